@@ -311,6 +311,204 @@ func (x *SymbolDeleted) GetDeletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+type SymbolLocked struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the locked symbol
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Project the symbol belongs to
+	ProjectId uint64 `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// Unique identifier within the project
+	Uid string `protobuf:"bytes,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	// User holding the lease
+	Holder string `protobuf:"bytes,4,opt,name=holder,proto3" json:"holder,omitempty"`
+	// Editing session holding the lease
+	SessionId string `protobuf:"bytes,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Timestamp when the lease expires unless renewed
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Timestamp when the symbol was locked
+	LockedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=locked_at,json=lockedAt,proto3" json:"locked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SymbolLocked) Reset() {
+	*x = SymbolLocked{}
+	mi := &file_events_symbols_v1_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SymbolLocked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbolLocked) ProtoMessage() {}
+
+func (x *SymbolLocked) ProtoReflect() protoreflect.Message {
+	mi := &file_events_symbols_v1_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymbolLocked.ProtoReflect.Descriptor instead.
+func (*SymbolLocked) Descriptor() ([]byte, []int) {
+	return file_events_symbols_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SymbolLocked) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SymbolLocked) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *SymbolLocked) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *SymbolLocked) GetHolder() string {
+	if x != nil {
+		return x.Holder
+	}
+	return ""
+}
+
+func (x *SymbolLocked) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SymbolLocked) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *SymbolLocked) GetLockedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LockedAt
+	}
+	return nil
+}
+
+type SymbolUnlocked struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the unlocked symbol
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Project the symbol belongs to
+	ProjectId uint64 `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// Unique identifier within the project
+	Uid string `protobuf:"bytes,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	// User that held the lease
+	Holder string `protobuf:"bytes,4,opt,name=holder,proto3" json:"holder,omitempty"`
+	// User that released the lease
+	ReleasedBy string `protobuf:"bytes,5,opt,name=released_by,json=releasedBy,proto3" json:"released_by,omitempty"`
+	// True when an admin broke a lease held by someone else
+	Forced bool `protobuf:"varint,6,opt,name=forced,proto3" json:"forced,omitempty"`
+	// Timestamp when the symbol was unlocked
+	UnlockedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=unlocked_at,json=unlockedAt,proto3" json:"unlocked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SymbolUnlocked) Reset() {
+	*x = SymbolUnlocked{}
+	mi := &file_events_symbols_v1_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SymbolUnlocked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbolUnlocked) ProtoMessage() {}
+
+func (x *SymbolUnlocked) ProtoReflect() protoreflect.Message {
+	mi := &file_events_symbols_v1_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymbolUnlocked.ProtoReflect.Descriptor instead.
+func (*SymbolUnlocked) Descriptor() ([]byte, []int) {
+	return file_events_symbols_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SymbolUnlocked) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SymbolUnlocked) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *SymbolUnlocked) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *SymbolUnlocked) GetHolder() string {
+	if x != nil {
+		return x.Holder
+	}
+	return ""
+}
+
+func (x *SymbolUnlocked) GetReleasedBy() string {
+	if x != nil {
+		return x.ReleasedBy
+	}
+	return ""
+}
+
+func (x *SymbolUnlocked) GetForced() bool {
+	if x != nil {
+		return x.Forced
+	}
+	return false
+}
+
+func (x *SymbolUnlocked) GetUnlockedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnlockedAt
+	}
+	return nil
+}
+
 var File_events_symbols_v1_events_proto protoreflect.FileDescriptor
 
 const file_events_symbols_v1_events_proto_rawDesc = "" +
@@ -346,7 +544,29 @@ const file_events_symbols_v1_events_proto_rawDesc = "" +
 	"project_id\x18\x02 \x01(\x04R\tprojectId\x12\x10\n" +
 	"\x03uid\x18\x03 \x01(\tR\x03uid\x129\n" +
 	"\n" +
-	"deleted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAtB\xbb\x01\n" +
+	"deleted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"\xfa\x01\n" +
+	"\fSymbolLocked\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\x04R\tprojectId\x12\x10\n" +
+	"\x03uid\x18\x03 \x01(\tR\x03uid\x12\x16\n" +
+	"\x06holder\x18\x04 \x01(\tR\x06holder\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x05 \x01(\tR\tsessionId\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x127\n" +
+	"\tlocked_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\blockedAt\"\xdf\x01\n" +
+	"\x0eSymbolUnlocked\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\x04R\tprojectId\x12\x10\n" +
+	"\x03uid\x18\x03 \x01(\tR\x03uid\x12\x16\n" +
+	"\x06holder\x18\x04 \x01(\tR\x06holder\x12\x1f\n" +
+	"\vreleased_by\x18\x05 \x01(\tR\n" +
+	"releasedBy\x12\x16\n" +
+	"\x06forced\x18\x06 \x01(\bR\x06forced\x12;\n" +
+	"\vunlocked_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"unlockedAtB\xbb\x01\n" +
 	"\x15com.events.symbols.v1B\vEventsProtoP\x01Z\x1acontracts/gen/events/v1;v1\xa2\x02\x03ESX\xaa\x02\x11Events.Symbols.V1\xba\x02\x12Events_Symbols_V1_\xca\x02\x11Events\\Symbols\\V1\xe2\x02\x1dEvents\\Symbols\\V1\\GPBMetadata\xea\x02\x13Events::Symbols::V1b\x06proto3"
 
 var (
@@ -361,22 +581,27 @@ func file_events_symbols_v1_events_proto_rawDescGZIP() []byte {
 	return file_events_symbols_v1_events_proto_rawDescData
 }
 
-var file_events_symbols_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_events_symbols_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_events_symbols_v1_events_proto_goTypes = []any{
 	(*SymbolCreated)(nil),         // 0: events.symbols.v1.SymbolCreated
 	(*SymbolUpdated)(nil),         // 1: events.symbols.v1.SymbolUpdated
 	(*SymbolDeleted)(nil),         // 2: events.symbols.v1.SymbolDeleted
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(*SymbolLocked)(nil),          // 3: events.symbols.v1.SymbolLocked
+	(*SymbolUnlocked)(nil),        // 4: events.symbols.v1.SymbolUnlocked
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
 }
 var file_events_symbols_v1_events_proto_depIdxs = []int32{
-	3, // 0: events.symbols.v1.SymbolCreated.created_at:type_name -> google.protobuf.Timestamp
-	3, // 1: events.symbols.v1.SymbolUpdated.updated_at:type_name -> google.protobuf.Timestamp
-	3, // 2: events.symbols.v1.SymbolDeleted.deleted_at:type_name -> google.protobuf.Timestamp
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	5, // 0: events.symbols.v1.SymbolCreated.created_at:type_name -> google.protobuf.Timestamp
+	5, // 1: events.symbols.v1.SymbolUpdated.updated_at:type_name -> google.protobuf.Timestamp
+	5, // 2: events.symbols.v1.SymbolDeleted.deleted_at:type_name -> google.protobuf.Timestamp
+	5, // 3: events.symbols.v1.SymbolLocked.expires_at:type_name -> google.protobuf.Timestamp
+	5, // 4: events.symbols.v1.SymbolLocked.locked_at:type_name -> google.protobuf.Timestamp
+	5, // 5: events.symbols.v1.SymbolUnlocked.unlocked_at:type_name -> google.protobuf.Timestamp
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_events_symbols_v1_events_proto_init() }
@@ -390,7 +615,7 @@ func file_events_symbols_v1_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_symbols_v1_events_proto_rawDesc), len(file_events_symbols_v1_events_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -24,37 +24,58 @@ const (
 type ErrorReason int32
 
 const (
-	ErrorReason_SYMBOL_UNSPECIFIED ErrorReason = 0
-	ErrorReason_SYMBOL_NOT_FOUND   ErrorReason = 1
-	ErrorReason_INVALID_PAGE_TOKEN ErrorReason = 2 // Invalid pagination token
-	ErrorReason_VALIDATION_ERROR   ErrorReason = 3 // General validation error
-	ErrorReason_DUPLICATE_SYMBOL   ErrorReason = 4 // Duplicate symbol (unique constraint violation)
-	ErrorReason_INVALID_ID         ErrorReason = 5 // Invalid symbol ID (zero or negative)
-	ErrorReason_INVALID_DATA       ErrorReason = 6 // Invalid or corrupted symbol data
-	ErrorReason_DATABASE_ERROR     ErrorReason = 7 // Generic database operation failed
+	ErrorReason_SYMBOL_UNSPECIFIED    ErrorReason = 0
+	ErrorReason_SYMBOL_NOT_FOUND      ErrorReason = 1
+	ErrorReason_INVALID_PAGE_TOKEN    ErrorReason = 2  // Invalid pagination token
+	ErrorReason_VALIDATION_ERROR      ErrorReason = 3  // General validation error
+	ErrorReason_DUPLICATE_SYMBOL      ErrorReason = 4  // Duplicate symbol (unique constraint violation)
+	ErrorReason_INVALID_ID            ErrorReason = 5  // Invalid symbol ID (zero or negative)
+	ErrorReason_INVALID_DATA          ErrorReason = 6  // Invalid or corrupted symbol data
+	ErrorReason_DATABASE_ERROR        ErrorReason = 7  // Generic database operation failed
+	ErrorReason_SYMBOL_HAS_DEPENDENTS ErrorReason = 8  // Symbol is referenced by other symbols (FAILED_PRECONDITION)
+	ErrorReason_INVALID_REFERENCE     ErrorReason = 9  // Referenced symbol does not exist in the project or is the symbol itself
+	ErrorReason_REFERENCE_CYCLE       ErrorReason = 10 // References would create a cycle
+	ErrorReason_SYMBOL_LOCKED         ErrorReason = 11 // Symbol is locked by another editing session (FAILED_PRECONDITION)
+	ErrorReason_LOCK_NOT_HELD         ErrorReason = 12 // Caller does not hold the symbol lock (FAILED_PRECONDITION)
+	ErrorReason_LOCK_FORBIDDEN        ErrorReason = 13 // Caller may not break the symbol lock
+	ErrorReason_REVISION_NOT_FOUND    ErrorReason = 14 // Symbol revision does not exist
 )
 
 // Enum value maps for ErrorReason.
 var (
 	ErrorReason_name = map[int32]string{
-		0: "SYMBOL_UNSPECIFIED",
-		1: "SYMBOL_NOT_FOUND",
-		2: "INVALID_PAGE_TOKEN",
-		3: "VALIDATION_ERROR",
-		4: "DUPLICATE_SYMBOL",
-		5: "INVALID_ID",
-		6: "INVALID_DATA",
-		7: "DATABASE_ERROR",
+		0:  "SYMBOL_UNSPECIFIED",
+		1:  "SYMBOL_NOT_FOUND",
+		2:  "INVALID_PAGE_TOKEN",
+		3:  "VALIDATION_ERROR",
+		4:  "DUPLICATE_SYMBOL",
+		5:  "INVALID_ID",
+		6:  "INVALID_DATA",
+		7:  "DATABASE_ERROR",
+		8:  "SYMBOL_HAS_DEPENDENTS",
+		9:  "INVALID_REFERENCE",
+		10: "REFERENCE_CYCLE",
+		11: "SYMBOL_LOCKED",
+		12: "LOCK_NOT_HELD",
+		13: "LOCK_FORBIDDEN",
+		14: "REVISION_NOT_FOUND",
 	}
 	ErrorReason_value = map[string]int32{
-		"SYMBOL_UNSPECIFIED": 0,
-		"SYMBOL_NOT_FOUND":   1,
-		"INVALID_PAGE_TOKEN": 2,
-		"VALIDATION_ERROR":   3,
-		"DUPLICATE_SYMBOL":   4,
-		"INVALID_ID":         5,
-		"INVALID_DATA":       6,
-		"DATABASE_ERROR":     7,
+		"SYMBOL_UNSPECIFIED":    0,
+		"SYMBOL_NOT_FOUND":      1,
+		"INVALID_PAGE_TOKEN":    2,
+		"VALIDATION_ERROR":      3,
+		"DUPLICATE_SYMBOL":      4,
+		"INVALID_ID":            5,
+		"INVALID_DATA":          6,
+		"DATABASE_ERROR":        7,
+		"SYMBOL_HAS_DEPENDENTS": 8,
+		"INVALID_REFERENCE":     9,
+		"REFERENCE_CYCLE":       10,
+		"SYMBOL_LOCKED":         11,
+		"LOCK_NOT_HELD":         12,
+		"LOCK_FORBIDDEN":        13,
+		"REVISION_NOT_FOUND":    14,
 	}
 )
 
@@ -89,7 +110,7 @@ var File_service_symbols_v1_error_reason_proto protoreflect.FileDescriptor
 
 const file_service_symbols_v1_error_reason_proto_rawDesc = "" +
 	"\n" +
-	"%service/symbols/v1/error_reason.proto\x12\x12service.symbols.v1*\xb5\x01\n" +
+	"%service/symbols/v1/error_reason.proto\x12\x12service.symbols.v1*\xce\x02\n" +
 	"\vErrorReason\x12\x16\n" +
 	"\x12SYMBOL_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10SYMBOL_NOT_FOUND\x10\x01\x12\x16\n" +
@@ -99,7 +120,15 @@ const file_service_symbols_v1_error_reason_proto_rawDesc = "" +
 	"\n" +
 	"INVALID_ID\x10\x05\x12\x10\n" +
 	"\fINVALID_DATA\x10\x06\x12\x12\n" +
-	"\x0eDATABASE_ERROR\x10\aB\xc7\x01\n" +
+	"\x0eDATABASE_ERROR\x10\a\x12\x19\n" +
+	"\x15SYMBOL_HAS_DEPENDENTS\x10\b\x12\x15\n" +
+	"\x11INVALID_REFERENCE\x10\t\x12\x13\n" +
+	"\x0fREFERENCE_CYCLE\x10\n" +
+	"\x12\x11\n" +
+	"\rSYMBOL_LOCKED\x10\v\x12\x11\n" +
+	"\rLOCK_NOT_HELD\x10\f\x12\x12\n" +
+	"\x0eLOCK_FORBIDDEN\x10\r\x12\x16\n" +
+	"\x12REVISION_NOT_FOUND\x10\x0eB\xc7\x01\n" +
 	"\x16com.service.symbols.v1B\x10ErrorReasonProtoP\x01Z\x1bcontracts/gen/symbols/v1;v1\xa2\x02\x03SSX\xaa\x02\x12Service.Symbols.V1\xba\x02\x13Service_Symbols_V1_\xca\x02\x12Service\\Symbols\\V1\xe2\x02\x1eService\\Symbols\\V1\\GPBMetadata\xea\x02\x14Service::Symbols::V1b\x06proto3"
 
 var (
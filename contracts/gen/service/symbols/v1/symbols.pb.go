@@ -11,6 +11,9 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,6 +26,59 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// AUDIT
+type AuditOperation int32
+
+const (
+	AuditOperation_AUDIT_OPERATION_UNSPECIFIED AuditOperation = 0
+	AuditOperation_AUDIT_OPERATION_CREATE      AuditOperation = 1
+	AuditOperation_AUDIT_OPERATION_UPDATE      AuditOperation = 2
+	AuditOperation_AUDIT_OPERATION_DELETE      AuditOperation = 3
+)
+
+// Enum value maps for AuditOperation.
+var (
+	AuditOperation_name = map[int32]string{
+		0: "AUDIT_OPERATION_UNSPECIFIED",
+		1: "AUDIT_OPERATION_CREATE",
+		2: "AUDIT_OPERATION_UPDATE",
+		3: "AUDIT_OPERATION_DELETE",
+	}
+	AuditOperation_value = map[string]int32{
+		"AUDIT_OPERATION_UNSPECIFIED": 0,
+		"AUDIT_OPERATION_CREATE":      1,
+		"AUDIT_OPERATION_UPDATE":      2,
+		"AUDIT_OPERATION_DELETE":      3,
+	}
+)
+
+func (x AuditOperation) Enum() *AuditOperation {
+	p := new(AuditOperation)
+	*p = x
+	return p
+}
+
+func (x AuditOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_symbols_v1_symbols_proto_enumTypes[0].Descriptor()
+}
+
+func (AuditOperation) Type() protoreflect.EnumType {
+	return &file_service_symbols_v1_symbols_proto_enumTypes[0]
+}
+
+func (x AuditOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditOperation.Descriptor instead.
+func (AuditOperation) EnumDescriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{0}
+}
+
 type SymbolItem struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -32,6 +88,7 @@ type SymbolItem struct {
 	ClassName       string                 `protobuf:"bytes,5,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
 	ComponentTarget string                 `protobuf:"bytes,6,opt,name=component_target,json=componentTarget,proto3" json:"component_target,omitempty"`
 	Version         uint32                 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	Tags            map[string]string      `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -115,6 +172,13 @@ func (x *SymbolItem) GetVersion() uint32 {
 	return 0
 }
 
+func (x *SymbolItem) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type Symbol struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -125,8 +189,11 @@ type Symbol struct {
 	ComponentTarget string                 `protobuf:"bytes,6,opt,name=component_target,json=componentTarget,proto3" json:"component_target,omitempty"`
 	Version         uint32                 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 	Data            []byte                 `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// UIDs of the symbols embedded by this symbol (same project)
+	References    []string          `protobuf:"bytes,9,rep,name=references,proto3" json:"references,omitempty"`
+	Tags          map[string]string `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Symbol) Reset() {
@@ -215,6 +282,20 @@ func (x *Symbol) GetData() []byte {
 	return nil
 }
 
+func (x *Symbol) GetReferences() []string {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *Symbol) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 // CREATE
 type CreateSymbolRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -225,8 +306,12 @@ type CreateSymbolRequest struct {
 	ComponentTarget string                 `protobuf:"bytes,6,opt,name=component_target,json=componentTarget,proto3" json:"component_target,omitempty"`
 	Version         uint32                 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 	Data            []byte                 `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// UIDs of the symbols embedded by this symbol (same project)
+	References []string `protobuf:"bytes,9,rep,name=references,proto3" json:"references,omitempty"`
+	// Free-form key/value labels, e.g. category, theme or author
+	Tags          map[string]string `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateSymbolRequest) Reset() {
@@ -308,6 +393,20 @@ func (x *CreateSymbolRequest) GetData() []byte {
 	return nil
 }
 
+func (x *CreateSymbolRequest) GetReferences() []string {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *CreateSymbolRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type CreateSymbolResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Symbol        *Symbol                `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
@@ -363,8 +462,12 @@ type UpdateSymbolRequest struct {
 	ComponentTarget string                 `protobuf:"bytes,6,opt,name=component_target,json=componentTarget,proto3" json:"component_target,omitempty"`
 	Version         uint32                 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 	Data            []byte                 `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Replaces the symbol references
+	References []string `protobuf:"bytes,9,rep,name=references,proto3" json:"references,omitempty"`
+	// Replaces the symbol tags
+	Tags          map[string]string `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateSymbolRequest) Reset() {
@@ -453,6 +556,20 @@ func (x *UpdateSymbolRequest) GetData() []byte {
 	return nil
 }
 
+func (x *UpdateSymbolRequest) GetReferences() []string {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *UpdateSymbolRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type UpdateSymbolResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Symbol        *Symbol                `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
@@ -499,8 +616,11 @@ func (x *UpdateSymbolResponse) GetSymbol() *Symbol {
 
 // DELETE
 type DeleteSymbolRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Delete even if other symbols reference this one; their references to it are removed.
+	// Without cascade the call fails with FAILED_PRECONDITION while dependents exist.
+	Cascade       bool `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -542,6 +662,13 @@ func (x *DeleteSymbolRequest) GetId() uint64 {
 	return 0
 }
 
+func (x *DeleteSymbolRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
 type DeleteSymbolResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -675,35 +802,28 @@ func (x *GetSymbolResponse) GetSymbol() *Symbol {
 	return nil
 }
 
-// ListSymbolsRequest contains parameters for listing symbols with optional filters.
-type ListSymbolsRequest struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	ProjectId uint64                 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	// Offset-based pagination: number of records to skip
-	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	// Maximum number of records to return (default: 20)
-	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	// Optional filters
-	Label           *string `protobuf:"bytes,4,opt,name=label,proto3,oneof" json:"label,omitempty"`                                            // Exact match on label
-	ComponentTarget *string `protobuf:"bytes,5,opt,name=component_target,json=componentTarget,proto3,oneof" json:"component_target,omitempty"` // Exact match on component_target
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// REFERENCES
+type ListSymbolDependentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSymbolsRequest) Reset() {
-	*x = ListSymbolsRequest{}
+func (x *ListSymbolDependentsRequest) Reset() {
+	*x = ListSymbolDependentsRequest{}
 	mi := &file_service_symbols_v1_symbols_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSymbolsRequest) String() string {
+func (x *ListSymbolDependentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSymbolsRequest) ProtoMessage() {}
+func (*ListSymbolDependentsRequest) ProtoMessage() {}
 
-func (x *ListSymbolsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListSymbolDependentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_service_symbols_v1_symbols_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -715,78 +835,84 @@ func (x *ListSymbolsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSymbolsRequest.ProtoReflect.Descriptor instead.
-func (*ListSymbolsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSymbolDependentsRequest.ProtoReflect.Descriptor instead.
+func (*ListSymbolDependentsRequest) Descriptor() ([]byte, []int) {
 	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListSymbolsRequest) GetProjectId() uint64 {
+func (x *ListSymbolDependentsRequest) GetId() uint64 {
 	if x != nil {
-		return x.ProjectId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ListSymbolsRequest) GetOffset() uint64 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
+type ListSymbolDependentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbols       []*SymbolItem          `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSymbolsRequest) GetLimit() uint32 {
+func (x *ListSymbolDependentsResponse) Reset() {
+	*x = ListSymbolDependentsResponse{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSymbolDependentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSymbolDependentsResponse) ProtoMessage() {}
+
+func (x *ListSymbolDependentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[11]
 	if x != nil {
-		return x.Limit
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ListSymbolsRequest) GetLabel() string {
-	if x != nil && x.Label != nil {
-		return *x.Label
-	}
-	return ""
+// Deprecated: Use ListSymbolDependentsResponse.ProtoReflect.Descriptor instead.
+func (*ListSymbolDependentsResponse) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListSymbolsRequest) GetComponentTarget() string {
-	if x != nil && x.ComponentTarget != nil {
-		return *x.ComponentTarget
+func (x *ListSymbolDependentsResponse) GetSymbols() []*SymbolItem {
+	if x != nil {
+		return x.Symbols
 	}
-	return ""
+	return nil
 }
 
-// PaginationMeta contains metadata about paginated results
-type PaginationMeta struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Total number of symbols matching the query
-	TotalCount uint64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	// Offset used in the request
-	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	// Limit used in the request
-	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	// True if more results exist after current page
-	HasNextPage bool `protobuf:"varint,4,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
-	// True if previous results exist (offset > 0)
-	HasPreviousPage bool `protobuf:"varint,5,opt,name=has_previous_page,json=hasPreviousPage,proto3" json:"has_previous_page,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ListSymbolDependenciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaginationMeta) Reset() {
-	*x = PaginationMeta{}
-	mi := &file_service_symbols_v1_symbols_proto_msgTypes[11]
+func (x *ListSymbolDependenciesRequest) Reset() {
+	*x = ListSymbolDependenciesRequest{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaginationMeta) String() string {
+func (x *ListSymbolDependenciesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaginationMeta) ProtoMessage() {}
+func (*ListSymbolDependenciesRequest) ProtoMessage() {}
 
-func (x *PaginationMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_service_symbols_v1_symbols_proto_msgTypes[11]
+func (x *ListSymbolDependenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -797,69 +923,89 @@ func (x *PaginationMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaginationMeta.ProtoReflect.Descriptor instead.
-func (*PaginationMeta) Descriptor() ([]byte, []int) {
-	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListSymbolDependenciesRequest.ProtoReflect.Descriptor instead.
+func (*ListSymbolDependenciesRequest) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *PaginationMeta) GetTotalCount() uint64 {
+func (x *ListSymbolDependenciesRequest) GetId() uint64 {
 	if x != nil {
-		return x.TotalCount
+		return x.Id
 	}
 	return 0
 }
 
-func (x *PaginationMeta) GetOffset() uint64 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
+type ListSymbolDependenciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbols       []*SymbolItem          `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaginationMeta) GetLimit() uint32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+func (x *ListSymbolDependenciesResponse) Reset() {
+	*x = ListSymbolDependenciesResponse{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaginationMeta) GetHasNextPage() bool {
+func (x *ListSymbolDependenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSymbolDependenciesResponse) ProtoMessage() {}
+
+func (x *ListSymbolDependenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[13]
 	if x != nil {
-		return x.HasNextPage
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *PaginationMeta) GetHasPreviousPage() bool {
+// Deprecated: Use ListSymbolDependenciesResponse.ProtoReflect.Descriptor instead.
+func (*ListSymbolDependenciesResponse) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListSymbolDependenciesResponse) GetSymbols() []*SymbolItem {
 	if x != nil {
-		return x.HasPreviousPage
+		return x.Symbols
 	}
-	return false
+	return nil
 }
 
-type ListSymbolsResponse struct {
+// SymbolLock is an editing lease on a symbol held by a user session.
+type SymbolLock struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Symbols       []*SymbolItem          `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
-	Pagination    *PaginationMeta        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	SymbolId      uint64                 `protobuf:"varint,1,opt,name=symbol_id,json=symbolId,proto3" json:"symbol_id,omitempty"`
+	Holder        string                 `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	SessionId     string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	AcquiredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=acquired_at,json=acquiredAt,proto3" json:"acquired_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSymbolsResponse) Reset() {
-	*x = ListSymbolsResponse{}
-	mi := &file_service_symbols_v1_symbols_proto_msgTypes[12]
+func (x *SymbolLock) Reset() {
+	*x = SymbolLock{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSymbolsResponse) String() string {
+func (x *SymbolLock) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSymbolsResponse) ProtoMessage() {}
+func (*SymbolLock) ProtoMessage() {}
 
-func (x *ListSymbolsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_symbols_v1_symbols_proto_msgTypes[12]
+func (x *SymbolLock) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -870,31 +1016,2025 @@ func (x *ListSymbolsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSymbolsResponse.ProtoReflect.Descriptor instead.
-func (*ListSymbolsResponse) Descriptor() ([]byte, []int) {
-	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SymbolLock.ProtoReflect.Descriptor instead.
+func (*SymbolLock) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListSymbolsResponse) GetSymbols() []*SymbolItem {
+func (x *SymbolLock) GetSymbolId() uint64 {
 	if x != nil {
-		return x.Symbols
+		return x.SymbolId
+	}
+	return 0
+}
+
+func (x *SymbolLock) GetHolder() string {
+	if x != nil {
+		return x.Holder
+	}
+	return ""
+}
+
+func (x *SymbolLock) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SymbolLock) GetAcquiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcquiredAt
 	}
 	return nil
 }
 
-func (x *ListSymbolsResponse) GetPagination() *PaginationMeta {
+func (x *SymbolLock) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Pagination
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-var File_service_symbols_v1_symbols_proto protoreflect.FileDescriptor
+type AcquireSymbolLockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Lease duration, defaults to 5 minutes
+	Ttl           *durationpb.Duration `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_service_symbols_v1_symbols_proto_rawDesc = "" +
-	"\n" +
-	" service/symbols/v1/symbols.proto\x12\x12service.symbols.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\x86\x02\n" +
-	"\n" +
+func (x *AcquireSymbolLockRequest) Reset() {
+	*x = AcquireSymbolLockRequest{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcquireSymbolLockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquireSymbolLockRequest) ProtoMessage() {}
+
+func (x *AcquireSymbolLockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquireSymbolLockRequest.ProtoReflect.Descriptor instead.
+func (*AcquireSymbolLockRequest) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AcquireSymbolLockRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AcquireSymbolLockRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type AcquireSymbolLockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lock          *SymbolLock            `protobuf:"bytes,1,opt,name=lock,proto3" json:"lock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcquireSymbolLockResponse) Reset() {
+	*x = AcquireSymbolLockResponse{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcquireSymbolLockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquireSymbolLockResponse) ProtoMessage() {}
+
+func (x *AcquireSymbolLockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquireSymbolLockResponse.ProtoReflect.Descriptor instead.
+func (*AcquireSymbolLockResponse) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AcquireSymbolLockResponse) GetLock() *SymbolLock {
+	if x != nil {
+		return x.Lock
+	}
+	return nil
+}
+
+type RenewSymbolLockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Lease duration from now, defaults to 5 minutes
+	Ttl           *durationpb.Duration `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewSymbolLockRequest) Reset() {
+	*x = RenewSymbolLockRequest{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewSymbolLockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewSymbolLockRequest) ProtoMessage() {}
+
+func (x *RenewSymbolLockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewSymbolLockRequest.ProtoReflect.Descriptor instead.
+func (*RenewSymbolLockRequest) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RenewSymbolLockRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RenewSymbolLockRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type RenewSymbolLockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lock          *SymbolLock            `protobuf:"bytes,1,opt,name=lock,proto3" json:"lock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewSymbolLockResponse) Reset() {
+	*x = RenewSymbolLockResponse{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewSymbolLockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewSymbolLockResponse) ProtoMessage() {}
+
+func (x *RenewSymbolLockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewSymbolLockResponse.ProtoReflect.Descriptor instead.
+func (*RenewSymbolLockResponse) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RenewSymbolLockResponse) GetLock() *SymbolLock {
+	if x != nil {
+		return x.Lock
+	}
+	return nil
+}
+
+type ReleaseSymbolLockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Break a lease held by another user session (admins only)
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseSymbolLockRequest) Reset() {
+	*x = ReleaseSymbolLockRequest{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSymbolLockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSymbolLockRequest) ProtoMessage() {}
+
+func (x *ReleaseSymbolLockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSymbolLockRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseSymbolLockRequest) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReleaseSymbolLockRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReleaseSymbolLockRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type ReleaseSymbolLockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseSymbolLockResponse) Reset() {
+	*x = ReleaseSymbolLockResponse{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSymbolLockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSymbolLockResponse) ProtoMessage() {}
+
+func (x *ReleaseSymbolLockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSymbolLockResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseSymbolLockResponse) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReleaseSymbolLockResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// TagFacet is a tag key or value with the number of symbols carrying it.
+type TagFacet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagFacet) Reset() {
+	*x = TagFacet{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagFacet) ProtoMessage() {}
+
+func (x *TagFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagFacet.ProtoReflect.Descriptor instead.
+func (*TagFacet) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TagFacet) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *TagFacet) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListTagKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     uint64                 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagKeysRequest) Reset() {
+	*x = ListTagKeysRequest{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagKeysRequest) ProtoMessage() {}
+
+func (x *ListTagKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListTagKeysRequest) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListTagKeysRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+type ListTagKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Facets        []*TagFacet            `protobuf:"bytes,1,rep,name=facets,proto3" json:"facets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagKeysResponse) Reset() {
+	*x = ListTagKeysResponse{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagKeysResponse) ProtoMessage() {}
+
+func (x *ListTagKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListTagKeysResponse) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListTagKeysResponse) GetFacets() []*TagFacet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+type ListTagValuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     uint64                 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagValuesRequest) Reset() {
+	*x = ListTagValuesRequest{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagValuesRequest) ProtoMessage() {}
+
+func (x *ListTagValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagValuesRequest.ProtoReflect.Descriptor instead.
+func (*ListTagValuesRequest) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListTagValuesRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *ListTagValuesRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ListTagValuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Facets        []*TagFacet            `protobuf:"bytes,1,rep,name=facets,proto3" json:"facets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagValuesResponse) Reset() {
+	*x = ListTagValuesResponse{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagValuesResponse) ProtoMessage() {}
+
+func (x *ListTagValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagValuesResponse.ProtoReflect.Descriptor instead.
+func (*ListTagValuesResponse) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListTagValuesResponse) GetFacets() []*TagFacet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+// SymbolRevision describes a stored snapshot of a symbol.
+// Revision 1 is the creation; every update adds the next revision.
+type SymbolRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      uint32                 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Version       uint32                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SymbolRevision) Reset() {
+	*x = SymbolRevision{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SymbolRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbolRevision) ProtoMessage() {}
+
+func (x *SymbolRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymbolRevision.ProtoReflect.Descriptor instead.
+func (*SymbolRevision) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SymbolRevision) GetRevision() uint32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *SymbolRevision) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SymbolRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListSymbolRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSymbolRevisionsRequest) Reset() {
+	*x = ListSymbolRevisionsRequest{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSymbolRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSymbolRevisionsRequest) ProtoMessage() {}
+
+func (x *ListSymbolRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSymbolRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSymbolRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListSymbolRevisionsRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListSymbolRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*SymbolRevision      `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSymbolRevisionsResponse) Reset() {
+	*x = ListSymbolRevisionsResponse{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSymbolRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSymbolRevisionsResponse) ProtoMessage() {}
+
+func (x *ListSymbolRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSymbolRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSymbolRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListSymbolRevisionsResponse) GetRevisions() []*SymbolRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+// DiffSymbolRequest compares a base state of a symbol with a target state.
+type DiffSymbolRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Revision of the symbol to diff from (0 = the current symbol)
+	BaseRevision uint32 `protobuf:"varint,2,opt,name=base_revision,json=baseRevision,proto3" json:"base_revision,omitempty"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*DiffSymbolRequest_Revision
+	//	*DiffSymbolRequest_OtherId
+	//	*DiffSymbolRequest_Proposed
+	Target        isDiffSymbolRequest_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffSymbolRequest) Reset() {
+	*x = DiffSymbolRequest{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffSymbolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffSymbolRequest) ProtoMessage() {}
+
+func (x *DiffSymbolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffSymbolRequest.ProtoReflect.Descriptor instead.
+func (*DiffSymbolRequest) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DiffSymbolRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DiffSymbolRequest) GetBaseRevision() uint32 {
+	if x != nil {
+		return x.BaseRevision
+	}
+	return 0
+}
+
+func (x *DiffSymbolRequest) GetTarget() isDiffSymbolRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *DiffSymbolRequest) GetRevision() uint32 {
+	if x != nil {
+		if x, ok := x.Target.(*DiffSymbolRequest_Revision); ok {
+			return x.Revision
+		}
+	}
+	return 0
+}
+
+func (x *DiffSymbolRequest) GetOtherId() uint64 {
+	if x != nil {
+		if x, ok := x.Target.(*DiffSymbolRequest_OtherId); ok {
+			return x.OtherId
+		}
+	}
+	return 0
+}
+
+func (x *DiffSymbolRequest) GetProposed() *SymbolProposal {
+	if x != nil {
+		if x, ok := x.Target.(*DiffSymbolRequest_Proposed); ok {
+			return x.Proposed
+		}
+	}
+	return nil
+}
+
+type isDiffSymbolRequest_Target interface {
+	isDiffSymbolRequest_Target()
+}
+
+type DiffSymbolRequest_Revision struct {
+	// Another revision of the same symbol
+	Revision uint32 `protobuf:"varint,3,opt,name=revision,proto3,oneof"`
+}
+
+type DiffSymbolRequest_OtherId struct {
+	// Another symbol
+	OtherId uint64 `protobuf:"varint,4,opt,name=other_id,json=otherId,proto3,oneof"`
+}
+
+type DiffSymbolRequest_Proposed struct {
+	// Unsaved state of the symbol
+	Proposed *SymbolProposal `protobuf:"bytes,5,opt,name=proposed,proto3,oneof"`
+}
+
+func (*DiffSymbolRequest_Revision) isDiffSymbolRequest_Target() {}
+
+func (*DiffSymbolRequest_OtherId) isDiffSymbolRequest_Target() {}
+
+func (*DiffSymbolRequest_Proposed) isDiffSymbolRequest_Target() {}
+
+// SymbolProposal is an unsaved state of a symbol, as an UpdateSymbol call would write it.
+// The project and uid are those of the base symbol.
+type SymbolProposal struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Label           string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	ClassName       string                 `protobuf:"bytes,2,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	ComponentTarget string                 `protobuf:"bytes,3,opt,name=component_target,json=componentTarget,proto3" json:"component_target,omitempty"`
+	Version         uint32                 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	Data            []byte                 `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	References      []string               `protobuf:"bytes,6,rep,name=references,proto3" json:"references,omitempty"`
+	Tags            map[string]string      `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SymbolProposal) Reset() {
+	*x = SymbolProposal{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SymbolProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbolProposal) ProtoMessage() {}
+
+func (x *SymbolProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymbolProposal.ProtoReflect.Descriptor instead.
+func (*SymbolProposal) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SymbolProposal) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SymbolProposal) GetClassName() string {
+	if x != nil {
+		return x.ClassName
+	}
+	return ""
+}
+
+func (x *SymbolProposal) GetComponentTarget() string {
+	if x != nil {
+		return x.ComponentTarget
+	}
+	return ""
+}
+
+func (x *SymbolProposal) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SymbolProposal) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SymbolProposal) GetReferences() []string {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *SymbolProposal) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// JsonPatchOperation is an RFC 6902 operation.
+type JsonPatchOperation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Op            string                 `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`       // add, remove or replace
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`   // JSON Pointer (RFC 6901)
+	Value         *structpb.Value        `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"` // Unset for remove
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JsonPatchOperation) Reset() {
+	*x = JsonPatchOperation{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JsonPatchOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JsonPatchOperation) ProtoMessage() {}
+
+func (x *JsonPatchOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JsonPatchOperation.ProtoReflect.Descriptor instead.
+func (*JsonPatchOperation) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *JsonPatchOperation) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *JsonPatchOperation) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *JsonPatchOperation) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// DataByteSummary describes a change of data that is not JSON.
+type DataByteSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OldSize       uint64                 `protobuf:"varint,1,opt,name=old_size,json=oldSize,proto3" json:"old_size,omitempty"`
+	NewSize       uint64                 `protobuf:"varint,2,opt,name=new_size,json=newSize,proto3" json:"new_size,omitempty"`
+	OldSha256     string                 `protobuf:"bytes,3,opt,name=old_sha256,json=oldSha256,proto3" json:"old_sha256,omitempty"`
+	NewSha256     string                 `protobuf:"bytes,4,opt,name=new_sha256,json=newSha256,proto3" json:"new_sha256,omitempty"`
+	CommonPrefix  uint64                 `protobuf:"varint,5,opt,name=common_prefix,json=commonPrefix,proto3" json:"common_prefix,omitempty"` // Leading bytes both sides share
+	CommonSuffix  uint64                 `protobuf:"varint,6,opt,name=common_suffix,json=commonSuffix,proto3" json:"common_suffix,omitempty"` // Trailing bytes both sides share, not overlapping the prefix
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataByteSummary) Reset() {
+	*x = DataByteSummary{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataByteSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataByteSummary) ProtoMessage() {}
+
+func (x *DataByteSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataByteSummary.ProtoReflect.Descriptor instead.
+func (*DataByteSummary) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DataByteSummary) GetOldSize() uint64 {
+	if x != nil {
+		return x.OldSize
+	}
+	return 0
+}
+
+func (x *DataByteSummary) GetNewSize() uint64 {
+	if x != nil {
+		return x.NewSize
+	}
+	return 0
+}
+
+func (x *DataByteSummary) GetOldSha256() string {
+	if x != nil {
+		return x.OldSha256
+	}
+	return ""
+}
+
+func (x *DataByteSummary) GetNewSha256() string {
+	if x != nil {
+		return x.NewSha256
+	}
+	return ""
+}
+
+func (x *DataByteSummary) GetCommonPrefix() uint64 {
+	if x != nil {
+		return x.CommonPrefix
+	}
+	return 0
+}
+
+func (x *DataByteSummary) GetCommonSuffix() uint64 {
+	if x != nil {
+		return x.CommonSuffix
+	}
+	return 0
+}
+
+type DataDiff struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Changed bool                   `protobuf:"varint,1,opt,name=changed,proto3" json:"changed,omitempty"`
+	// RFC 6902 patch turning the base data into the target data, set when both are JSON
+	Patch []*JsonPatchOperation `protobuf:"bytes,2,rep,name=patch,proto3" json:"patch,omitempty"`
+	// Set when either side is not JSON
+	Bytes         *DataByteSummary `protobuf:"bytes,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataDiff) Reset() {
+	*x = DataDiff{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataDiff) ProtoMessage() {}
+
+func (x *DataDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataDiff.ProtoReflect.Descriptor instead.
+func (*DataDiff) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DataDiff) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+func (x *DataDiff) GetPatch() []*JsonPatchOperation {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
+}
+
+func (x *DataDiff) GetBytes() *DataByteSummary {
+	if x != nil {
+		return x.Bytes
+	}
+	return nil
+}
+
+type DiffSymbolResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Metadata changes; changes of data are described by data
+	Changes       []*FieldChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	Data          *DataDiff      `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffSymbolResponse) Reset() {
+	*x = DiffSymbolResponse{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffSymbolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffSymbolResponse) ProtoMessage() {}
+
+func (x *DiffSymbolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffSymbolResponse.ProtoReflect.Descriptor instead.
+func (*DiffSymbolResponse) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DiffSymbolResponse) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *DiffSymbolResponse) GetData() *DataDiff {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ComponentTargetCount struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ComponentTarget string                 `protobuf:"bytes,1,opt,name=component_target,json=componentTarget,proto3" json:"component_target,omitempty"`
+	Count           uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ComponentTargetCount) Reset() {
+	*x = ComponentTargetCount{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComponentTargetCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComponentTargetCount) ProtoMessage() {}
+
+func (x *ComponentTargetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComponentTargetCount.ProtoReflect.Descriptor instead.
+func (*ComponentTargetCount) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ComponentTargetCount) GetComponentTarget() string {
+	if x != nil {
+		return x.ComponentTarget
+	}
+	return ""
+}
+
+func (x *ComponentTargetCount) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// ProjectSymbolStats summarizes the live symbols of a project.
+// Recently changed counts are relative to computed_at.
+type ProjectSymbolStats struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	ProjectId        uint64                  `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	TotalSymbols     uint64                  `protobuf:"varint,2,opt,name=total_symbols,json=totalSymbols,proto3" json:"total_symbols,omitempty"`
+	ComponentTargets []*ComponentTargetCount `protobuf:"bytes,3,rep,name=component_targets,json=componentTargets,proto3" json:"component_targets,omitempty"` // Ordered by component target
+	TotalDataBytes   uint64                  `protobuf:"varint,4,opt,name=total_data_bytes,json=totalDataBytes,proto3" json:"total_data_bytes,omitempty"`
+	AverageDataBytes float64                 `protobuf:"fixed64,5,opt,name=average_data_bytes,json=averageDataBytes,proto3" json:"average_data_bytes,omitempty"`
+	ChangedLastDay   uint64                  `protobuf:"varint,6,opt,name=changed_last_day,json=changedLastDay,proto3" json:"changed_last_day,omitempty"`
+	ChangedLastWeek  uint64                  `protobuf:"varint,7,opt,name=changed_last_week,json=changedLastWeek,proto3" json:"changed_last_week,omitempty"`
+	ComputedAt       *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	Materialized     bool                    `protobuf:"varint,9,opt,name=materialized,proto3" json:"materialized,omitempty"` // Served from the materialized stats table rather than computed on request
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ProjectSymbolStats) Reset() {
+	*x = ProjectSymbolStats{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectSymbolStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectSymbolStats) ProtoMessage() {}
+
+func (x *ProjectSymbolStats) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectSymbolStats.ProtoReflect.Descriptor instead.
+func (*ProjectSymbolStats) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ProjectSymbolStats) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *ProjectSymbolStats) GetTotalSymbols() uint64 {
+	if x != nil {
+		return x.TotalSymbols
+	}
+	return 0
+}
+
+func (x *ProjectSymbolStats) GetComponentTargets() []*ComponentTargetCount {
+	if x != nil {
+		return x.ComponentTargets
+	}
+	return nil
+}
+
+func (x *ProjectSymbolStats) GetTotalDataBytes() uint64 {
+	if x != nil {
+		return x.TotalDataBytes
+	}
+	return 0
+}
+
+func (x *ProjectSymbolStats) GetAverageDataBytes() float64 {
+	if x != nil {
+		return x.AverageDataBytes
+	}
+	return 0
+}
+
+func (x *ProjectSymbolStats) GetChangedLastDay() uint64 {
+	if x != nil {
+		return x.ChangedLastDay
+	}
+	return 0
+}
+
+func (x *ProjectSymbolStats) GetChangedLastWeek() uint64 {
+	if x != nil {
+		return x.ChangedLastWeek
+	}
+	return 0
+}
+
+func (x *ProjectSymbolStats) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+func (x *ProjectSymbolStats) GetMaterialized() bool {
+	if x != nil {
+		return x.Materialized
+	}
+	return false
+}
+
+type GetProjectSymbolStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     uint64                 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectSymbolStatsRequest) Reset() {
+	*x = GetProjectSymbolStatsRequest{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectSymbolStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectSymbolStatsRequest) ProtoMessage() {}
+
+func (x *GetProjectSymbolStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectSymbolStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectSymbolStatsRequest) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetProjectSymbolStatsRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+type GetProjectSymbolStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         *ProjectSymbolStats    `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectSymbolStatsResponse) Reset() {
+	*x = GetProjectSymbolStatsResponse{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectSymbolStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectSymbolStatsResponse) ProtoMessage() {}
+
+func (x *GetProjectSymbolStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectSymbolStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectSymbolStatsResponse) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetProjectSymbolStatsResponse) GetStats() *ProjectSymbolStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// ListSymbolsRequest contains parameters for listing symbols with optional filters.
+type ListSymbolsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId uint64                 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// Offset-based pagination: number of records to skip
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Maximum number of records to return (default: 20)
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Optional filters
+	Label           *string `protobuf:"bytes,4,opt,name=label,proto3,oneof" json:"label,omitempty"`                                            // Exact match on label
+	ComponentTarget *string `protobuf:"bytes,5,opt,name=component_target,json=componentTarget,proto3,oneof" json:"component_target,omitempty"` // Exact match on component_target
+	// Tag filters; all given filters must match.
+	// Tag equality as "key=value", e.g. ?tags=theme=dark&tags=author=jane
+	Tags []string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Tag existence by key, e.g. ?tag_keys=category
+	TagKeys []string `protobuf:"bytes,7,rep,name=tag_keys,json=tagKeys,proto3" json:"tag_keys,omitempty"`
+	// Tag value in a set as "key=value1,value2", e.g. ?tag_in=category=header,footer
+	// Entries of tags and tag_in without "=" filter on key existence.
+	TagIn         []string `protobuf:"bytes,8,rep,name=tag_in,json=tagIn,proto3" json:"tag_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSymbolsRequest) Reset() {
+	*x = ListSymbolsRequest{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSymbolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSymbolsRequest) ProtoMessage() {}
+
+func (x *ListSymbolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSymbolsRequest.ProtoReflect.Descriptor instead.
+func (*ListSymbolsRequest) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListSymbolsRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *ListSymbolsRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListSymbolsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListSymbolsRequest) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *ListSymbolsRequest) GetComponentTarget() string {
+	if x != nil && x.ComponentTarget != nil {
+		return *x.ComponentTarget
+	}
+	return ""
+}
+
+func (x *ListSymbolsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListSymbolsRequest) GetTagKeys() []string {
+	if x != nil {
+		return x.TagKeys
+	}
+	return nil
+}
+
+func (x *ListSymbolsRequest) GetTagIn() []string {
+	if x != nil {
+		return x.TagIn
+	}
+	return nil
+}
+
+// PaginationMeta contains metadata about paginated results
+type PaginationMeta struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Total number of symbols matching the query
+	TotalCount uint64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// Offset used in the request
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Limit used in the request
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// True if more results exist after current page
+	HasNextPage bool `protobuf:"varint,4,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"`
+	// True if previous results exist (offset > 0)
+	HasPreviousPage bool `protobuf:"varint,5,opt,name=has_previous_page,json=hasPreviousPage,proto3" json:"has_previous_page,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PaginationMeta) Reset() {
+	*x = PaginationMeta{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaginationMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginationMeta) ProtoMessage() {}
+
+func (x *PaginationMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginationMeta.ProtoReflect.Descriptor instead.
+func (*PaginationMeta) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PaginationMeta) GetTotalCount() uint64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *PaginationMeta) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *PaginationMeta) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *PaginationMeta) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+func (x *PaginationMeta) GetHasPreviousPage() bool {
+	if x != nil {
+		return x.HasPreviousPage
+	}
+	return false
+}
+
+type ListSymbolsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbols       []*SymbolItem          `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	Pagination    *PaginationMeta        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSymbolsResponse) Reset() {
+	*x = ListSymbolsResponse{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSymbolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSymbolsResponse) ProtoMessage() {}
+
+func (x *ListSymbolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSymbolsResponse.ProtoReflect.Descriptor instead.
+func (*ListSymbolsResponse) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListSymbolsResponse) GetSymbols() []*SymbolItem {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *ListSymbolsResponse) GetPagination() *PaginationMeta {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// FieldChange describes the change of a single symbol field.
+// Binary fields are represented by their SHA-256 digest.
+type FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue      string                 `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      string                 `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *FieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+// AuditEvent is an append-only record of a symbol mutation.
+type AuditEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId uint64                 `protobuf:"varint,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	SymbolId  uint64                 `protobuf:"varint,3,opt,name=symbol_id,json=symbolId,proto3" json:"symbol_id,omitempty"`
+	Operation AuditOperation         `protobuf:"varint,4,opt,name=operation,proto3,enum=service.symbols.v1.AuditOperation" json:"operation,omitempty"`
+	// User that performed the mutation (X-User-ID header, "anonymous" or "system")
+	Actor         string                 `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	RequestId     string                 `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,7,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	Changes       []*FieldChange         `protobuf:"bytes,8,rep,name=changes,proto3" json:"changes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AuditEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetSymbolId() uint64 {
+	if x != nil {
+		return x.SymbolId
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetOperation() AuditOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return AuditOperation_AUDIT_OPERATION_UNSPECIFIED
+}
+
+func (x *AuditEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListAuditEventsRequest contains parameters for listing audit events of a project.
+type ListAuditEventsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId uint64                 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// Offset-based pagination: number of records to skip
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Maximum number of records to return (default: 20)
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Optional filters
+	SymbolId      *uint64                `protobuf:"varint,4,opt,name=symbol_id,json=symbolId,proto3,oneof" json:"symbol_id,omitempty"`                    // Events of a single symbol
+	Actor         *string                `protobuf:"bytes,5,opt,name=actor,proto3,oneof" json:"actor,omitempty"`                                           // Exact match on actor
+	Operation     AuditOperation         `protobuf:"varint,6,opt,name=operation,proto3,enum=service.symbols.v1.AuditOperation" json:"operation,omitempty"` // UNSPECIFIED matches all operations
+	Since         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=since,proto3" json:"since,omitempty"`                                                 // Events created at or after this time
+	Until         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=until,proto3" json:"until,omitempty"`                                                 // Events created before this time
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListAuditEventsRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetSymbolId() uint64 {
+	if x != nil && x.SymbolId != nil {
+		return *x.SymbolId
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetActor() string {
+	if x != nil && x.Actor != nil {
+		return *x.Actor
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetOperation() AuditOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return AuditOperation_AUDIT_OPERATION_UNSPECIFIED
+}
+
+func (x *ListAuditEventsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Pagination    *PaginationMeta        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_symbols_v1_symbols_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_service_symbols_v1_symbols_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetPagination() *PaginationMeta {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+var File_service_symbols_v1_symbols_proto protoreflect.FileDescriptor
+
+const file_service_symbols_v1_symbols_proto_rawDesc = "" +
+	"\n" +
+	" service/symbols/v1/symbols.proto\x12\x12service.symbols.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xfd\x02\n" +
+	"\n" +
 	"SymbolItem\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\x12&\n" +
 	"\n" +
@@ -904,7 +3044,11 @@ const file_service_symbols_v1_symbols_proto_rawDesc = "" +
 	"\n" +
 	"class_name\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tclassName\x122\n" +
 	"\x10component_target\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x0fcomponentTarget\x12!\n" +
-	"\aversion\x18\a \x01(\rB\a\xfaB\x04*\x02 \x00R\aversion\"\x9f\x02\n" +
+	"\aversion\x18\a \x01(\rB\a\xfaB\x04*\x02 \x00R\aversion\x12<\n" +
+	"\x04tags\x18\b \x03(\v2(.service.symbols.v1.SymbolItem.TagsEntryR\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb2\x03\n" +
 	"\x06Symbol\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\x12&\n" +
 	"\n" +
@@ -915,7 +3059,15 @@ const file_service_symbols_v1_symbols_proto_rawDesc = "" +
 	"class_name\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tclassName\x122\n" +
 	"\x10component_target\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x0fcomponentTarget\x12!\n" +
 	"\aversion\x18\a \x01(\rB\a\xfaB\x04*\x02 \x00R\aversion\x12\x1b\n" +
-	"\x04data\x18\b \x01(\fB\a\xfaB\x04z\x02\x10\x01R\x04data\"\x93\x02\n" +
+	"\x04data\x18\b \x01(\fB\a\xfaB\x04z\x02\x10\x01R\x04data\x12\x1e\n" +
+	"\n" +
+	"references\x18\t \x03(\tR\n" +
+	"references\x128\n" +
+	"\x04tags\x18\n" +
+	" \x03(\v2$.service.symbols.v1.Symbol.TagsEntryR\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xdd\x03\n" +
 	"\x13CreateSymbolRequest\x12&\n" +
 	"\n" +
 	"project_id\x18\x02 \x01(\x04B\a\xfaB\x042\x02 \x00R\tprojectId\x12\x19\n" +
@@ -925,9 +3077,17 @@ const file_service_symbols_v1_symbols_proto_rawDesc = "" +
 	"class_name\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tclassName\x122\n" +
 	"\x10component_target\x18\x06 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x0fcomponentTarget\x12!\n" +
 	"\aversion\x18\a \x01(\rB\a\xfaB\x04*\x02 \x00R\aversion\x12\x1b\n" +
-	"\x04data\x18\b \x01(\fB\a\xfaB\x04z\x02\x10\x01R\x04data\"T\n" +
+	"\x04data\x18\b \x01(\fB\a\xfaB\x04z\x02\x10\x01R\x04data\x12/\n" +
+	"\n" +
+	"references\x18\t \x03(\tB\x0f\xfaB\f\x92\x01\t\x18\x01\"\x05r\x03\xb0\x01\x01R\n" +
+	"references\x12^\n" +
+	"\x04tags\x18\n" +
+	" \x03(\v21.service.symbols.v1.CreateSymbolRequest.TagsEntryB\x17\xfaB\x14\x9a\x01\x11\x102\"\x06r\x04\x10\x01\x18@*\x05r\x03\x18\xff\x01R\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"T\n" +
 	"\x14CreateSymbolResponse\x12<\n" +
-	"\x06symbol\x18\x01 \x01(\v2\x1a.service.symbols.v1.SymbolB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06symbol\"\xf6\x01\n" +
+	"\x06symbol\x18\x01 \x01(\v2\x1a.service.symbols.v1.SymbolB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06symbol\"\xc0\x03\n" +
 	"\x13UpdateSymbolRequest\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\x12&\n" +
 	"\n" +
@@ -938,24 +3098,155 @@ const file_service_symbols_v1_symbols_proto_rawDesc = "" +
 	"class_name\x18\x05 \x01(\tR\tclassName\x12)\n" +
 	"\x10component_target\x18\x06 \x01(\tR\x0fcomponentTarget\x12\x18\n" +
 	"\aversion\x18\a \x01(\rR\aversion\x12\x12\n" +
-	"\x04data\x18\b \x01(\fR\x04data\"T\n" +
+	"\x04data\x18\b \x01(\fR\x04data\x12/\n" +
+	"\n" +
+	"references\x18\t \x03(\tB\x0f\xfaB\f\x92\x01\t\x18\x01\"\x05r\x03\xb0\x01\x01R\n" +
+	"references\x12^\n" +
+	"\x04tags\x18\n" +
+	" \x03(\v21.service.symbols.v1.UpdateSymbolRequest.TagsEntryB\x17\xfaB\x14\x9a\x01\x11\x102\"\x06r\x04\x10\x01\x18@*\x05r\x03\x18\xff\x01R\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"T\n" +
 	"\x14UpdateSymbolResponse\x12<\n" +
-	"\x06symbol\x18\x01 \x01(\v2\x1a.service.symbols.v1.SymbolB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06symbol\".\n" +
+	"\x06symbol\x18\x01 \x01(\v2\x1a.service.symbols.v1.SymbolB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06symbol\"H\n" +
 	"\x13DeleteSymbolRequest\x12\x17\n" +
-	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\"0\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\x12\x18\n" +
+	"\acascade\x18\x02 \x01(\bR\acascade\"0\n" +
 	"\x14DeleteSymbolResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"+\n" +
 	"\x10GetSymbolRequest\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\"Q\n" +
 	"\x11GetSymbolResponse\x12<\n" +
-	"\x06symbol\x18\x01 \x01(\v2\x1a.service.symbols.v1.SymbolB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06symbol\"\xe8\x01\n" +
+	"\x06symbol\x18\x01 \x01(\v2\x1a.service.symbols.v1.SymbolB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06symbol\"6\n" +
+	"\x1bListSymbolDependentsRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\"X\n" +
+	"\x1cListSymbolDependentsResponse\x128\n" +
+	"\asymbols\x18\x01 \x03(\v2\x1e.service.symbols.v1.SymbolItemR\asymbols\"8\n" +
+	"\x1dListSymbolDependenciesRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\"Z\n" +
+	"\x1eListSymbolDependenciesResponse\x128\n" +
+	"\asymbols\x18\x01 \x03(\v2\x1e.service.symbols.v1.SymbolItemR\asymbols\"\xd8\x01\n" +
+	"\n" +
+	"SymbolLock\x12\x1b\n" +
+	"\tsymbol_id\x18\x01 \x01(\x04R\bsymbolId\x12\x16\n" +
+	"\x06holder\x18\x02 \x01(\tR\x06holder\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\x12;\n" +
+	"\vacquired_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"acquiredAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"q\n" +
+	"\x18AcquireSymbolLockRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\x12<\n" +
+	"\x03ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationB\x0f\xfaB\f\xaa\x01\t\"\x03\b\x90\x1c2\x02\b\n" +
+	"R\x03ttl\"O\n" +
+	"\x19AcquireSymbolLockResponse\x122\n" +
+	"\x04lock\x18\x01 \x01(\v2\x1e.service.symbols.v1.SymbolLockR\x04lock\"o\n" +
+	"\x16RenewSymbolLockRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\x12<\n" +
+	"\x03ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationB\x0f\xfaB\f\xaa\x01\t\"\x03\b\x90\x1c2\x02\b\n" +
+	"R\x03ttl\"M\n" +
+	"\x17RenewSymbolLockResponse\x122\n" +
+	"\x04lock\x18\x01 \x01(\v2\x1e.service.symbols.v1.SymbolLockR\x04lock\"I\n" +
+	"\x18ReleaseSymbolLockRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"5\n" +
+	"\x19ReleaseSymbolLockResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"6\n" +
+	"\bTagFacet\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x04R\x05count\"<\n" +
+	"\x12ListTagKeysRequest\x12&\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\tprojectId\"K\n" +
+	"\x13ListTagKeysResponse\x124\n" +
+	"\x06facets\x18\x01 \x03(\v2\x1c.service.symbols.v1.TagFacetR\x06facets\"[\n" +
+	"\x14ListTagValuesRequest\x12&\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\tprojectId\x12\x1b\n" +
+	"\x03key\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18@R\x03key\"M\n" +
+	"\x15ListTagValuesResponse\x124\n" +
+	"\x06facets\x18\x01 \x03(\v2\x1c.service.symbols.v1.TagFacetR\x06facets\"\x81\x01\n" +
+	"\x0eSymbolRevision\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\rR\brevision\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\rR\aversion\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"5\n" +
+	"\x1aListSymbolRevisionsRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\"_\n" +
+	"\x1bListSymbolRevisionsResponse\x12@\n" +
+	"\trevisions\x18\x01 \x03(\v2\".service.symbols.v1.SymbolRevisionR\trevisions\"\xef\x01\n" +
+	"\x11DiffSymbolRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\x12#\n" +
+	"\rbase_revision\x18\x02 \x01(\rR\fbaseRevision\x12%\n" +
+	"\brevision\x18\x03 \x01(\rB\a\xfaB\x04*\x02 \x00H\x00R\brevision\x12$\n" +
+	"\bother_id\x18\x04 \x01(\x04B\a\xfaB\x042\x02 \x00H\x00R\aotherId\x12@\n" +
+	"\bproposed\x18\x05 \x01(\v2\".service.symbols.v1.SymbolProposalH\x00R\bproposedB\r\n" +
+	"\x06target\x12\x03\xf8B\x01\"\xb9\x02\n" +
+	"\x0eSymbolProposal\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x1d\n" +
+	"\n" +
+	"class_name\x18\x02 \x01(\tR\tclassName\x12)\n" +
+	"\x10component_target\x18\x03 \x01(\tR\x0fcomponentTarget\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\rR\aversion\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\fR\x04data\x12\x1e\n" +
+	"\n" +
+	"references\x18\x06 \x03(\tR\n" +
+	"references\x12@\n" +
+	"\x04tags\x18\a \x03(\v2,.service.symbols.v1.SymbolProposal.TagsEntryR\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"f\n" +
+	"\x12JsonPatchOperation\x12\x0e\n" +
+	"\x02op\x18\x01 \x01(\tR\x02op\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12,\n" +
+	"\x05value\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\x05value\"\xcf\x01\n" +
+	"\x0fDataByteSummary\x12\x19\n" +
+	"\bold_size\x18\x01 \x01(\x04R\aoldSize\x12\x19\n" +
+	"\bnew_size\x18\x02 \x01(\x04R\anewSize\x12\x1d\n" +
+	"\n" +
+	"old_sha256\x18\x03 \x01(\tR\toldSha256\x12\x1d\n" +
+	"\n" +
+	"new_sha256\x18\x04 \x01(\tR\tnewSha256\x12#\n" +
+	"\rcommon_prefix\x18\x05 \x01(\x04R\fcommonPrefix\x12#\n" +
+	"\rcommon_suffix\x18\x06 \x01(\x04R\fcommonSuffix\"\x9d\x01\n" +
+	"\bDataDiff\x12\x18\n" +
+	"\achanged\x18\x01 \x01(\bR\achanged\x12<\n" +
+	"\x05patch\x18\x02 \x03(\v2&.service.symbols.v1.JsonPatchOperationR\x05patch\x129\n" +
+	"\x05bytes\x18\x03 \x01(\v2#.service.symbols.v1.DataByteSummaryR\x05bytes\"\x81\x01\n" +
+	"\x12DiffSymbolResponse\x129\n" +
+	"\achanges\x18\x01 \x03(\v2\x1f.service.symbols.v1.FieldChangeR\achanges\x120\n" +
+	"\x04data\x18\x02 \x01(\v2\x1c.service.symbols.v1.DataDiffR\x04data\"W\n" +
+	"\x14ComponentTargetCount\x12)\n" +
+	"\x10component_target\x18\x01 \x01(\tR\x0fcomponentTarget\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x04R\x05count\"\xbe\x03\n" +
+	"\x12ProjectSymbolStats\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\x04R\tprojectId\x12#\n" +
+	"\rtotal_symbols\x18\x02 \x01(\x04R\ftotalSymbols\x12U\n" +
+	"\x11component_targets\x18\x03 \x03(\v2(.service.symbols.v1.ComponentTargetCountR\x10componentTargets\x12(\n" +
+	"\x10total_data_bytes\x18\x04 \x01(\x04R\x0etotalDataBytes\x12,\n" +
+	"\x12average_data_bytes\x18\x05 \x01(\x01R\x10averageDataBytes\x12(\n" +
+	"\x10changed_last_day\x18\x06 \x01(\x04R\x0echangedLastDay\x12*\n" +
+	"\x11changed_last_week\x18\a \x01(\x04R\x0fchangedLastWeek\x12;\n" +
+	"\vcomputed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAt\x12\"\n" +
+	"\fmaterialized\x18\t \x01(\bR\fmaterialized\"F\n" +
+	"\x1cGetProjectSymbolStatsRequest\x12&\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\tprojectId\"]\n" +
+	"\x1dGetProjectSymbolStatsResponse\x12<\n" +
+	"\x05stats\x18\x01 \x01(\v2&.service.symbols.v1.ProjectSymbolStatsR\x05stats\"\xae\x02\n" +
 	"\x12ListSymbolsRequest\x12&\n" +
 	"\n" +
 	"project_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\tprojectId\x12\x1f\n" +
 	"\x06offset\x18\x02 \x01(\x04B\a\xfaB\x042\x02(\x00R\x06offset\x12\x1f\n" +
 	"\x05limit\x18\x03 \x01(\rB\t\xfaB\x06*\x04\x18d(\x01R\x05limit\x12\x19\n" +
 	"\x05label\x18\x04 \x01(\tH\x00R\x05label\x88\x01\x01\x12.\n" +
-	"\x10component_target\x18\x05 \x01(\tH\x01R\x0fcomponentTarget\x88\x01\x01B\b\n" +
+	"\x10component_target\x18\x05 \x01(\tH\x01R\x0fcomponentTarget\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x19\n" +
+	"\btag_keys\x18\a \x03(\tR\atagKeys\x12\x15\n" +
+	"\x06tag_in\x18\b \x03(\tR\x05tagInB\b\n" +
 	"\x06_labelB\x13\n" +
 	"\x11_component_target\"\xaf\x01\n" +
 	"\x0ePaginationMeta\x12\x1f\n" +
@@ -969,13 +3260,66 @@ const file_service_symbols_v1_symbols_proto_rawDesc = "" +
 	"\asymbols\x18\x01 \x03(\v2\x1e.service.symbols.v1.SymbolItemR\asymbols\x12B\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2\".service.symbols.v1.PaginationMetaR\n" +
-	"pagination2\x88\x05\n" +
+	"pagination\"]\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\"\xe2\x02\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\x04R\tprojectId\x12\x1b\n" +
+	"\tsymbol_id\x18\x03 \x01(\x04R\bsymbolId\x12@\n" +
+	"\toperation\x18\x04 \x01(\x0e2\".service.symbols.v1.AuditOperationR\toperation\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x06 \x01(\tR\trequestId\x12\x1b\n" +
+	"\tclient_ip\x18\a \x01(\tR\bclientIp\x129\n" +
+	"\achanges\x18\b \x03(\v2\x1f.service.symbols.v1.FieldChangeR\achanges\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x87\x03\n" +
+	"\x16ListAuditEventsRequest\x12&\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\tprojectId\x12\x1f\n" +
+	"\x06offset\x18\x02 \x01(\x04B\a\xfaB\x042\x02(\x00R\x06offset\x12\x1f\n" +
+	"\x05limit\x18\x03 \x01(\rB\t\xfaB\x06*\x04\x18d(\x01R\x05limit\x12 \n" +
+	"\tsymbol_id\x18\x04 \x01(\x04H\x00R\bsymbolId\x88\x01\x01\x12\x19\n" +
+	"\x05actor\x18\x05 \x01(\tH\x01R\x05actor\x88\x01\x01\x12J\n" +
+	"\toperation\x18\x06 \x01(\x0e2\".service.symbols.v1.AuditOperationB\b\xfaB\x05\x82\x01\x02\x10\x01R\toperation\x120\n" +
+	"\x05since\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x05untilB\f\n" +
+	"\n" +
+	"_symbol_idB\b\n" +
+	"\x06_actor\"\x95\x01\n" +
+	"\x17ListAuditEventsResponse\x126\n" +
+	"\x06events\x18\x01 \x03(\v2\x1e.service.symbols.v1.AuditEventR\x06events\x12B\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\".service.symbols.v1.PaginationMetaR\n" +
+	"pagination*\x85\x01\n" +
+	"\x0eAuditOperation\x12\x1f\n" +
+	"\x1bAUDIT_OPERATION_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16AUDIT_OPERATION_CREATE\x10\x01\x12\x1a\n" +
+	"\x16AUDIT_OPERATION_UPDATE\x10\x02\x12\x1a\n" +
+	"\x16AUDIT_OPERATION_DELETE\x10\x032\x87\x12\n" +
 	"\x0eSymbolsService\x12y\n" +
 	"\fCreateSymbol\x12'.service.symbols.v1.CreateSymbolRequest\x1a(.service.symbols.v1.CreateSymbolResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/symbols\x12r\n" +
 	"\tGetSymbol\x12$.service.symbols.v1.GetSymbolRequest\x1a%.service.symbols.v1.GetSymbolResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/symbols/{id}\x12~\n" +
 	"\fUpdateSymbol\x12'.service.symbols.v1.UpdateSymbolRequest\x1a(.service.symbols.v1.UpdateSymbolResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/symbols/{id}\x12{\n" +
 	"\fDeleteSymbol\x12'.service.symbols.v1.DeleteSymbolRequest\x1a(.service.symbols.v1.DeleteSymbolResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/symbols/{id}\x12\x89\x01\n" +
-	"\vListSymbols\x12&.service.symbols.v1.ListSymbolsRequest\x1a'.service.symbols.v1.ListSymbolsResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/projects/{project_id}/symbolsB\xc3\x01\n" +
+	"\vListSymbols\x12&.service.symbols.v1.ListSymbolsRequest\x1a'.service.symbols.v1.ListSymbolsResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/projects/{project_id}/symbols\x12\x9e\x01\n" +
+	"\x14ListSymbolDependents\x12/.service.symbols.v1.ListSymbolDependentsRequest\x1a0.service.symbols.v1.ListSymbolDependentsResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/symbols/{id}/dependents\x12\xa6\x01\n" +
+	"\x16ListSymbolDependencies\x121.service.symbols.v1.ListSymbolDependenciesRequest\x1a2.service.symbols.v1.ListSymbolDependenciesResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/symbols/{id}/dependencies\x12\x92\x01\n" +
+	"\x11AcquireSymbolLock\x12,.service.symbols.v1.AcquireSymbolLockRequest\x1a-.service.symbols.v1.AcquireSymbolLockResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/symbols/{id}/lock\x12\x8c\x01\n" +
+	"\x0fRenewSymbolLock\x12*.service.symbols.v1.RenewSymbolLockRequest\x1a+.service.symbols.v1.RenewSymbolLockResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\x1a\x15/v1/symbols/{id}/lock\x12\x8f\x01\n" +
+	"\x11ReleaseSymbolLock\x12,.service.symbols.v1.ReleaseSymbolLockRequest\x1a-.service.symbols.v1.ReleaseSymbolLockResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v1/symbols/{id}/lock\x12\x86\x01\n" +
+	"\vListTagKeys\x12&.service.symbols.v1.ListTagKeysRequest\x1a'.service.symbols.v1.ListTagKeysResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/projects/{project_id}/tags\x12\x92\x01\n" +
+	"\rListTagValues\x12(.service.symbols.v1.ListTagValuesRequest\x1a).service.symbols.v1.ListTagValuesResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/projects/{project_id}/tags/{key}\x12\x9a\x01\n" +
+	"\x13ListSymbolRevisions\x12..service.symbols.v1.ListSymbolRevisionsRequest\x1a/.service.symbols.v1.ListSymbolRevisionsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/symbols/{id}/revisions\x12}\n" +
+	"\n" +
+	"DiffSymbol\x12%.service.symbols.v1.DiffSymbolRequest\x1a&.service.symbols.v1.DiffSymbolResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/symbols/{id}/diff\x12\xa5\x01\n" +
+	"\x15GetProjectSymbolStats\x120.service.symbols.v1.GetProjectSymbolStatsRequest\x1a1.service.symbols.v1.GetProjectSymbolStatsResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/projects/{project_id}/stats\x12\x9a\x01\n" +
+	"\x0fListAuditEvents\x12*.service.symbols.v1.ListAuditEventsRequest\x1a+.service.symbols.v1.ListAuditEventsResponse\".\x82\xd3\xe4\x93\x02(\x12&/v1/projects/{project_id}/audit-eventsB\xc3\x01\n" +
 	"\x16com.service.symbols.v1B\fSymbolsProtoP\x01Z\x1bcontracts/gen/symbols/v1;v1\xa2\x02\x03SSX\xaa\x02\x12Service.Symbols.V1\xba\x02\x13Service_Symbols_V1_\xca\x02\x12Service\\Symbols\\V1\xe2\x02\x1eService\\Symbols\\V1\\GPBMetadata\xea\x02\x14Service::Symbols::V1b\x06proto3"
 
 var (
@@ -990,43 +3334,142 @@ func file_service_symbols_v1_symbols_proto_rawDescGZIP() []byte {
 	return file_service_symbols_v1_symbols_proto_rawDescData
 }
 
-var file_service_symbols_v1_symbols_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_service_symbols_v1_symbols_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_service_symbols_v1_symbols_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
 var file_service_symbols_v1_symbols_proto_goTypes = []any{
-	(*SymbolItem)(nil),           // 0: service.symbols.v1.SymbolItem
-	(*Symbol)(nil),               // 1: service.symbols.v1.Symbol
-	(*CreateSymbolRequest)(nil),  // 2: service.symbols.v1.CreateSymbolRequest
-	(*CreateSymbolResponse)(nil), // 3: service.symbols.v1.CreateSymbolResponse
-	(*UpdateSymbolRequest)(nil),  // 4: service.symbols.v1.UpdateSymbolRequest
-	(*UpdateSymbolResponse)(nil), // 5: service.symbols.v1.UpdateSymbolResponse
-	(*DeleteSymbolRequest)(nil),  // 6: service.symbols.v1.DeleteSymbolRequest
-	(*DeleteSymbolResponse)(nil), // 7: service.symbols.v1.DeleteSymbolResponse
-	(*GetSymbolRequest)(nil),     // 8: service.symbols.v1.GetSymbolRequest
-	(*GetSymbolResponse)(nil),    // 9: service.symbols.v1.GetSymbolResponse
-	(*ListSymbolsRequest)(nil),   // 10: service.symbols.v1.ListSymbolsRequest
-	(*PaginationMeta)(nil),       // 11: service.symbols.v1.PaginationMeta
-	(*ListSymbolsResponse)(nil),  // 12: service.symbols.v1.ListSymbolsResponse
+	(AuditOperation)(0),                    // 0: service.symbols.v1.AuditOperation
+	(*SymbolItem)(nil),                     // 1: service.symbols.v1.SymbolItem
+	(*Symbol)(nil),                         // 2: service.symbols.v1.Symbol
+	(*CreateSymbolRequest)(nil),            // 3: service.symbols.v1.CreateSymbolRequest
+	(*CreateSymbolResponse)(nil),           // 4: service.symbols.v1.CreateSymbolResponse
+	(*UpdateSymbolRequest)(nil),            // 5: service.symbols.v1.UpdateSymbolRequest
+	(*UpdateSymbolResponse)(nil),           // 6: service.symbols.v1.UpdateSymbolResponse
+	(*DeleteSymbolRequest)(nil),            // 7: service.symbols.v1.DeleteSymbolRequest
+	(*DeleteSymbolResponse)(nil),           // 8: service.symbols.v1.DeleteSymbolResponse
+	(*GetSymbolRequest)(nil),               // 9: service.symbols.v1.GetSymbolRequest
+	(*GetSymbolResponse)(nil),              // 10: service.symbols.v1.GetSymbolResponse
+	(*ListSymbolDependentsRequest)(nil),    // 11: service.symbols.v1.ListSymbolDependentsRequest
+	(*ListSymbolDependentsResponse)(nil),   // 12: service.symbols.v1.ListSymbolDependentsResponse
+	(*ListSymbolDependenciesRequest)(nil),  // 13: service.symbols.v1.ListSymbolDependenciesRequest
+	(*ListSymbolDependenciesResponse)(nil), // 14: service.symbols.v1.ListSymbolDependenciesResponse
+	(*SymbolLock)(nil),                     // 15: service.symbols.v1.SymbolLock
+	(*AcquireSymbolLockRequest)(nil),       // 16: service.symbols.v1.AcquireSymbolLockRequest
+	(*AcquireSymbolLockResponse)(nil),      // 17: service.symbols.v1.AcquireSymbolLockResponse
+	(*RenewSymbolLockRequest)(nil),         // 18: service.symbols.v1.RenewSymbolLockRequest
+	(*RenewSymbolLockResponse)(nil),        // 19: service.symbols.v1.RenewSymbolLockResponse
+	(*ReleaseSymbolLockRequest)(nil),       // 20: service.symbols.v1.ReleaseSymbolLockRequest
+	(*ReleaseSymbolLockResponse)(nil),      // 21: service.symbols.v1.ReleaseSymbolLockResponse
+	(*TagFacet)(nil),                       // 22: service.symbols.v1.TagFacet
+	(*ListTagKeysRequest)(nil),             // 23: service.symbols.v1.ListTagKeysRequest
+	(*ListTagKeysResponse)(nil),            // 24: service.symbols.v1.ListTagKeysResponse
+	(*ListTagValuesRequest)(nil),           // 25: service.symbols.v1.ListTagValuesRequest
+	(*ListTagValuesResponse)(nil),          // 26: service.symbols.v1.ListTagValuesResponse
+	(*SymbolRevision)(nil),                 // 27: service.symbols.v1.SymbolRevision
+	(*ListSymbolRevisionsRequest)(nil),     // 28: service.symbols.v1.ListSymbolRevisionsRequest
+	(*ListSymbolRevisionsResponse)(nil),    // 29: service.symbols.v1.ListSymbolRevisionsResponse
+	(*DiffSymbolRequest)(nil),              // 30: service.symbols.v1.DiffSymbolRequest
+	(*SymbolProposal)(nil),                 // 31: service.symbols.v1.SymbolProposal
+	(*JsonPatchOperation)(nil),             // 32: service.symbols.v1.JsonPatchOperation
+	(*DataByteSummary)(nil),                // 33: service.symbols.v1.DataByteSummary
+	(*DataDiff)(nil),                       // 34: service.symbols.v1.DataDiff
+	(*DiffSymbolResponse)(nil),             // 35: service.symbols.v1.DiffSymbolResponse
+	(*ComponentTargetCount)(nil),           // 36: service.symbols.v1.ComponentTargetCount
+	(*ProjectSymbolStats)(nil),             // 37: service.symbols.v1.ProjectSymbolStats
+	(*GetProjectSymbolStatsRequest)(nil),   // 38: service.symbols.v1.GetProjectSymbolStatsRequest
+	(*GetProjectSymbolStatsResponse)(nil),  // 39: service.symbols.v1.GetProjectSymbolStatsResponse
+	(*ListSymbolsRequest)(nil),             // 40: service.symbols.v1.ListSymbolsRequest
+	(*PaginationMeta)(nil),                 // 41: service.symbols.v1.PaginationMeta
+	(*ListSymbolsResponse)(nil),            // 42: service.symbols.v1.ListSymbolsResponse
+	(*FieldChange)(nil),                    // 43: service.symbols.v1.FieldChange
+	(*AuditEvent)(nil),                     // 44: service.symbols.v1.AuditEvent
+	(*ListAuditEventsRequest)(nil),         // 45: service.symbols.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),        // 46: service.symbols.v1.ListAuditEventsResponse
+	nil,                                    // 47: service.symbols.v1.SymbolItem.TagsEntry
+	nil,                                    // 48: service.symbols.v1.Symbol.TagsEntry
+	nil,                                    // 49: service.symbols.v1.CreateSymbolRequest.TagsEntry
+	nil,                                    // 50: service.symbols.v1.UpdateSymbolRequest.TagsEntry
+	nil,                                    // 51: service.symbols.v1.SymbolProposal.TagsEntry
+	(*timestamppb.Timestamp)(nil),          // 52: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),            // 53: google.protobuf.Duration
+	(*structpb.Value)(nil),                 // 54: google.protobuf.Value
 }
 var file_service_symbols_v1_symbols_proto_depIdxs = []int32{
-	1,  // 0: service.symbols.v1.CreateSymbolResponse.symbol:type_name -> service.symbols.v1.Symbol
-	1,  // 1: service.symbols.v1.UpdateSymbolResponse.symbol:type_name -> service.symbols.v1.Symbol
-	1,  // 2: service.symbols.v1.GetSymbolResponse.symbol:type_name -> service.symbols.v1.Symbol
-	0,  // 3: service.symbols.v1.ListSymbolsResponse.symbols:type_name -> service.symbols.v1.SymbolItem
-	11, // 4: service.symbols.v1.ListSymbolsResponse.pagination:type_name -> service.symbols.v1.PaginationMeta
-	2,  // 5: service.symbols.v1.SymbolsService.CreateSymbol:input_type -> service.symbols.v1.CreateSymbolRequest
-	8,  // 6: service.symbols.v1.SymbolsService.GetSymbol:input_type -> service.symbols.v1.GetSymbolRequest
-	4,  // 7: service.symbols.v1.SymbolsService.UpdateSymbol:input_type -> service.symbols.v1.UpdateSymbolRequest
-	6,  // 8: service.symbols.v1.SymbolsService.DeleteSymbol:input_type -> service.symbols.v1.DeleteSymbolRequest
-	10, // 9: service.symbols.v1.SymbolsService.ListSymbols:input_type -> service.symbols.v1.ListSymbolsRequest
-	3,  // 10: service.symbols.v1.SymbolsService.CreateSymbol:output_type -> service.symbols.v1.CreateSymbolResponse
-	9,  // 11: service.symbols.v1.SymbolsService.GetSymbol:output_type -> service.symbols.v1.GetSymbolResponse
-	5,  // 12: service.symbols.v1.SymbolsService.UpdateSymbol:output_type -> service.symbols.v1.UpdateSymbolResponse
-	7,  // 13: service.symbols.v1.SymbolsService.DeleteSymbol:output_type -> service.symbols.v1.DeleteSymbolResponse
-	12, // 14: service.symbols.v1.SymbolsService.ListSymbols:output_type -> service.symbols.v1.ListSymbolsResponse
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	47, // 0: service.symbols.v1.SymbolItem.tags:type_name -> service.symbols.v1.SymbolItem.TagsEntry
+	48, // 1: service.symbols.v1.Symbol.tags:type_name -> service.symbols.v1.Symbol.TagsEntry
+	49, // 2: service.symbols.v1.CreateSymbolRequest.tags:type_name -> service.symbols.v1.CreateSymbolRequest.TagsEntry
+	2,  // 3: service.symbols.v1.CreateSymbolResponse.symbol:type_name -> service.symbols.v1.Symbol
+	50, // 4: service.symbols.v1.UpdateSymbolRequest.tags:type_name -> service.symbols.v1.UpdateSymbolRequest.TagsEntry
+	2,  // 5: service.symbols.v1.UpdateSymbolResponse.symbol:type_name -> service.symbols.v1.Symbol
+	2,  // 6: service.symbols.v1.GetSymbolResponse.symbol:type_name -> service.symbols.v1.Symbol
+	1,  // 7: service.symbols.v1.ListSymbolDependentsResponse.symbols:type_name -> service.symbols.v1.SymbolItem
+	1,  // 8: service.symbols.v1.ListSymbolDependenciesResponse.symbols:type_name -> service.symbols.v1.SymbolItem
+	52, // 9: service.symbols.v1.SymbolLock.acquired_at:type_name -> google.protobuf.Timestamp
+	52, // 10: service.symbols.v1.SymbolLock.expires_at:type_name -> google.protobuf.Timestamp
+	53, // 11: service.symbols.v1.AcquireSymbolLockRequest.ttl:type_name -> google.protobuf.Duration
+	15, // 12: service.symbols.v1.AcquireSymbolLockResponse.lock:type_name -> service.symbols.v1.SymbolLock
+	53, // 13: service.symbols.v1.RenewSymbolLockRequest.ttl:type_name -> google.protobuf.Duration
+	15, // 14: service.symbols.v1.RenewSymbolLockResponse.lock:type_name -> service.symbols.v1.SymbolLock
+	22, // 15: service.symbols.v1.ListTagKeysResponse.facets:type_name -> service.symbols.v1.TagFacet
+	22, // 16: service.symbols.v1.ListTagValuesResponse.facets:type_name -> service.symbols.v1.TagFacet
+	52, // 17: service.symbols.v1.SymbolRevision.created_at:type_name -> google.protobuf.Timestamp
+	27, // 18: service.symbols.v1.ListSymbolRevisionsResponse.revisions:type_name -> service.symbols.v1.SymbolRevision
+	31, // 19: service.symbols.v1.DiffSymbolRequest.proposed:type_name -> service.symbols.v1.SymbolProposal
+	51, // 20: service.symbols.v1.SymbolProposal.tags:type_name -> service.symbols.v1.SymbolProposal.TagsEntry
+	54, // 21: service.symbols.v1.JsonPatchOperation.value:type_name -> google.protobuf.Value
+	32, // 22: service.symbols.v1.DataDiff.patch:type_name -> service.symbols.v1.JsonPatchOperation
+	33, // 23: service.symbols.v1.DataDiff.bytes:type_name -> service.symbols.v1.DataByteSummary
+	43, // 24: service.symbols.v1.DiffSymbolResponse.changes:type_name -> service.symbols.v1.FieldChange
+	34, // 25: service.symbols.v1.DiffSymbolResponse.data:type_name -> service.symbols.v1.DataDiff
+	36, // 26: service.symbols.v1.ProjectSymbolStats.component_targets:type_name -> service.symbols.v1.ComponentTargetCount
+	52, // 27: service.symbols.v1.ProjectSymbolStats.computed_at:type_name -> google.protobuf.Timestamp
+	37, // 28: service.symbols.v1.GetProjectSymbolStatsResponse.stats:type_name -> service.symbols.v1.ProjectSymbolStats
+	1,  // 29: service.symbols.v1.ListSymbolsResponse.symbols:type_name -> service.symbols.v1.SymbolItem
+	41, // 30: service.symbols.v1.ListSymbolsResponse.pagination:type_name -> service.symbols.v1.PaginationMeta
+	0,  // 31: service.symbols.v1.AuditEvent.operation:type_name -> service.symbols.v1.AuditOperation
+	43, // 32: service.symbols.v1.AuditEvent.changes:type_name -> service.symbols.v1.FieldChange
+	52, // 33: service.symbols.v1.AuditEvent.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 34: service.symbols.v1.ListAuditEventsRequest.operation:type_name -> service.symbols.v1.AuditOperation
+	52, // 35: service.symbols.v1.ListAuditEventsRequest.since:type_name -> google.protobuf.Timestamp
+	52, // 36: service.symbols.v1.ListAuditEventsRequest.until:type_name -> google.protobuf.Timestamp
+	44, // 37: service.symbols.v1.ListAuditEventsResponse.events:type_name -> service.symbols.v1.AuditEvent
+	41, // 38: service.symbols.v1.ListAuditEventsResponse.pagination:type_name -> service.symbols.v1.PaginationMeta
+	3,  // 39: service.symbols.v1.SymbolsService.CreateSymbol:input_type -> service.symbols.v1.CreateSymbolRequest
+	9,  // 40: service.symbols.v1.SymbolsService.GetSymbol:input_type -> service.symbols.v1.GetSymbolRequest
+	5,  // 41: service.symbols.v1.SymbolsService.UpdateSymbol:input_type -> service.symbols.v1.UpdateSymbolRequest
+	7,  // 42: service.symbols.v1.SymbolsService.DeleteSymbol:input_type -> service.symbols.v1.DeleteSymbolRequest
+	40, // 43: service.symbols.v1.SymbolsService.ListSymbols:input_type -> service.symbols.v1.ListSymbolsRequest
+	11, // 44: service.symbols.v1.SymbolsService.ListSymbolDependents:input_type -> service.symbols.v1.ListSymbolDependentsRequest
+	13, // 45: service.symbols.v1.SymbolsService.ListSymbolDependencies:input_type -> service.symbols.v1.ListSymbolDependenciesRequest
+	16, // 46: service.symbols.v1.SymbolsService.AcquireSymbolLock:input_type -> service.symbols.v1.AcquireSymbolLockRequest
+	18, // 47: service.symbols.v1.SymbolsService.RenewSymbolLock:input_type -> service.symbols.v1.RenewSymbolLockRequest
+	20, // 48: service.symbols.v1.SymbolsService.ReleaseSymbolLock:input_type -> service.symbols.v1.ReleaseSymbolLockRequest
+	23, // 49: service.symbols.v1.SymbolsService.ListTagKeys:input_type -> service.symbols.v1.ListTagKeysRequest
+	25, // 50: service.symbols.v1.SymbolsService.ListTagValues:input_type -> service.symbols.v1.ListTagValuesRequest
+	28, // 51: service.symbols.v1.SymbolsService.ListSymbolRevisions:input_type -> service.symbols.v1.ListSymbolRevisionsRequest
+	30, // 52: service.symbols.v1.SymbolsService.DiffSymbol:input_type -> service.symbols.v1.DiffSymbolRequest
+	38, // 53: service.symbols.v1.SymbolsService.GetProjectSymbolStats:input_type -> service.symbols.v1.GetProjectSymbolStatsRequest
+	45, // 54: service.symbols.v1.SymbolsService.ListAuditEvents:input_type -> service.symbols.v1.ListAuditEventsRequest
+	4,  // 55: service.symbols.v1.SymbolsService.CreateSymbol:output_type -> service.symbols.v1.CreateSymbolResponse
+	10, // 56: service.symbols.v1.SymbolsService.GetSymbol:output_type -> service.symbols.v1.GetSymbolResponse
+	6,  // 57: service.symbols.v1.SymbolsService.UpdateSymbol:output_type -> service.symbols.v1.UpdateSymbolResponse
+	8,  // 58: service.symbols.v1.SymbolsService.DeleteSymbol:output_type -> service.symbols.v1.DeleteSymbolResponse
+	42, // 59: service.symbols.v1.SymbolsService.ListSymbols:output_type -> service.symbols.v1.ListSymbolsResponse
+	12, // 60: service.symbols.v1.SymbolsService.ListSymbolDependents:output_type -> service.symbols.v1.ListSymbolDependentsResponse
+	14, // 61: service.symbols.v1.SymbolsService.ListSymbolDependencies:output_type -> service.symbols.v1.ListSymbolDependenciesResponse
+	17, // 62: service.symbols.v1.SymbolsService.AcquireSymbolLock:output_type -> service.symbols.v1.AcquireSymbolLockResponse
+	19, // 63: service.symbols.v1.SymbolsService.RenewSymbolLock:output_type -> service.symbols.v1.RenewSymbolLockResponse
+	21, // 64: service.symbols.v1.SymbolsService.ReleaseSymbolLock:output_type -> service.symbols.v1.ReleaseSymbolLockResponse
+	24, // 65: service.symbols.v1.SymbolsService.ListTagKeys:output_type -> service.symbols.v1.ListTagKeysResponse
+	26, // 66: service.symbols.v1.SymbolsService.ListTagValues:output_type -> service.symbols.v1.ListTagValuesResponse
+	29, // 67: service.symbols.v1.SymbolsService.ListSymbolRevisions:output_type -> service.symbols.v1.ListSymbolRevisionsResponse
+	35, // 68: service.symbols.v1.SymbolsService.DiffSymbol:output_type -> service.symbols.v1.DiffSymbolResponse
+	39, // 69: service.symbols.v1.SymbolsService.GetProjectSymbolStats:output_type -> service.symbols.v1.GetProjectSymbolStatsResponse
+	46, // 70: service.symbols.v1.SymbolsService.ListAuditEvents:output_type -> service.symbols.v1.ListAuditEventsResponse
+	55, // [55:71] is the sub-list for method output_type
+	39, // [39:55] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_service_symbols_v1_symbols_proto_init() }
@@ -1034,19 +3477,26 @@ func file_service_symbols_v1_symbols_proto_init() {
 	if File_service_symbols_v1_symbols_proto != nil {
 		return
 	}
-	file_service_symbols_v1_symbols_proto_msgTypes[10].OneofWrappers = []any{}
+	file_service_symbols_v1_symbols_proto_msgTypes[29].OneofWrappers = []any{
+		(*DiffSymbolRequest_Revision)(nil),
+		(*DiffSymbolRequest_OtherId)(nil),
+		(*DiffSymbolRequest_Proposed)(nil),
+	}
+	file_service_symbols_v1_symbols_proto_msgTypes[39].OneofWrappers = []any{}
+	file_service_symbols_v1_symbols_proto_msgTypes[44].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_service_symbols_v1_symbols_proto_rawDesc), len(file_service_symbols_v1_symbols_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      1,
+			NumMessages:   51,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_service_symbols_v1_symbols_proto_goTypes,
 		DependencyIndexes: file_service_symbols_v1_symbols_proto_depIdxs,
+		EnumInfos:         file_service_symbols_v1_symbols_proto_enumTypes,
 		MessageInfos:      file_service_symbols_v1_symbols_proto_msgTypes,
 	}.Build()
 	File_service_symbols_v1_symbols_proto = out.File
@@ -19,11 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SymbolsService_CreateSymbol_FullMethodName = "/service.symbols.v1.SymbolsService/CreateSymbol"
-	SymbolsService_GetSymbol_FullMethodName    = "/service.symbols.v1.SymbolsService/GetSymbol"
-	SymbolsService_UpdateSymbol_FullMethodName = "/service.symbols.v1.SymbolsService/UpdateSymbol"
-	SymbolsService_DeleteSymbol_FullMethodName = "/service.symbols.v1.SymbolsService/DeleteSymbol"
-	SymbolsService_ListSymbols_FullMethodName  = "/service.symbols.v1.SymbolsService/ListSymbols"
+	SymbolsService_CreateSymbol_FullMethodName           = "/service.symbols.v1.SymbolsService/CreateSymbol"
+	SymbolsService_GetSymbol_FullMethodName              = "/service.symbols.v1.SymbolsService/GetSymbol"
+	SymbolsService_UpdateSymbol_FullMethodName           = "/service.symbols.v1.SymbolsService/UpdateSymbol"
+	SymbolsService_DeleteSymbol_FullMethodName           = "/service.symbols.v1.SymbolsService/DeleteSymbol"
+	SymbolsService_ListSymbols_FullMethodName            = "/service.symbols.v1.SymbolsService/ListSymbols"
+	SymbolsService_ListSymbolDependents_FullMethodName   = "/service.symbols.v1.SymbolsService/ListSymbolDependents"
+	SymbolsService_ListSymbolDependencies_FullMethodName = "/service.symbols.v1.SymbolsService/ListSymbolDependencies"
+	SymbolsService_AcquireSymbolLock_FullMethodName      = "/service.symbols.v1.SymbolsService/AcquireSymbolLock"
+	SymbolsService_RenewSymbolLock_FullMethodName        = "/service.symbols.v1.SymbolsService/RenewSymbolLock"
+	SymbolsService_ReleaseSymbolLock_FullMethodName      = "/service.symbols.v1.SymbolsService/ReleaseSymbolLock"
+	SymbolsService_ListTagKeys_FullMethodName            = "/service.symbols.v1.SymbolsService/ListTagKeys"
+	SymbolsService_ListTagValues_FullMethodName          = "/service.symbols.v1.SymbolsService/ListTagValues"
+	SymbolsService_ListSymbolRevisions_FullMethodName    = "/service.symbols.v1.SymbolsService/ListSymbolRevisions"
+	SymbolsService_DiffSymbol_FullMethodName             = "/service.symbols.v1.SymbolsService/DiffSymbol"
+	SymbolsService_GetProjectSymbolStats_FullMethodName  = "/service.symbols.v1.SymbolsService/GetProjectSymbolStats"
+	SymbolsService_ListAuditEvents_FullMethodName        = "/service.symbols.v1.SymbolsService/ListAuditEvents"
 )
 
 // SymbolsServiceClient is the client API for SymbolsService service.
@@ -38,6 +49,30 @@ type SymbolsServiceClient interface {
 	UpdateSymbol(ctx context.Context, in *UpdateSymbolRequest, opts ...grpc.CallOption) (*UpdateSymbolResponse, error)
 	DeleteSymbol(ctx context.Context, in *DeleteSymbolRequest, opts ...grpc.CallOption) (*DeleteSymbolResponse, error)
 	ListSymbols(ctx context.Context, in *ListSymbolsRequest, opts ...grpc.CallOption) (*ListSymbolsResponse, error)
+	// ListSymbolDependents lists the symbols that reference the given symbol.
+	ListSymbolDependents(ctx context.Context, in *ListSymbolDependentsRequest, opts ...grpc.CallOption) (*ListSymbolDependentsResponse, error)
+	// ListSymbolDependencies lists the symbols referenced by the given symbol.
+	ListSymbolDependencies(ctx context.Context, in *ListSymbolDependenciesRequest, opts ...grpc.CallOption) (*ListSymbolDependenciesResponse, error)
+	// AcquireSymbolLock takes an editing lease on a symbol for the calling user session.
+	// While the lease is live, UpdateSymbol fails with FAILED_PRECONDITION for everyone else.
+	AcquireSymbolLock(ctx context.Context, in *AcquireSymbolLockRequest, opts ...grpc.CallOption) (*AcquireSymbolLockResponse, error)
+	// RenewSymbolLock extends the lease held by the calling user session.
+	RenewSymbolLock(ctx context.Context, in *RenewSymbolLockRequest, opts ...grpc.CallOption) (*RenewSymbolLockResponse, error)
+	// ReleaseSymbolLock ends the lease held by the calling user session.
+	// Admins may break a lease held by someone else with force.
+	ReleaseSymbolLock(ctx context.Context, in *ReleaseSymbolLockRequest, opts ...grpc.CallOption) (*ReleaseSymbolLockResponse, error)
+	// ListTagKeys returns the tag keys used in a project with the number of symbols carrying each.
+	ListTagKeys(ctx context.Context, in *ListTagKeysRequest, opts ...grpc.CallOption) (*ListTagKeysResponse, error)
+	// ListTagValues returns the values of a tag key in a project with the number of symbols carrying each.
+	ListTagValues(ctx context.Context, in *ListTagValuesRequest, opts ...grpc.CallOption) (*ListTagValuesResponse, error)
+	// ListSymbolRevisions lists the stored revisions of a symbol, oldest first.
+	ListSymbolRevisions(ctx context.Context, in *ListSymbolRevisionsRequest, opts ...grpc.CallOption) (*ListSymbolRevisionsResponse, error)
+	// DiffSymbol compares a symbol with one of its revisions, another symbol, or a proposed payload.
+	DiffSymbol(ctx context.Context, in *DiffSymbolRequest, opts ...grpc.CallOption) (*DiffSymbolResponse, error)
+	// GetProjectSymbolStats returns aggregate statistics about the symbols of a project.
+	GetProjectSymbolStats(ctx context.Context, in *GetProjectSymbolStatsRequest, opts ...grpc.CallOption) (*GetProjectSymbolStatsResponse, error)
+	// ListAuditEvents lists the recorded mutations of a project's symbols, newest first.
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
 }
 
 type symbolsServiceClient struct {
@@ -98,6 +133,116 @@ func (c *symbolsServiceClient) ListSymbols(ctx context.Context, in *ListSymbolsR
 	return out, nil
 }
 
+func (c *symbolsServiceClient) ListSymbolDependents(ctx context.Context, in *ListSymbolDependentsRequest, opts ...grpc.CallOption) (*ListSymbolDependentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSymbolDependentsResponse)
+	err := c.cc.Invoke(ctx, SymbolsService_ListSymbolDependents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *symbolsServiceClient) ListSymbolDependencies(ctx context.Context, in *ListSymbolDependenciesRequest, opts ...grpc.CallOption) (*ListSymbolDependenciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSymbolDependenciesResponse)
+	err := c.cc.Invoke(ctx, SymbolsService_ListSymbolDependencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *symbolsServiceClient) AcquireSymbolLock(ctx context.Context, in *AcquireSymbolLockRequest, opts ...grpc.CallOption) (*AcquireSymbolLockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcquireSymbolLockResponse)
+	err := c.cc.Invoke(ctx, SymbolsService_AcquireSymbolLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *symbolsServiceClient) RenewSymbolLock(ctx context.Context, in *RenewSymbolLockRequest, opts ...grpc.CallOption) (*RenewSymbolLockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenewSymbolLockResponse)
+	err := c.cc.Invoke(ctx, SymbolsService_RenewSymbolLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *symbolsServiceClient) ReleaseSymbolLock(ctx context.Context, in *ReleaseSymbolLockRequest, opts ...grpc.CallOption) (*ReleaseSymbolLockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseSymbolLockResponse)
+	err := c.cc.Invoke(ctx, SymbolsService_ReleaseSymbolLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *symbolsServiceClient) ListTagKeys(ctx context.Context, in *ListTagKeysRequest, opts ...grpc.CallOption) (*ListTagKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagKeysResponse)
+	err := c.cc.Invoke(ctx, SymbolsService_ListTagKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *symbolsServiceClient) ListTagValues(ctx context.Context, in *ListTagValuesRequest, opts ...grpc.CallOption) (*ListTagValuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagValuesResponse)
+	err := c.cc.Invoke(ctx, SymbolsService_ListTagValues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *symbolsServiceClient) ListSymbolRevisions(ctx context.Context, in *ListSymbolRevisionsRequest, opts ...grpc.CallOption) (*ListSymbolRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSymbolRevisionsResponse)
+	err := c.cc.Invoke(ctx, SymbolsService_ListSymbolRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *symbolsServiceClient) DiffSymbol(ctx context.Context, in *DiffSymbolRequest, opts ...grpc.CallOption) (*DiffSymbolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffSymbolResponse)
+	err := c.cc.Invoke(ctx, SymbolsService_DiffSymbol_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *symbolsServiceClient) GetProjectSymbolStats(ctx context.Context, in *GetProjectSymbolStatsRequest, opts ...grpc.CallOption) (*GetProjectSymbolStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProjectSymbolStatsResponse)
+	err := c.cc.Invoke(ctx, SymbolsService_GetProjectSymbolStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *symbolsServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, SymbolsService_ListAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SymbolsServiceServer is the server API for SymbolsService service.
 // All implementations must embed UnimplementedSymbolsServiceServer
 // for forward compatibility.
@@ -110,6 +255,30 @@ type SymbolsServiceServer interface {
 	UpdateSymbol(context.Context, *UpdateSymbolRequest) (*UpdateSymbolResponse, error)
 	DeleteSymbol(context.Context, *DeleteSymbolRequest) (*DeleteSymbolResponse, error)
 	ListSymbols(context.Context, *ListSymbolsRequest) (*ListSymbolsResponse, error)
+	// ListSymbolDependents lists the symbols that reference the given symbol.
+	ListSymbolDependents(context.Context, *ListSymbolDependentsRequest) (*ListSymbolDependentsResponse, error)
+	// ListSymbolDependencies lists the symbols referenced by the given symbol.
+	ListSymbolDependencies(context.Context, *ListSymbolDependenciesRequest) (*ListSymbolDependenciesResponse, error)
+	// AcquireSymbolLock takes an editing lease on a symbol for the calling user session.
+	// While the lease is live, UpdateSymbol fails with FAILED_PRECONDITION for everyone else.
+	AcquireSymbolLock(context.Context, *AcquireSymbolLockRequest) (*AcquireSymbolLockResponse, error)
+	// RenewSymbolLock extends the lease held by the calling user session.
+	RenewSymbolLock(context.Context, *RenewSymbolLockRequest) (*RenewSymbolLockResponse, error)
+	// ReleaseSymbolLock ends the lease held by the calling user session.
+	// Admins may break a lease held by someone else with force.
+	ReleaseSymbolLock(context.Context, *ReleaseSymbolLockRequest) (*ReleaseSymbolLockResponse, error)
+	// ListTagKeys returns the tag keys used in a project with the number of symbols carrying each.
+	ListTagKeys(context.Context, *ListTagKeysRequest) (*ListTagKeysResponse, error)
+	// ListTagValues returns the values of a tag key in a project with the number of symbols carrying each.
+	ListTagValues(context.Context, *ListTagValuesRequest) (*ListTagValuesResponse, error)
+	// ListSymbolRevisions lists the stored revisions of a symbol, oldest first.
+	ListSymbolRevisions(context.Context, *ListSymbolRevisionsRequest) (*ListSymbolRevisionsResponse, error)
+	// DiffSymbol compares a symbol with one of its revisions, another symbol, or a proposed payload.
+	DiffSymbol(context.Context, *DiffSymbolRequest) (*DiffSymbolResponse, error)
+	// GetProjectSymbolStats returns aggregate statistics about the symbols of a project.
+	GetProjectSymbolStats(context.Context, *GetProjectSymbolStatsRequest) (*GetProjectSymbolStatsResponse, error)
+	// ListAuditEvents lists the recorded mutations of a project's symbols, newest first.
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
 	mustEmbedUnimplementedSymbolsServiceServer()
 }
 
@@ -135,6 +304,39 @@ func (UnimplementedSymbolsServiceServer) DeleteSymbol(context.Context, *DeleteSy
 func (UnimplementedSymbolsServiceServer) ListSymbols(context.Context, *ListSymbolsRequest) (*ListSymbolsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListSymbols not implemented")
 }
+func (UnimplementedSymbolsServiceServer) ListSymbolDependents(context.Context, *ListSymbolDependentsRequest) (*ListSymbolDependentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSymbolDependents not implemented")
+}
+func (UnimplementedSymbolsServiceServer) ListSymbolDependencies(context.Context, *ListSymbolDependenciesRequest) (*ListSymbolDependenciesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSymbolDependencies not implemented")
+}
+func (UnimplementedSymbolsServiceServer) AcquireSymbolLock(context.Context, *AcquireSymbolLockRequest) (*AcquireSymbolLockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcquireSymbolLock not implemented")
+}
+func (UnimplementedSymbolsServiceServer) RenewSymbolLock(context.Context, *RenewSymbolLockRequest) (*RenewSymbolLockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenewSymbolLock not implemented")
+}
+func (UnimplementedSymbolsServiceServer) ReleaseSymbolLock(context.Context, *ReleaseSymbolLockRequest) (*ReleaseSymbolLockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseSymbolLock not implemented")
+}
+func (UnimplementedSymbolsServiceServer) ListTagKeys(context.Context, *ListTagKeysRequest) (*ListTagKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTagKeys not implemented")
+}
+func (UnimplementedSymbolsServiceServer) ListTagValues(context.Context, *ListTagValuesRequest) (*ListTagValuesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTagValues not implemented")
+}
+func (UnimplementedSymbolsServiceServer) ListSymbolRevisions(context.Context, *ListSymbolRevisionsRequest) (*ListSymbolRevisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSymbolRevisions not implemented")
+}
+func (UnimplementedSymbolsServiceServer) DiffSymbol(context.Context, *DiffSymbolRequest) (*DiffSymbolResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffSymbol not implemented")
+}
+func (UnimplementedSymbolsServiceServer) GetProjectSymbolStats(context.Context, *GetProjectSymbolStatsRequest) (*GetProjectSymbolStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProjectSymbolStats not implemented")
+}
+func (UnimplementedSymbolsServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedSymbolsServiceServer) mustEmbedUnimplementedSymbolsServiceServer() {}
 func (UnimplementedSymbolsServiceServer) testEmbeddedByValue()                        {}
 
@@ -246,6 +448,204 @@ func _SymbolsService_ListSymbols_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SymbolsService_ListSymbolDependents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSymbolDependentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbolsServiceServer).ListSymbolDependents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SymbolsService_ListSymbolDependents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbolsServiceServer).ListSymbolDependents(ctx, req.(*ListSymbolDependentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SymbolsService_ListSymbolDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSymbolDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbolsServiceServer).ListSymbolDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SymbolsService_ListSymbolDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbolsServiceServer).ListSymbolDependencies(ctx, req.(*ListSymbolDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SymbolsService_AcquireSymbolLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireSymbolLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbolsServiceServer).AcquireSymbolLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SymbolsService_AcquireSymbolLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbolsServiceServer).AcquireSymbolLock(ctx, req.(*AcquireSymbolLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SymbolsService_RenewSymbolLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewSymbolLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbolsServiceServer).RenewSymbolLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SymbolsService_RenewSymbolLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbolsServiceServer).RenewSymbolLock(ctx, req.(*RenewSymbolLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SymbolsService_ReleaseSymbolLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseSymbolLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbolsServiceServer).ReleaseSymbolLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SymbolsService_ReleaseSymbolLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbolsServiceServer).ReleaseSymbolLock(ctx, req.(*ReleaseSymbolLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SymbolsService_ListTagKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbolsServiceServer).ListTagKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SymbolsService_ListTagKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbolsServiceServer).ListTagKeys(ctx, req.(*ListTagKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SymbolsService_ListTagValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbolsServiceServer).ListTagValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SymbolsService_ListTagValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbolsServiceServer).ListTagValues(ctx, req.(*ListTagValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SymbolsService_ListSymbolRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSymbolRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbolsServiceServer).ListSymbolRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SymbolsService_ListSymbolRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbolsServiceServer).ListSymbolRevisions(ctx, req.(*ListSymbolRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SymbolsService_DiffSymbol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffSymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbolsServiceServer).DiffSymbol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SymbolsService_DiffSymbol_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbolsServiceServer).DiffSymbol(ctx, req.(*DiffSymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SymbolsService_GetProjectSymbolStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectSymbolStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbolsServiceServer).GetProjectSymbolStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SymbolsService_GetProjectSymbolStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbolsServiceServer).GetProjectSymbolStats(ctx, req.(*GetProjectSymbolStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SymbolsService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SymbolsServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SymbolsService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SymbolsServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SymbolsService_ServiceDesc is the grpc.ServiceDesc for SymbolsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -273,6 +673,50 @@ var SymbolsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSymbols",
 			Handler:    _SymbolsService_ListSymbols_Handler,
 		},
+		{
+			MethodName: "ListSymbolDependents",
+			Handler:    _SymbolsService_ListSymbolDependents_Handler,
+		},
+		{
+			MethodName: "ListSymbolDependencies",
+			Handler:    _SymbolsService_ListSymbolDependencies_Handler,
+		},
+		{
+			MethodName: "AcquireSymbolLock",
+			Handler:    _SymbolsService_AcquireSymbolLock_Handler,
+		},
+		{
+			MethodName: "RenewSymbolLock",
+			Handler:    _SymbolsService_RenewSymbolLock_Handler,
+		},
+		{
+			MethodName: "ReleaseSymbolLock",
+			Handler:    _SymbolsService_ReleaseSymbolLock_Handler,
+		},
+		{
+			MethodName: "ListTagKeys",
+			Handler:    _SymbolsService_ListTagKeys_Handler,
+		},
+		{
+			MethodName: "ListTagValues",
+			Handler:    _SymbolsService_ListTagValues_Handler,
+		},
+		{
+			MethodName: "ListSymbolRevisions",
+			Handler:    _SymbolsService_ListSymbolRevisions_Handler,
+		},
+		{
+			MethodName: "DiffSymbol",
+			Handler:    _SymbolsService_DiffSymbol_Handler,
+		},
+		{
+			MethodName: "GetProjectSymbolStats",
+			Handler:    _SymbolsService_GetProjectSymbolStats_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _SymbolsService_ListAuditEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service/symbols/v1/symbols.proto",
@@ -19,18 +19,53 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationSymbolsServiceAcquireSymbolLock = "/service.symbols.v1.SymbolsService/AcquireSymbolLock"
 const OperationSymbolsServiceCreateSymbol = "/service.symbols.v1.SymbolsService/CreateSymbol"
 const OperationSymbolsServiceDeleteSymbol = "/service.symbols.v1.SymbolsService/DeleteSymbol"
+const OperationSymbolsServiceDiffSymbol = "/service.symbols.v1.SymbolsService/DiffSymbol"
+const OperationSymbolsServiceGetProjectSymbolStats = "/service.symbols.v1.SymbolsService/GetProjectSymbolStats"
 const OperationSymbolsServiceGetSymbol = "/service.symbols.v1.SymbolsService/GetSymbol"
+const OperationSymbolsServiceListAuditEvents = "/service.symbols.v1.SymbolsService/ListAuditEvents"
+const OperationSymbolsServiceListSymbolDependencies = "/service.symbols.v1.SymbolsService/ListSymbolDependencies"
+const OperationSymbolsServiceListSymbolDependents = "/service.symbols.v1.SymbolsService/ListSymbolDependents"
+const OperationSymbolsServiceListSymbolRevisions = "/service.symbols.v1.SymbolsService/ListSymbolRevisions"
 const OperationSymbolsServiceListSymbols = "/service.symbols.v1.SymbolsService/ListSymbols"
+const OperationSymbolsServiceListTagKeys = "/service.symbols.v1.SymbolsService/ListTagKeys"
+const OperationSymbolsServiceListTagValues = "/service.symbols.v1.SymbolsService/ListTagValues"
+const OperationSymbolsServiceReleaseSymbolLock = "/service.symbols.v1.SymbolsService/ReleaseSymbolLock"
+const OperationSymbolsServiceRenewSymbolLock = "/service.symbols.v1.SymbolsService/RenewSymbolLock"
 const OperationSymbolsServiceUpdateSymbol = "/service.symbols.v1.SymbolsService/UpdateSymbol"
 
 type SymbolsServiceHTTPServer interface {
+	// AcquireSymbolLock takes an editing lease on a symbol for the calling user session.
+	// While the lease is live, UpdateSymbol fails with FAILED_PRECONDITION for everyone else.
+	AcquireSymbolLock(context.Context, *AcquireSymbolLockRequest) (*AcquireSymbolLockResponse, error)
 	// CreateSymbol Sends a greeting
 	CreateSymbol(context.Context, *CreateSymbolRequest) (*CreateSymbolResponse, error)
 	DeleteSymbol(context.Context, *DeleteSymbolRequest) (*DeleteSymbolResponse, error)
+	// DiffSymbol compares a symbol with one of its revisions, another symbol, or a proposed payload.
+	DiffSymbol(context.Context, *DiffSymbolRequest) (*DiffSymbolResponse, error)
+	// GetProjectSymbolStats returns aggregate statistics about the symbols of a project.
+	GetProjectSymbolStats(context.Context, *GetProjectSymbolStatsRequest) (*GetProjectSymbolStatsResponse, error)
 	GetSymbol(context.Context, *GetSymbolRequest) (*GetSymbolResponse, error)
+	// ListAuditEvents lists the recorded mutations of a project's symbols, newest first.
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// ListSymbolDependencies lists the symbols referenced by the given symbol.
+	ListSymbolDependencies(context.Context, *ListSymbolDependenciesRequest) (*ListSymbolDependenciesResponse, error)
+	// ListSymbolDependents lists the symbols that reference the given symbol.
+	ListSymbolDependents(context.Context, *ListSymbolDependentsRequest) (*ListSymbolDependentsResponse, error)
+	// ListSymbolRevisions lists the stored revisions of a symbol, oldest first.
+	ListSymbolRevisions(context.Context, *ListSymbolRevisionsRequest) (*ListSymbolRevisionsResponse, error)
 	ListSymbols(context.Context, *ListSymbolsRequest) (*ListSymbolsResponse, error)
+	// ListTagKeys returns the tag keys used in a project with the number of symbols carrying each.
+	ListTagKeys(context.Context, *ListTagKeysRequest) (*ListTagKeysResponse, error)
+	// ListTagValues returns the values of a tag key in a project with the number of symbols carrying each.
+	ListTagValues(context.Context, *ListTagValuesRequest) (*ListTagValuesResponse, error)
+	// ReleaseSymbolLock ends the lease held by the calling user session.
+	// Admins may break a lease held by someone else with force.
+	ReleaseSymbolLock(context.Context, *ReleaseSymbolLockRequest) (*ReleaseSymbolLockResponse, error)
+	// RenewSymbolLock extends the lease held by the calling user session.
+	RenewSymbolLock(context.Context, *RenewSymbolLockRequest) (*RenewSymbolLockResponse, error)
 	UpdateSymbol(context.Context, *UpdateSymbolRequest) (*UpdateSymbolResponse, error)
 }
 
@@ -41,6 +76,17 @@ func RegisterSymbolsServiceHTTPServer(s *http.Server, srv SymbolsServiceHTTPServ
 	r.PUT("/v1/symbols/{id}", _SymbolsService_UpdateSymbol0_HTTP_Handler(srv))
 	r.DELETE("/v1/symbols/{id}", _SymbolsService_DeleteSymbol0_HTTP_Handler(srv))
 	r.GET("/v1/projects/{project_id}/symbols", _SymbolsService_ListSymbols0_HTTP_Handler(srv))
+	r.GET("/v1/symbols/{id}/dependents", _SymbolsService_ListSymbolDependents0_HTTP_Handler(srv))
+	r.GET("/v1/symbols/{id}/dependencies", _SymbolsService_ListSymbolDependencies0_HTTP_Handler(srv))
+	r.POST("/v1/symbols/{id}/lock", _SymbolsService_AcquireSymbolLock0_HTTP_Handler(srv))
+	r.PUT("/v1/symbols/{id}/lock", _SymbolsService_RenewSymbolLock0_HTTP_Handler(srv))
+	r.DELETE("/v1/symbols/{id}/lock", _SymbolsService_ReleaseSymbolLock0_HTTP_Handler(srv))
+	r.GET("/v1/projects/{project_id}/tags", _SymbolsService_ListTagKeys0_HTTP_Handler(srv))
+	r.GET("/v1/projects/{project_id}/tags/{key}", _SymbolsService_ListTagValues0_HTTP_Handler(srv))
+	r.GET("/v1/symbols/{id}/revisions", _SymbolsService_ListSymbolRevisions0_HTTP_Handler(srv))
+	r.POST("/v1/symbols/{id}/diff", _SymbolsService_DiffSymbol0_HTTP_Handler(srv))
+	r.GET("/v1/projects/{project_id}/stats", _SymbolsService_GetProjectSymbolStats0_HTTP_Handler(srv))
+	r.GET("/v1/projects/{project_id}/audit-events", _SymbolsService_ListAuditEvents0_HTTP_Handler(srv))
 }
 
 func _SymbolsService_CreateSymbol0_HTTP_Handler(srv SymbolsServiceHTTPServer) func(ctx http.Context) error {
@@ -156,12 +202,287 @@ func _SymbolsService_ListSymbols0_HTTP_Handler(srv SymbolsServiceHTTPServer) fun
 	}
 }
 
+func _SymbolsService_ListSymbolDependents0_HTTP_Handler(srv SymbolsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSymbolDependentsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSymbolsServiceListSymbolDependents)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSymbolDependents(ctx, req.(*ListSymbolDependentsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSymbolDependentsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SymbolsService_ListSymbolDependencies0_HTTP_Handler(srv SymbolsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSymbolDependenciesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSymbolsServiceListSymbolDependencies)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSymbolDependencies(ctx, req.(*ListSymbolDependenciesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSymbolDependenciesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SymbolsService_AcquireSymbolLock0_HTTP_Handler(srv SymbolsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AcquireSymbolLockRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSymbolsServiceAcquireSymbolLock)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AcquireSymbolLock(ctx, req.(*AcquireSymbolLockRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AcquireSymbolLockResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SymbolsService_RenewSymbolLock0_HTTP_Handler(srv SymbolsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RenewSymbolLockRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSymbolsServiceRenewSymbolLock)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RenewSymbolLock(ctx, req.(*RenewSymbolLockRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RenewSymbolLockResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SymbolsService_ReleaseSymbolLock0_HTTP_Handler(srv SymbolsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReleaseSymbolLockRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSymbolsServiceReleaseSymbolLock)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReleaseSymbolLock(ctx, req.(*ReleaseSymbolLockRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReleaseSymbolLockResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SymbolsService_ListTagKeys0_HTTP_Handler(srv SymbolsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTagKeysRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSymbolsServiceListTagKeys)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTagKeys(ctx, req.(*ListTagKeysRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTagKeysResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SymbolsService_ListTagValues0_HTTP_Handler(srv SymbolsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTagValuesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSymbolsServiceListTagValues)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTagValues(ctx, req.(*ListTagValuesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTagValuesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SymbolsService_ListSymbolRevisions0_HTTP_Handler(srv SymbolsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSymbolRevisionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSymbolsServiceListSymbolRevisions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSymbolRevisions(ctx, req.(*ListSymbolRevisionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSymbolRevisionsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SymbolsService_DiffSymbol0_HTTP_Handler(srv SymbolsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DiffSymbolRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSymbolsServiceDiffSymbol)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DiffSymbol(ctx, req.(*DiffSymbolRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DiffSymbolResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SymbolsService_GetProjectSymbolStats0_HTTP_Handler(srv SymbolsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetProjectSymbolStatsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSymbolsServiceGetProjectSymbolStats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetProjectSymbolStats(ctx, req.(*GetProjectSymbolStatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetProjectSymbolStatsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SymbolsService_ListAuditEvents0_HTTP_Handler(srv SymbolsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAuditEventsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSymbolsServiceListAuditEvents)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAuditEventsResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type SymbolsServiceHTTPClient interface {
+	// AcquireSymbolLock takes an editing lease on a symbol for the calling user session.
+	// While the lease is live, UpdateSymbol fails with FAILED_PRECONDITION for everyone else.
+	AcquireSymbolLock(ctx context.Context, req *AcquireSymbolLockRequest, opts ...http.CallOption) (rsp *AcquireSymbolLockResponse, err error)
 	// CreateSymbol Sends a greeting
 	CreateSymbol(ctx context.Context, req *CreateSymbolRequest, opts ...http.CallOption) (rsp *CreateSymbolResponse, err error)
 	DeleteSymbol(ctx context.Context, req *DeleteSymbolRequest, opts ...http.CallOption) (rsp *DeleteSymbolResponse, err error)
+	// DiffSymbol compares a symbol with one of its revisions, another symbol, or a proposed payload.
+	DiffSymbol(ctx context.Context, req *DiffSymbolRequest, opts ...http.CallOption) (rsp *DiffSymbolResponse, err error)
+	// GetProjectSymbolStats returns aggregate statistics about the symbols of a project.
+	GetProjectSymbolStats(ctx context.Context, req *GetProjectSymbolStatsRequest, opts ...http.CallOption) (rsp *GetProjectSymbolStatsResponse, err error)
 	GetSymbol(ctx context.Context, req *GetSymbolRequest, opts ...http.CallOption) (rsp *GetSymbolResponse, err error)
+	// ListAuditEvents lists the recorded mutations of a project's symbols, newest first.
+	ListAuditEvents(ctx context.Context, req *ListAuditEventsRequest, opts ...http.CallOption) (rsp *ListAuditEventsResponse, err error)
+	// ListSymbolDependencies lists the symbols referenced by the given symbol.
+	ListSymbolDependencies(ctx context.Context, req *ListSymbolDependenciesRequest, opts ...http.CallOption) (rsp *ListSymbolDependenciesResponse, err error)
+	// ListSymbolDependents lists the symbols that reference the given symbol.
+	ListSymbolDependents(ctx context.Context, req *ListSymbolDependentsRequest, opts ...http.CallOption) (rsp *ListSymbolDependentsResponse, err error)
+	// ListSymbolRevisions lists the stored revisions of a symbol, oldest first.
+	ListSymbolRevisions(ctx context.Context, req *ListSymbolRevisionsRequest, opts ...http.CallOption) (rsp *ListSymbolRevisionsResponse, err error)
 	ListSymbols(ctx context.Context, req *ListSymbolsRequest, opts ...http.CallOption) (rsp *ListSymbolsResponse, err error)
+	// ListTagKeys returns the tag keys used in a project with the number of symbols carrying each.
+	ListTagKeys(ctx context.Context, req *ListTagKeysRequest, opts ...http.CallOption) (rsp *ListTagKeysResponse, err error)
+	// ListTagValues returns the values of a tag key in a project with the number of symbols carrying each.
+	ListTagValues(ctx context.Context, req *ListTagValuesRequest, opts ...http.CallOption) (rsp *ListTagValuesResponse, err error)
+	// ReleaseSymbolLock ends the lease held by the calling user session.
+	// Admins may break a lease held by someone else with force.
+	ReleaseSymbolLock(ctx context.Context, req *ReleaseSymbolLockRequest, opts ...http.CallOption) (rsp *ReleaseSymbolLockResponse, err error)
+	// RenewSymbolLock extends the lease held by the calling user session.
+	RenewSymbolLock(ctx context.Context, req *RenewSymbolLockRequest, opts ...http.CallOption) (rsp *RenewSymbolLockResponse, err error)
 	UpdateSymbol(ctx context.Context, req *UpdateSymbolRequest, opts ...http.CallOption) (rsp *UpdateSymbolResponse, err error)
 }
 
@@ -173,6 +494,21 @@ func NewSymbolsServiceHTTPClient(client *http.Client) SymbolsServiceHTTPClient {
 	return &SymbolsServiceHTTPClientImpl{client}
 }
 
+// AcquireSymbolLock takes an editing lease on a symbol for the calling user session.
+// While the lease is live, UpdateSymbol fails with FAILED_PRECONDITION for everyone else.
+func (c *SymbolsServiceHTTPClientImpl) AcquireSymbolLock(ctx context.Context, in *AcquireSymbolLockRequest, opts ...http.CallOption) (*AcquireSymbolLockResponse, error) {
+	var out AcquireSymbolLockResponse
+	pattern := "/v1/symbols/{id}/lock"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSymbolsServiceAcquireSymbolLock))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // CreateSymbol Sends a greeting
 func (c *SymbolsServiceHTTPClientImpl) CreateSymbol(ctx context.Context, in *CreateSymbolRequest, opts ...http.CallOption) (*CreateSymbolResponse, error) {
 	var out CreateSymbolResponse
@@ -200,6 +536,34 @@ func (c *SymbolsServiceHTTPClientImpl) DeleteSymbol(ctx context.Context, in *Del
 	return &out, nil
 }
 
+// DiffSymbol compares a symbol with one of its revisions, another symbol, or a proposed payload.
+func (c *SymbolsServiceHTTPClientImpl) DiffSymbol(ctx context.Context, in *DiffSymbolRequest, opts ...http.CallOption) (*DiffSymbolResponse, error) {
+	var out DiffSymbolResponse
+	pattern := "/v1/symbols/{id}/diff"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSymbolsServiceDiffSymbol))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetProjectSymbolStats returns aggregate statistics about the symbols of a project.
+func (c *SymbolsServiceHTTPClientImpl) GetProjectSymbolStats(ctx context.Context, in *GetProjectSymbolStatsRequest, opts ...http.CallOption) (*GetProjectSymbolStatsResponse, error) {
+	var out GetProjectSymbolStatsResponse
+	pattern := "/v1/projects/{project_id}/stats"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSymbolsServiceGetProjectSymbolStats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *SymbolsServiceHTTPClientImpl) GetSymbol(ctx context.Context, in *GetSymbolRequest, opts ...http.CallOption) (*GetSymbolResponse, error) {
 	var out GetSymbolResponse
 	pattern := "/v1/symbols/{id}"
@@ -213,6 +577,62 @@ func (c *SymbolsServiceHTTPClientImpl) GetSymbol(ctx context.Context, in *GetSym
 	return &out, nil
 }
 
+// ListAuditEvents lists the recorded mutations of a project's symbols, newest first.
+func (c *SymbolsServiceHTTPClientImpl) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...http.CallOption) (*ListAuditEventsResponse, error) {
+	var out ListAuditEventsResponse
+	pattern := "/v1/projects/{project_id}/audit-events"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSymbolsServiceListAuditEvents))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListSymbolDependencies lists the symbols referenced by the given symbol.
+func (c *SymbolsServiceHTTPClientImpl) ListSymbolDependencies(ctx context.Context, in *ListSymbolDependenciesRequest, opts ...http.CallOption) (*ListSymbolDependenciesResponse, error) {
+	var out ListSymbolDependenciesResponse
+	pattern := "/v1/symbols/{id}/dependencies"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSymbolsServiceListSymbolDependencies))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListSymbolDependents lists the symbols that reference the given symbol.
+func (c *SymbolsServiceHTTPClientImpl) ListSymbolDependents(ctx context.Context, in *ListSymbolDependentsRequest, opts ...http.CallOption) (*ListSymbolDependentsResponse, error) {
+	var out ListSymbolDependentsResponse
+	pattern := "/v1/symbols/{id}/dependents"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSymbolsServiceListSymbolDependents))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListSymbolRevisions lists the stored revisions of a symbol, oldest first.
+func (c *SymbolsServiceHTTPClientImpl) ListSymbolRevisions(ctx context.Context, in *ListSymbolRevisionsRequest, opts ...http.CallOption) (*ListSymbolRevisionsResponse, error) {
+	var out ListSymbolRevisionsResponse
+	pattern := "/v1/symbols/{id}/revisions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSymbolsServiceListSymbolRevisions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *SymbolsServiceHTTPClientImpl) ListSymbols(ctx context.Context, in *ListSymbolsRequest, opts ...http.CallOption) (*ListSymbolsResponse, error) {
 	var out ListSymbolsResponse
 	pattern := "/v1/projects/{project_id}/symbols"
@@ -226,6 +646,63 @@ func (c *SymbolsServiceHTTPClientImpl) ListSymbols(ctx context.Context, in *List
 	return &out, nil
 }
 
+// ListTagKeys returns the tag keys used in a project with the number of symbols carrying each.
+func (c *SymbolsServiceHTTPClientImpl) ListTagKeys(ctx context.Context, in *ListTagKeysRequest, opts ...http.CallOption) (*ListTagKeysResponse, error) {
+	var out ListTagKeysResponse
+	pattern := "/v1/projects/{project_id}/tags"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSymbolsServiceListTagKeys))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTagValues returns the values of a tag key in a project with the number of symbols carrying each.
+func (c *SymbolsServiceHTTPClientImpl) ListTagValues(ctx context.Context, in *ListTagValuesRequest, opts ...http.CallOption) (*ListTagValuesResponse, error) {
+	var out ListTagValuesResponse
+	pattern := "/v1/projects/{project_id}/tags/{key}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSymbolsServiceListTagValues))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReleaseSymbolLock ends the lease held by the calling user session.
+// Admins may break a lease held by someone else with force.
+func (c *SymbolsServiceHTTPClientImpl) ReleaseSymbolLock(ctx context.Context, in *ReleaseSymbolLockRequest, opts ...http.CallOption) (*ReleaseSymbolLockResponse, error) {
+	var out ReleaseSymbolLockResponse
+	pattern := "/v1/symbols/{id}/lock"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationSymbolsServiceReleaseSymbolLock))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RenewSymbolLock extends the lease held by the calling user session.
+func (c *SymbolsServiceHTTPClientImpl) RenewSymbolLock(ctx context.Context, in *RenewSymbolLockRequest, opts ...http.CallOption) (*RenewSymbolLockResponse, error) {
+	var out RenewSymbolLockResponse
+	pattern := "/v1/symbols/{id}/lock"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSymbolsServiceRenewSymbolLock))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *SymbolsServiceHTTPClientImpl) UpdateSymbol(ctx context.Context, in *UpdateSymbolRequest, opts ...http.CallOption) (*UpdateSymbolResponse, error) {
 	var out UpdateSymbolResponse
 	pattern := "/v1/symbols/{id}"
@@ -48,6 +48,39 @@ const (
 	// SymbolsServiceListSymbolsProcedure is the fully-qualified name of the SymbolsService's
 	// ListSymbols RPC.
 	SymbolsServiceListSymbolsProcedure = "/service.symbols.v1.SymbolsService/ListSymbols"
+	// SymbolsServiceListSymbolDependentsProcedure is the fully-qualified name of the SymbolsService's
+	// ListSymbolDependents RPC.
+	SymbolsServiceListSymbolDependentsProcedure = "/service.symbols.v1.SymbolsService/ListSymbolDependents"
+	// SymbolsServiceListSymbolDependenciesProcedure is the fully-qualified name of the SymbolsService's
+	// ListSymbolDependencies RPC.
+	SymbolsServiceListSymbolDependenciesProcedure = "/service.symbols.v1.SymbolsService/ListSymbolDependencies"
+	// SymbolsServiceAcquireSymbolLockProcedure is the fully-qualified name of the SymbolsService's
+	// AcquireSymbolLock RPC.
+	SymbolsServiceAcquireSymbolLockProcedure = "/service.symbols.v1.SymbolsService/AcquireSymbolLock"
+	// SymbolsServiceRenewSymbolLockProcedure is the fully-qualified name of the SymbolsService's
+	// RenewSymbolLock RPC.
+	SymbolsServiceRenewSymbolLockProcedure = "/service.symbols.v1.SymbolsService/RenewSymbolLock"
+	// SymbolsServiceReleaseSymbolLockProcedure is the fully-qualified name of the SymbolsService's
+	// ReleaseSymbolLock RPC.
+	SymbolsServiceReleaseSymbolLockProcedure = "/service.symbols.v1.SymbolsService/ReleaseSymbolLock"
+	// SymbolsServiceListTagKeysProcedure is the fully-qualified name of the SymbolsService's
+	// ListTagKeys RPC.
+	SymbolsServiceListTagKeysProcedure = "/service.symbols.v1.SymbolsService/ListTagKeys"
+	// SymbolsServiceListTagValuesProcedure is the fully-qualified name of the SymbolsService's
+	// ListTagValues RPC.
+	SymbolsServiceListTagValuesProcedure = "/service.symbols.v1.SymbolsService/ListTagValues"
+	// SymbolsServiceListSymbolRevisionsProcedure is the fully-qualified name of the SymbolsService's
+	// ListSymbolRevisions RPC.
+	SymbolsServiceListSymbolRevisionsProcedure = "/service.symbols.v1.SymbolsService/ListSymbolRevisions"
+	// SymbolsServiceDiffSymbolProcedure is the fully-qualified name of the SymbolsService's DiffSymbol
+	// RPC.
+	SymbolsServiceDiffSymbolProcedure = "/service.symbols.v1.SymbolsService/DiffSymbol"
+	// SymbolsServiceGetProjectSymbolStatsProcedure is the fully-qualified name of the SymbolsService's
+	// GetProjectSymbolStats RPC.
+	SymbolsServiceGetProjectSymbolStatsProcedure = "/service.symbols.v1.SymbolsService/GetProjectSymbolStats"
+	// SymbolsServiceListAuditEventsProcedure is the fully-qualified name of the SymbolsService's
+	// ListAuditEvents RPC.
+	SymbolsServiceListAuditEventsProcedure = "/service.symbols.v1.SymbolsService/ListAuditEvents"
 )
 
 // SymbolsServiceClient is a client for the service.symbols.v1.SymbolsService service.
@@ -58,6 +91,30 @@ type SymbolsServiceClient interface {
 	UpdateSymbol(context.Context, *v1.UpdateSymbolRequest) (*v1.UpdateSymbolResponse, error)
 	DeleteSymbol(context.Context, *v1.DeleteSymbolRequest) (*v1.DeleteSymbolResponse, error)
 	ListSymbols(context.Context, *v1.ListSymbolsRequest) (*v1.ListSymbolsResponse, error)
+	// ListSymbolDependents lists the symbols that reference the given symbol.
+	ListSymbolDependents(context.Context, *v1.ListSymbolDependentsRequest) (*v1.ListSymbolDependentsResponse, error)
+	// ListSymbolDependencies lists the symbols referenced by the given symbol.
+	ListSymbolDependencies(context.Context, *v1.ListSymbolDependenciesRequest) (*v1.ListSymbolDependenciesResponse, error)
+	// AcquireSymbolLock takes an editing lease on a symbol for the calling user session.
+	// While the lease is live, UpdateSymbol fails with FAILED_PRECONDITION for everyone else.
+	AcquireSymbolLock(context.Context, *v1.AcquireSymbolLockRequest) (*v1.AcquireSymbolLockResponse, error)
+	// RenewSymbolLock extends the lease held by the calling user session.
+	RenewSymbolLock(context.Context, *v1.RenewSymbolLockRequest) (*v1.RenewSymbolLockResponse, error)
+	// ReleaseSymbolLock ends the lease held by the calling user session.
+	// Admins may break a lease held by someone else with force.
+	ReleaseSymbolLock(context.Context, *v1.ReleaseSymbolLockRequest) (*v1.ReleaseSymbolLockResponse, error)
+	// ListTagKeys returns the tag keys used in a project with the number of symbols carrying each.
+	ListTagKeys(context.Context, *v1.ListTagKeysRequest) (*v1.ListTagKeysResponse, error)
+	// ListTagValues returns the values of a tag key in a project with the number of symbols carrying each.
+	ListTagValues(context.Context, *v1.ListTagValuesRequest) (*v1.ListTagValuesResponse, error)
+	// ListSymbolRevisions lists the stored revisions of a symbol, oldest first.
+	ListSymbolRevisions(context.Context, *v1.ListSymbolRevisionsRequest) (*v1.ListSymbolRevisionsResponse, error)
+	// DiffSymbol compares a symbol with one of its revisions, another symbol, or a proposed payload.
+	DiffSymbol(context.Context, *v1.DiffSymbolRequest) (*v1.DiffSymbolResponse, error)
+	// GetProjectSymbolStats returns aggregate statistics about the symbols of a project.
+	GetProjectSymbolStats(context.Context, *v1.GetProjectSymbolStatsRequest) (*v1.GetProjectSymbolStatsResponse, error)
+	// ListAuditEvents lists the recorded mutations of a project's symbols, newest first.
+	ListAuditEvents(context.Context, *v1.ListAuditEventsRequest) (*v1.ListAuditEventsResponse, error)
 }
 
 // NewSymbolsServiceClient constructs a client for the service.symbols.v1.SymbolsService service. By
@@ -101,16 +158,93 @@ func NewSymbolsServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(symbolsServiceMethods.ByName("ListSymbols")),
 			connect.WithClientOptions(opts...),
 		),
+		listSymbolDependents: connect.NewClient[v1.ListSymbolDependentsRequest, v1.ListSymbolDependentsResponse](
+			httpClient,
+			baseURL+SymbolsServiceListSymbolDependentsProcedure,
+			connect.WithSchema(symbolsServiceMethods.ByName("ListSymbolDependents")),
+			connect.WithClientOptions(opts...),
+		),
+		listSymbolDependencies: connect.NewClient[v1.ListSymbolDependenciesRequest, v1.ListSymbolDependenciesResponse](
+			httpClient,
+			baseURL+SymbolsServiceListSymbolDependenciesProcedure,
+			connect.WithSchema(symbolsServiceMethods.ByName("ListSymbolDependencies")),
+			connect.WithClientOptions(opts...),
+		),
+		acquireSymbolLock: connect.NewClient[v1.AcquireSymbolLockRequest, v1.AcquireSymbolLockResponse](
+			httpClient,
+			baseURL+SymbolsServiceAcquireSymbolLockProcedure,
+			connect.WithSchema(symbolsServiceMethods.ByName("AcquireSymbolLock")),
+			connect.WithClientOptions(opts...),
+		),
+		renewSymbolLock: connect.NewClient[v1.RenewSymbolLockRequest, v1.RenewSymbolLockResponse](
+			httpClient,
+			baseURL+SymbolsServiceRenewSymbolLockProcedure,
+			connect.WithSchema(symbolsServiceMethods.ByName("RenewSymbolLock")),
+			connect.WithClientOptions(opts...),
+		),
+		releaseSymbolLock: connect.NewClient[v1.ReleaseSymbolLockRequest, v1.ReleaseSymbolLockResponse](
+			httpClient,
+			baseURL+SymbolsServiceReleaseSymbolLockProcedure,
+			connect.WithSchema(symbolsServiceMethods.ByName("ReleaseSymbolLock")),
+			connect.WithClientOptions(opts...),
+		),
+		listTagKeys: connect.NewClient[v1.ListTagKeysRequest, v1.ListTagKeysResponse](
+			httpClient,
+			baseURL+SymbolsServiceListTagKeysProcedure,
+			connect.WithSchema(symbolsServiceMethods.ByName("ListTagKeys")),
+			connect.WithClientOptions(opts...),
+		),
+		listTagValues: connect.NewClient[v1.ListTagValuesRequest, v1.ListTagValuesResponse](
+			httpClient,
+			baseURL+SymbolsServiceListTagValuesProcedure,
+			connect.WithSchema(symbolsServiceMethods.ByName("ListTagValues")),
+			connect.WithClientOptions(opts...),
+		),
+		listSymbolRevisions: connect.NewClient[v1.ListSymbolRevisionsRequest, v1.ListSymbolRevisionsResponse](
+			httpClient,
+			baseURL+SymbolsServiceListSymbolRevisionsProcedure,
+			connect.WithSchema(symbolsServiceMethods.ByName("ListSymbolRevisions")),
+			connect.WithClientOptions(opts...),
+		),
+		diffSymbol: connect.NewClient[v1.DiffSymbolRequest, v1.DiffSymbolResponse](
+			httpClient,
+			baseURL+SymbolsServiceDiffSymbolProcedure,
+			connect.WithSchema(symbolsServiceMethods.ByName("DiffSymbol")),
+			connect.WithClientOptions(opts...),
+		),
+		getProjectSymbolStats: connect.NewClient[v1.GetProjectSymbolStatsRequest, v1.GetProjectSymbolStatsResponse](
+			httpClient,
+			baseURL+SymbolsServiceGetProjectSymbolStatsProcedure,
+			connect.WithSchema(symbolsServiceMethods.ByName("GetProjectSymbolStats")),
+			connect.WithClientOptions(opts...),
+		),
+		listAuditEvents: connect.NewClient[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse](
+			httpClient,
+			baseURL+SymbolsServiceListAuditEventsProcedure,
+			connect.WithSchema(symbolsServiceMethods.ByName("ListAuditEvents")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // symbolsServiceClient implements SymbolsServiceClient.
 type symbolsServiceClient struct {
-	createSymbol *connect.Client[v1.CreateSymbolRequest, v1.CreateSymbolResponse]
-	getSymbol    *connect.Client[v1.GetSymbolRequest, v1.GetSymbolResponse]
-	updateSymbol *connect.Client[v1.UpdateSymbolRequest, v1.UpdateSymbolResponse]
-	deleteSymbol *connect.Client[v1.DeleteSymbolRequest, v1.DeleteSymbolResponse]
-	listSymbols  *connect.Client[v1.ListSymbolsRequest, v1.ListSymbolsResponse]
+	createSymbol           *connect.Client[v1.CreateSymbolRequest, v1.CreateSymbolResponse]
+	getSymbol              *connect.Client[v1.GetSymbolRequest, v1.GetSymbolResponse]
+	updateSymbol           *connect.Client[v1.UpdateSymbolRequest, v1.UpdateSymbolResponse]
+	deleteSymbol           *connect.Client[v1.DeleteSymbolRequest, v1.DeleteSymbolResponse]
+	listSymbols            *connect.Client[v1.ListSymbolsRequest, v1.ListSymbolsResponse]
+	listSymbolDependents   *connect.Client[v1.ListSymbolDependentsRequest, v1.ListSymbolDependentsResponse]
+	listSymbolDependencies *connect.Client[v1.ListSymbolDependenciesRequest, v1.ListSymbolDependenciesResponse]
+	acquireSymbolLock      *connect.Client[v1.AcquireSymbolLockRequest, v1.AcquireSymbolLockResponse]
+	renewSymbolLock        *connect.Client[v1.RenewSymbolLockRequest, v1.RenewSymbolLockResponse]
+	releaseSymbolLock      *connect.Client[v1.ReleaseSymbolLockRequest, v1.ReleaseSymbolLockResponse]
+	listTagKeys            *connect.Client[v1.ListTagKeysRequest, v1.ListTagKeysResponse]
+	listTagValues          *connect.Client[v1.ListTagValuesRequest, v1.ListTagValuesResponse]
+	listSymbolRevisions    *connect.Client[v1.ListSymbolRevisionsRequest, v1.ListSymbolRevisionsResponse]
+	diffSymbol             *connect.Client[v1.DiffSymbolRequest, v1.DiffSymbolResponse]
+	getProjectSymbolStats  *connect.Client[v1.GetProjectSymbolStatsRequest, v1.GetProjectSymbolStatsResponse]
+	listAuditEvents        *connect.Client[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse]
 }
 
 // CreateSymbol calls service.symbols.v1.SymbolsService.CreateSymbol.
@@ -158,6 +292,105 @@ func (c *symbolsServiceClient) ListSymbols(ctx context.Context, req *v1.ListSymb
 	return nil, err
 }
 
+// ListSymbolDependents calls service.symbols.v1.SymbolsService.ListSymbolDependents.
+func (c *symbolsServiceClient) ListSymbolDependents(ctx context.Context, req *v1.ListSymbolDependentsRequest) (*v1.ListSymbolDependentsResponse, error) {
+	response, err := c.listSymbolDependents.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListSymbolDependencies calls service.symbols.v1.SymbolsService.ListSymbolDependencies.
+func (c *symbolsServiceClient) ListSymbolDependencies(ctx context.Context, req *v1.ListSymbolDependenciesRequest) (*v1.ListSymbolDependenciesResponse, error) {
+	response, err := c.listSymbolDependencies.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AcquireSymbolLock calls service.symbols.v1.SymbolsService.AcquireSymbolLock.
+func (c *symbolsServiceClient) AcquireSymbolLock(ctx context.Context, req *v1.AcquireSymbolLockRequest) (*v1.AcquireSymbolLockResponse, error) {
+	response, err := c.acquireSymbolLock.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RenewSymbolLock calls service.symbols.v1.SymbolsService.RenewSymbolLock.
+func (c *symbolsServiceClient) RenewSymbolLock(ctx context.Context, req *v1.RenewSymbolLockRequest) (*v1.RenewSymbolLockResponse, error) {
+	response, err := c.renewSymbolLock.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ReleaseSymbolLock calls service.symbols.v1.SymbolsService.ReleaseSymbolLock.
+func (c *symbolsServiceClient) ReleaseSymbolLock(ctx context.Context, req *v1.ReleaseSymbolLockRequest) (*v1.ReleaseSymbolLockResponse, error) {
+	response, err := c.releaseSymbolLock.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListTagKeys calls service.symbols.v1.SymbolsService.ListTagKeys.
+func (c *symbolsServiceClient) ListTagKeys(ctx context.Context, req *v1.ListTagKeysRequest) (*v1.ListTagKeysResponse, error) {
+	response, err := c.listTagKeys.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListTagValues calls service.symbols.v1.SymbolsService.ListTagValues.
+func (c *symbolsServiceClient) ListTagValues(ctx context.Context, req *v1.ListTagValuesRequest) (*v1.ListTagValuesResponse, error) {
+	response, err := c.listTagValues.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListSymbolRevisions calls service.symbols.v1.SymbolsService.ListSymbolRevisions.
+func (c *symbolsServiceClient) ListSymbolRevisions(ctx context.Context, req *v1.ListSymbolRevisionsRequest) (*v1.ListSymbolRevisionsResponse, error) {
+	response, err := c.listSymbolRevisions.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DiffSymbol calls service.symbols.v1.SymbolsService.DiffSymbol.
+func (c *symbolsServiceClient) DiffSymbol(ctx context.Context, req *v1.DiffSymbolRequest) (*v1.DiffSymbolResponse, error) {
+	response, err := c.diffSymbol.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetProjectSymbolStats calls service.symbols.v1.SymbolsService.GetProjectSymbolStats.
+func (c *symbolsServiceClient) GetProjectSymbolStats(ctx context.Context, req *v1.GetProjectSymbolStatsRequest) (*v1.GetProjectSymbolStatsResponse, error) {
+	response, err := c.getProjectSymbolStats.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListAuditEvents calls service.symbols.v1.SymbolsService.ListAuditEvents.
+func (c *symbolsServiceClient) ListAuditEvents(ctx context.Context, req *v1.ListAuditEventsRequest) (*v1.ListAuditEventsResponse, error) {
+	response, err := c.listAuditEvents.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // SymbolsServiceHandler is an implementation of the service.symbols.v1.SymbolsService service.
 type SymbolsServiceHandler interface {
 	// Sends a greeting
@@ -166,6 +399,30 @@ type SymbolsServiceHandler interface {
 	UpdateSymbol(context.Context, *v1.UpdateSymbolRequest) (*v1.UpdateSymbolResponse, error)
 	DeleteSymbol(context.Context, *v1.DeleteSymbolRequest) (*v1.DeleteSymbolResponse, error)
 	ListSymbols(context.Context, *v1.ListSymbolsRequest) (*v1.ListSymbolsResponse, error)
+	// ListSymbolDependents lists the symbols that reference the given symbol.
+	ListSymbolDependents(context.Context, *v1.ListSymbolDependentsRequest) (*v1.ListSymbolDependentsResponse, error)
+	// ListSymbolDependencies lists the symbols referenced by the given symbol.
+	ListSymbolDependencies(context.Context, *v1.ListSymbolDependenciesRequest) (*v1.ListSymbolDependenciesResponse, error)
+	// AcquireSymbolLock takes an editing lease on a symbol for the calling user session.
+	// While the lease is live, UpdateSymbol fails with FAILED_PRECONDITION for everyone else.
+	AcquireSymbolLock(context.Context, *v1.AcquireSymbolLockRequest) (*v1.AcquireSymbolLockResponse, error)
+	// RenewSymbolLock extends the lease held by the calling user session.
+	RenewSymbolLock(context.Context, *v1.RenewSymbolLockRequest) (*v1.RenewSymbolLockResponse, error)
+	// ReleaseSymbolLock ends the lease held by the calling user session.
+	// Admins may break a lease held by someone else with force.
+	ReleaseSymbolLock(context.Context, *v1.ReleaseSymbolLockRequest) (*v1.ReleaseSymbolLockResponse, error)
+	// ListTagKeys returns the tag keys used in a project with the number of symbols carrying each.
+	ListTagKeys(context.Context, *v1.ListTagKeysRequest) (*v1.ListTagKeysResponse, error)
+	// ListTagValues returns the values of a tag key in a project with the number of symbols carrying each.
+	ListTagValues(context.Context, *v1.ListTagValuesRequest) (*v1.ListTagValuesResponse, error)
+	// ListSymbolRevisions lists the stored revisions of a symbol, oldest first.
+	ListSymbolRevisions(context.Context, *v1.ListSymbolRevisionsRequest) (*v1.ListSymbolRevisionsResponse, error)
+	// DiffSymbol compares a symbol with one of its revisions, another symbol, or a proposed payload.
+	DiffSymbol(context.Context, *v1.DiffSymbolRequest) (*v1.DiffSymbolResponse, error)
+	// GetProjectSymbolStats returns aggregate statistics about the symbols of a project.
+	GetProjectSymbolStats(context.Context, *v1.GetProjectSymbolStatsRequest) (*v1.GetProjectSymbolStatsResponse, error)
+	// ListAuditEvents lists the recorded mutations of a project's symbols, newest first.
+	ListAuditEvents(context.Context, *v1.ListAuditEventsRequest) (*v1.ListAuditEventsResponse, error)
 }
 
 // NewSymbolsServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -205,6 +462,72 @@ func NewSymbolsServiceHandler(svc SymbolsServiceHandler, opts ...connect.Handler
 		connect.WithSchema(symbolsServiceMethods.ByName("ListSymbols")),
 		connect.WithHandlerOptions(opts...),
 	)
+	symbolsServiceListSymbolDependentsHandler := connect.NewUnaryHandlerSimple(
+		SymbolsServiceListSymbolDependentsProcedure,
+		svc.ListSymbolDependents,
+		connect.WithSchema(symbolsServiceMethods.ByName("ListSymbolDependents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	symbolsServiceListSymbolDependenciesHandler := connect.NewUnaryHandlerSimple(
+		SymbolsServiceListSymbolDependenciesProcedure,
+		svc.ListSymbolDependencies,
+		connect.WithSchema(symbolsServiceMethods.ByName("ListSymbolDependencies")),
+		connect.WithHandlerOptions(opts...),
+	)
+	symbolsServiceAcquireSymbolLockHandler := connect.NewUnaryHandlerSimple(
+		SymbolsServiceAcquireSymbolLockProcedure,
+		svc.AcquireSymbolLock,
+		connect.WithSchema(symbolsServiceMethods.ByName("AcquireSymbolLock")),
+		connect.WithHandlerOptions(opts...),
+	)
+	symbolsServiceRenewSymbolLockHandler := connect.NewUnaryHandlerSimple(
+		SymbolsServiceRenewSymbolLockProcedure,
+		svc.RenewSymbolLock,
+		connect.WithSchema(symbolsServiceMethods.ByName("RenewSymbolLock")),
+		connect.WithHandlerOptions(opts...),
+	)
+	symbolsServiceReleaseSymbolLockHandler := connect.NewUnaryHandlerSimple(
+		SymbolsServiceReleaseSymbolLockProcedure,
+		svc.ReleaseSymbolLock,
+		connect.WithSchema(symbolsServiceMethods.ByName("ReleaseSymbolLock")),
+		connect.WithHandlerOptions(opts...),
+	)
+	symbolsServiceListTagKeysHandler := connect.NewUnaryHandlerSimple(
+		SymbolsServiceListTagKeysProcedure,
+		svc.ListTagKeys,
+		connect.WithSchema(symbolsServiceMethods.ByName("ListTagKeys")),
+		connect.WithHandlerOptions(opts...),
+	)
+	symbolsServiceListTagValuesHandler := connect.NewUnaryHandlerSimple(
+		SymbolsServiceListTagValuesProcedure,
+		svc.ListTagValues,
+		connect.WithSchema(symbolsServiceMethods.ByName("ListTagValues")),
+		connect.WithHandlerOptions(opts...),
+	)
+	symbolsServiceListSymbolRevisionsHandler := connect.NewUnaryHandlerSimple(
+		SymbolsServiceListSymbolRevisionsProcedure,
+		svc.ListSymbolRevisions,
+		connect.WithSchema(symbolsServiceMethods.ByName("ListSymbolRevisions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	symbolsServiceDiffSymbolHandler := connect.NewUnaryHandlerSimple(
+		SymbolsServiceDiffSymbolProcedure,
+		svc.DiffSymbol,
+		connect.WithSchema(symbolsServiceMethods.ByName("DiffSymbol")),
+		connect.WithHandlerOptions(opts...),
+	)
+	symbolsServiceGetProjectSymbolStatsHandler := connect.NewUnaryHandlerSimple(
+		SymbolsServiceGetProjectSymbolStatsProcedure,
+		svc.GetProjectSymbolStats,
+		connect.WithSchema(symbolsServiceMethods.ByName("GetProjectSymbolStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	symbolsServiceListAuditEventsHandler := connect.NewUnaryHandlerSimple(
+		SymbolsServiceListAuditEventsProcedure,
+		svc.ListAuditEvents,
+		connect.WithSchema(symbolsServiceMethods.ByName("ListAuditEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/service.symbols.v1.SymbolsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case SymbolsServiceCreateSymbolProcedure:
@@ -217,6 +540,28 @@ func NewSymbolsServiceHandler(svc SymbolsServiceHandler, opts ...connect.Handler
 			symbolsServiceDeleteSymbolHandler.ServeHTTP(w, r)
 		case SymbolsServiceListSymbolsProcedure:
 			symbolsServiceListSymbolsHandler.ServeHTTP(w, r)
+		case SymbolsServiceListSymbolDependentsProcedure:
+			symbolsServiceListSymbolDependentsHandler.ServeHTTP(w, r)
+		case SymbolsServiceListSymbolDependenciesProcedure:
+			symbolsServiceListSymbolDependenciesHandler.ServeHTTP(w, r)
+		case SymbolsServiceAcquireSymbolLockProcedure:
+			symbolsServiceAcquireSymbolLockHandler.ServeHTTP(w, r)
+		case SymbolsServiceRenewSymbolLockProcedure:
+			symbolsServiceRenewSymbolLockHandler.ServeHTTP(w, r)
+		case SymbolsServiceReleaseSymbolLockProcedure:
+			symbolsServiceReleaseSymbolLockHandler.ServeHTTP(w, r)
+		case SymbolsServiceListTagKeysProcedure:
+			symbolsServiceListTagKeysHandler.ServeHTTP(w, r)
+		case SymbolsServiceListTagValuesProcedure:
+			symbolsServiceListTagValuesHandler.ServeHTTP(w, r)
+		case SymbolsServiceListSymbolRevisionsProcedure:
+			symbolsServiceListSymbolRevisionsHandler.ServeHTTP(w, r)
+		case SymbolsServiceDiffSymbolProcedure:
+			symbolsServiceDiffSymbolHandler.ServeHTTP(w, r)
+		case SymbolsServiceGetProjectSymbolStatsProcedure:
+			symbolsServiceGetProjectSymbolStatsHandler.ServeHTTP(w, r)
+		case SymbolsServiceListAuditEventsProcedure:
+			symbolsServiceListAuditEventsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -245,3 +590,47 @@ func (UnimplementedSymbolsServiceHandler) DeleteSymbol(context.Context, *v1.Dele
 func (UnimplementedSymbolsServiceHandler) ListSymbols(context.Context, *v1.ListSymbolsRequest) (*v1.ListSymbolsResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("service.symbols.v1.SymbolsService.ListSymbols is not implemented"))
 }
+
+func (UnimplementedSymbolsServiceHandler) ListSymbolDependents(context.Context, *v1.ListSymbolDependentsRequest) (*v1.ListSymbolDependentsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("service.symbols.v1.SymbolsService.ListSymbolDependents is not implemented"))
+}
+
+func (UnimplementedSymbolsServiceHandler) ListSymbolDependencies(context.Context, *v1.ListSymbolDependenciesRequest) (*v1.ListSymbolDependenciesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("service.symbols.v1.SymbolsService.ListSymbolDependencies is not implemented"))
+}
+
+func (UnimplementedSymbolsServiceHandler) AcquireSymbolLock(context.Context, *v1.AcquireSymbolLockRequest) (*v1.AcquireSymbolLockResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("service.symbols.v1.SymbolsService.AcquireSymbolLock is not implemented"))
+}
+
+func (UnimplementedSymbolsServiceHandler) RenewSymbolLock(context.Context, *v1.RenewSymbolLockRequest) (*v1.RenewSymbolLockResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("service.symbols.v1.SymbolsService.RenewSymbolLock is not implemented"))
+}
+
+func (UnimplementedSymbolsServiceHandler) ReleaseSymbolLock(context.Context, *v1.ReleaseSymbolLockRequest) (*v1.ReleaseSymbolLockResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("service.symbols.v1.SymbolsService.ReleaseSymbolLock is not implemented"))
+}
+
+func (UnimplementedSymbolsServiceHandler) ListTagKeys(context.Context, *v1.ListTagKeysRequest) (*v1.ListTagKeysResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("service.symbols.v1.SymbolsService.ListTagKeys is not implemented"))
+}
+
+func (UnimplementedSymbolsServiceHandler) ListTagValues(context.Context, *v1.ListTagValuesRequest) (*v1.ListTagValuesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("service.symbols.v1.SymbolsService.ListTagValues is not implemented"))
+}
+
+func (UnimplementedSymbolsServiceHandler) ListSymbolRevisions(context.Context, *v1.ListSymbolRevisionsRequest) (*v1.ListSymbolRevisionsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("service.symbols.v1.SymbolsService.ListSymbolRevisions is not implemented"))
+}
+
+func (UnimplementedSymbolsServiceHandler) DiffSymbol(context.Context, *v1.DiffSymbolRequest) (*v1.DiffSymbolResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("service.symbols.v1.SymbolsService.DiffSymbol is not implemented"))
+}
+
+func (UnimplementedSymbolsServiceHandler) GetProjectSymbolStats(context.Context, *v1.GetProjectSymbolStatsRequest) (*v1.GetProjectSymbolStatsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("service.symbols.v1.SymbolsService.GetProjectSymbolStats is not implemented"))
+}
+
+func (UnimplementedSymbolsServiceHandler) ListAuditEvents(context.Context, *v1.ListAuditEventsRequest) (*v1.ListAuditEventsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("service.symbols.v1.SymbolsService.ListAuditEvents is not implemented"))
+}
@@ -0,0 +1,97 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+// CloudEventsSpecVersion is the CloudEvents specification version written by SetCloudEvent.
+const CloudEventsSpecVersion = "1.0"
+
+// CloudEvents binary content mode headers. In binary mode the event data is the message
+// payload and the context attributes travel as message headers.
+const (
+	HeaderSpecVersion = "ce-specversion"
+	HeaderID          = "ce-id"
+	HeaderType        = "ce-type"
+	HeaderSource      = "ce-source"
+	HeaderSubject     = "ce-subject"
+	HeaderTime        = "ce-time"
+	// HeaderDataContentType carries the datacontenttype attribute, which binary mode maps to the content type.
+	HeaderDataContentType = "content-type"
+)
+
+// ProtobufContentType is the datacontenttype of protobuf encoded event data.
+const ProtobufContentType = "application/protobuf"
+
+// ErrInvalidCloudEvent is returned when a message carries a malformed CloudEvents envelope.
+var ErrInvalidCloudEvent = errors.New("invalid cloudevent")
+
+// CloudEvent holds the CloudEvents context attributes of a message.
+type CloudEvent struct {
+	ID              string
+	Type            string
+	Source          string
+	Subject         string
+	Time            time.Time
+	DataContentType string
+}
+
+// SetCloudEvent writes the attributes to the message metadata in binary content mode.
+// An empty ID defaults to the message UUID and a zero Time to now.
+func SetCloudEvent(msg *message.Message, ce CloudEvent) {
+	if ce.ID == "" {
+		ce.ID = msg.UUID
+	}
+	if ce.Time.IsZero() {
+		ce.Time = time.Now()
+	}
+
+	msg.Metadata.Set(HeaderSpecVersion, CloudEventsSpecVersion)
+	msg.Metadata.Set(HeaderID, ce.ID)
+	msg.Metadata.Set(HeaderType, ce.Type)
+	msg.Metadata.Set(HeaderSource, ce.Source)
+	msg.Metadata.Set(HeaderTime, ce.Time.UTC().Format(time.RFC3339Nano))
+	if ce.Subject != "" {
+		msg.Metadata.Set(HeaderSubject, ce.Subject)
+	}
+	if ce.DataContentType != "" {
+		msg.Metadata.Set(HeaderDataContentType, ce.DataContentType)
+	}
+}
+
+// ParseCloudEvent reads the CloudEvents attributes from the message metadata.
+// It returns nil without error for messages published without an envelope.
+func ParseCloudEvent(msg *message.Message) (*CloudEvent, error) {
+	version := msg.Metadata.Get(HeaderSpecVersion)
+	if version == "" {
+		return nil, nil
+	}
+	if version != CloudEventsSpecVersion {
+		return nil, fmt.Errorf("%w: unsupported specversion %q", ErrInvalidCloudEvent, version)
+	}
+
+	ce := &CloudEvent{
+		ID:              msg.Metadata.Get(HeaderID),
+		Type:            msg.Metadata.Get(HeaderType),
+		Source:          msg.Metadata.Get(HeaderSource),
+		Subject:         msg.Metadata.Get(HeaderSubject),
+		DataContentType: msg.Metadata.Get(HeaderDataContentType),
+	}
+	if ce.ID == "" || ce.Type == "" || ce.Source == "" {
+		return nil, fmt.Errorf("%w: id, type and source are required", ErrInvalidCloudEvent)
+	}
+
+	if t := msg.Metadata.Get(HeaderTime); t != "" {
+		parsed, err := time.Parse(time.RFC3339Nano, t)
+		if err != nil {
+			return nil, fmt.Errorf("%w: time: %w", ErrInvalidCloudEvent, err)
+		}
+		ce.Time = parsed
+	}
+
+	return ce, nil
+}
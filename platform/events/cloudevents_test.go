@@ -0,0 +1,115 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudEvent_RoundTrip(t *testing.T) {
+	at := time.Date(2026, 3, 4, 5, 6, 7, 890, time.FixedZone("CET", 3600))
+	msg := message.NewMessage("msg-1", []byte("payload"))
+
+	SetCloudEvent(msg, CloudEvent{
+		Type:            "events.symbols.v1.SymbolCreated",
+		Source:          "/services/symbols",
+		Subject:         "42",
+		Time:            at,
+		DataContentType: ProtobufContentType,
+	})
+
+	assert.Equal(t, "1.0", msg.Metadata.Get("ce-specversion"))
+	assert.Equal(t, "msg-1", msg.Metadata.Get("ce-id"), "id defaults to the message UUID")
+	assert.Equal(t, "2026-03-04T04:06:07.00000089Z", msg.Metadata.Get("ce-time"))
+	assert.Equal(t, "application/protobuf", msg.Metadata.Get("content-type"))
+
+	ce, err := ParseCloudEvent(msg)
+	require.NoError(t, err)
+	require.NotNil(t, ce)
+	assert.Equal(t, "msg-1", ce.ID)
+	assert.Equal(t, "events.symbols.v1.SymbolCreated", ce.Type)
+	assert.Equal(t, "/services/symbols", ce.Source)
+	assert.Equal(t, "42", ce.Subject)
+	assert.True(t, at.Equal(ce.Time))
+	assert.Equal(t, ProtobufContentType, ce.DataContentType)
+}
+
+func TestSetCloudEvent_Defaults(t *testing.T) {
+	msg := message.NewMessage("msg-1", nil)
+
+	SetCloudEvent(msg, CloudEvent{ID: "evt-1", Type: "t", Source: "s"})
+
+	assert.Equal(t, "evt-1", msg.Metadata.Get(HeaderID))
+	assert.NotEmpty(t, msg.Metadata.Get(HeaderTime))
+	_, hasSubject := msg.Metadata[HeaderSubject]
+	assert.False(t, hasSubject)
+	_, hasContentType := msg.Metadata[HeaderDataContentType]
+	assert.False(t, hasContentType)
+}
+
+func TestParseCloudEvent(t *testing.T) {
+	valid := func() message.Metadata {
+		return message.Metadata{
+			HeaderSpecVersion: "1.0",
+			HeaderID:          "evt-1",
+			HeaderType:        "t",
+			HeaderSource:      "s",
+		}
+	}
+
+	tests := []struct {
+		name     string
+		metadata func() message.Metadata
+		wantNil  bool
+		wantErr  bool
+	}{
+		{name: "legacy message", metadata: func() message.Metadata { return message.Metadata{"routing_key": "symbol.created"} }, wantNil: true},
+		{name: "minimal envelope", metadata: valid},
+		{
+			name: "unsupported version",
+			metadata: func() message.Metadata {
+				m := valid()
+				m[HeaderSpecVersion] = "0.3"
+				return m
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing type",
+			metadata: func() message.Metadata {
+				m := valid()
+				delete(m, HeaderType)
+				return m
+			},
+			wantErr: true,
+		},
+		{
+			name: "malformed time",
+			metadata: func() message.Metadata {
+				m := valid()
+				m[HeaderTime] = "yesterday"
+				return m
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := message.NewMessage("msg-1", nil)
+			msg.Metadata = tt.metadata()
+
+			ce, err := ParseCloudEvent(msg)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidCloudEvent)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantNil, ce == nil)
+		})
+	}
+}
@@ -3,10 +3,24 @@ package events
 
 import (
 	"context"
+	"errors"
 
 	"github.com/ThreeDotsLabs/watermill/message"
 )
 
+// ErrPermanent marks event handling failures that retrying cannot fix, such as an undecodable payload.
+// Wrap it with fmt.Errorf("%w: ...", ErrPermanent) so consumers skip their retries.
+var ErrPermanent = errors.New("permanent failure")
+
+// ReplayKey is the metadata flag set to "true" on re-published events, so consumers can tell
+// replays from live events.
+const ReplayKey = "replay"
+
+// IsReplay reports whether msg is a re-published event.
+func IsReplay(msg *message.Message) bool {
+	return msg.Metadata.Get(ReplayKey) == "true"
+}
+
 // Publisher publishes events to an external messaging system.
 type Publisher interface {
 	Publish(ctx context.Context, topic string, payload []byte) error
@@ -20,8 +34,3 @@ type Subscriber interface {
 	Close() error
 	Unwrap() message.Subscriber
 }
-
-// EventHandler handles events from an external messaging system.
-type EventHandler interface {
-	Handle(ctx context.Context, payload []byte) error
-}
@@ -0,0 +1,193 @@
+package events
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"google.golang.org/protobuf/proto"
+)
+
+// HandlerFunc handles a decoded event.
+// Returned errors are retried unless they wrap ErrPermanent.
+type HandlerFunc[T proto.Message] func(ctx context.Context, evt T) error
+
+// TypeResolver returns the type of the event a message carries: the fully qualified name of its
+// protobuf message. An empty type sends the message to the fallback.
+type TypeResolver func(msg *message.Message) (string, error)
+
+// FallbackFunc handles the messages no handler is registered for.
+type FallbackFunc func(msg *message.Message) error
+
+// Registry collects the event handlers of a service and builds the Watermill router consuming them.
+//
+// Handlers are registered per topic and event type with Handle. All handlers of a topic share
+// one consumer, which decodes every message into the protobuf message its handler expects.
+type Registry struct {
+	subscriber message.Subscriber
+	resolve    TypeResolver
+	fallback   FallbackFunc
+	workers    func(name string) int
+	middleware []message.HandlerMiddleware
+	plugins    []message.RouterPlugin
+
+	topics []string
+	routes map[string]map[string]func(msg *message.Message) error
+}
+
+// RegistryOption configures a Registry.
+type RegistryOption func(*Registry)
+
+// WithTypeResolver sets how the event type of a message is resolved (default: CloudEventType).
+func WithTypeResolver(resolve TypeResolver) RegistryOption {
+	return func(r *Registry) {
+		r.resolve = resolve
+	}
+}
+
+// WithFallback sets the handler of messages of unregistered event types (default: ack them).
+func WithFallback(fallback FallbackFunc) RegistryOption {
+	return func(r *Registry) {
+		r.fallback = fallback
+	}
+}
+
+// WithWorkers sets how many consumers run the named router handler (default: 1).
+// Handlers are named after their topic.
+func WithWorkers(workers func(name string) int) RegistryOption {
+	return func(r *Registry) {
+		r.workers = workers
+	}
+}
+
+// WithMiddleware appends router middleware, outermost first.
+func WithMiddleware(m ...message.HandlerMiddleware) RegistryOption {
+	return func(r *Registry) {
+		r.middleware = append(r.middleware, m...)
+	}
+}
+
+// WithPlugins appends router plugins.
+func WithPlugins(p ...message.RouterPlugin) RegistryOption {
+	return func(r *Registry) {
+		r.plugins = append(r.plugins, p...)
+	}
+}
+
+// NewRegistry creates a Registry consuming from sub.
+func NewRegistry(sub message.Subscriber, opts ...RegistryOption) *Registry {
+	r := &Registry{
+		subscriber: sub,
+		resolve:    CloudEventType,
+		fallback:   func(*message.Message) error { return nil },
+		workers:    func(string) int { return 1 },
+		routes:     make(map[string]map[string]func(msg *message.Message) error),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Handle registers fn for the events of type T published on topic.
+// It panics when a handler for T is already registered on the topic.
+func Handle[T proto.Message](r *Registry, topic string, fn HandlerFunc[T]) {
+	var zero T
+	eventType := string(zero.ProtoReflect().Descriptor().FullName())
+
+	r.add(topic, eventType, func(msg *message.Message) error {
+		if ct := msg.Metadata.Get(HeaderDataContentType); ct != "" && ct != ProtobufContentType {
+			return fmt.Errorf("%w: %s event has unsupported content type %q", ErrPermanent, eventType, ct)
+		}
+
+		evt := zero.ProtoReflect().New().Interface().(T)
+		if err := proto.Unmarshal(msg.Payload, evt); err != nil {
+			return fmt.Errorf("%w: failed to decode %s event: %w", ErrPermanent, eventType, err)
+		}
+
+		return fn(msg.Context(), evt)
+	})
+}
+
+func (r *Registry) add(topic, eventType string, h func(msg *message.Message) error) {
+	routes, ok := r.routes[topic]
+	if !ok {
+		routes = make(map[string]func(msg *message.Message) error)
+		r.routes[topic] = routes
+		r.topics = append(r.topics, topic)
+	}
+	if _, dup := routes[eventType]; dup {
+		panic(fmt.Sprintf("events: handler for %s on topic %s registered twice", eventType, topic))
+	}
+
+	routes[eventType] = h
+}
+
+// Router builds a Watermill router with the registry plugins and middleware and one consumer
+// handler per topic. A topic with several workers gets a handler, and so a consumer, per worker.
+func (r *Registry) Router(logger watermill.LoggerAdapter) (*message.Router, error) {
+	router, err := message.NewRouter(message.RouterConfig{}, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	router.AddPlugin(r.plugins...)
+	router.AddMiddleware(r.middleware...)
+
+	for _, topic := range r.topics {
+		h := r.dispatcher(topic, logger)
+
+		n := r.workers(topic)
+		if n <= 1 {
+			router.AddConsumerHandler(topic, topic, r.subscriber, h)
+			continue
+		}
+		for i := 1; i <= n; i++ {
+			router.AddConsumerHandler(fmt.Sprintf("%s-%d", topic, i), topic, r.subscriber, h)
+		}
+	}
+
+	return router, nil
+}
+
+// Dispatch routes msg, consumed from topic, to the handler registered for its event type, or to the
+// fallback when there is none. Messages whose type cannot be resolved fail permanently.
+func (r *Registry) Dispatch(topic string, msg *message.Message) error {
+	eventType, err := r.resolve(msg)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrPermanent, err)
+	}
+
+	h, ok := r.routes[topic][eventType]
+	if !ok {
+		return r.fallback(msg)
+	}
+
+	return h(msg)
+}
+
+// dispatcher returns the router handler dispatching the messages of topic.
+func (r *Registry) dispatcher(topic string, logger watermill.LoggerAdapter) message.NoPublishHandlerFunc {
+	return func(msg *message.Message) error {
+		logger.Debug("Dispatching event", watermill.LogFields{
+			"message_uuid": msg.UUID,
+			"topic":        topic,
+			"event_type":   msg.Metadata.Get(HeaderType),
+		})
+
+		return r.Dispatch(topic, msg)
+	}
+}
+
+// CloudEventType resolves the event type from the CloudEvents envelope of a message.
+// Messages without an envelope have no type.
+func CloudEventType(msg *message.Message) (string, error) {
+	ce, err := ParseCloudEvent(msg)
+	if err != nil || ce == nil {
+		return "", err
+	}
+
+	return ce.Type, nil
+}
@@ -0,0 +1,240 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const testTopic = "lifecycle_events"
+
+// eventMessage builds a CloudEvents message carrying evt.
+func eventMessage(t *testing.T, evt proto.Message) *message.Message {
+	payload, err := proto.Marshal(evt)
+	require.NoError(t, err)
+
+	msg := message.NewMessage(watermill.NewUUID(), payload)
+	SetCloudEvent(msg, CloudEvent{
+		Type:            string(evt.ProtoReflect().Descriptor().FullName()),
+		Source:          "/test",
+		DataContentType: ProtobufContentType,
+	})
+	return msg
+}
+
+func TestRegistry_Dispatch(t *testing.T) {
+	errHandler := errors.New("db down")
+
+	tests := []struct {
+		name          string
+		msg           func(t *testing.T) *message.Message
+		opts          []RegistryOption
+		handlerErr    error
+		wantString    string
+		wantInt       int64
+		wantFallback  bool
+		wantErr       error
+		wantPermanent bool
+	}{
+		{
+			name:       "typed handler",
+			msg:        func(t *testing.T) *message.Message { return eventMessage(t, wrapperspb.String("hello")) },
+			wantString: "hello",
+		},
+		{
+			name:    "second type on the same topic",
+			msg:     func(t *testing.T) *message.Message { return eventMessage(t, wrapperspb.Int64(42)) },
+			wantInt: 42,
+		},
+		{
+			name:       "handler error is returned for retry",
+			msg:        func(t *testing.T) *message.Message { return eventMessage(t, wrapperspb.String("hello")) },
+			handlerErr: errHandler,
+			wantString: "hello",
+			wantErr:    errHandler,
+		},
+		{
+			name:         "unregistered type goes to the fallback",
+			msg:          func(t *testing.T) *message.Message { return eventMessage(t, wrapperspb.Bool(true)) },
+			wantFallback: true,
+		},
+		{
+			name: "message without envelope goes to the fallback",
+			msg: func(t *testing.T) *message.Message {
+				return message.NewMessage(watermill.NewUUID(), []byte("legacy"))
+			},
+			wantFallback: true,
+		},
+		{
+			name: "custom type resolver",
+			msg: func(t *testing.T) *message.Message {
+				payload, err := proto.Marshal(wrapperspb.String("legacy"))
+				require.NoError(t, err)
+				msg := message.NewMessage(watermill.NewUUID(), payload)
+				msg.Metadata.Set("routing_key", "string.created")
+				return msg
+			},
+			opts: []RegistryOption{WithTypeResolver(func(msg *message.Message) (string, error) {
+				if msg.Metadata.Get("routing_key") == "string.created" {
+					return "google.protobuf.StringValue", nil
+				}
+				return "", nil
+			})},
+			wantString: "legacy",
+		},
+		{
+			name: "undecodable payload fails permanently",
+			msg: func(t *testing.T) *message.Message {
+				msg := eventMessage(t, wrapperspb.String("hello"))
+				msg.Payload = []byte{0xff, 0xff}
+				return msg
+			},
+			wantPermanent: true,
+		},
+		{
+			name: "unsupported content type fails permanently",
+			msg: func(t *testing.T) *message.Message {
+				msg := eventMessage(t, wrapperspb.String("hello"))
+				msg.Metadata.Set(HeaderDataContentType, "application/json")
+				return msg
+			},
+			wantPermanent: true,
+		},
+		{
+			name: "invalid envelope fails permanently",
+			msg: func(t *testing.T) *message.Message {
+				msg := eventMessage(t, wrapperspb.String("hello"))
+				msg.Metadata.Set(HeaderSpecVersion, "0.3")
+				return msg
+			},
+			wantPermanent: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				gotString    string
+				gotInt       int64
+				fallbackUsed bool
+			)
+			opts := append([]RegistryOption{WithFallback(func(*message.Message) error {
+				fallbackUsed = true
+				return nil
+			})}, tt.opts...)
+			r := NewRegistry(nil, opts...)
+			Handle(r, testTopic, func(_ context.Context, evt *wrapperspb.StringValue) error {
+				gotString = evt.GetValue()
+				return tt.handlerErr
+			})
+			Handle(r, testTopic, func(_ context.Context, evt *wrapperspb.Int64Value) error {
+				gotInt = evt.GetValue()
+				return nil
+			})
+
+			err := r.Dispatch(testTopic, tt.msg(t))
+
+			switch {
+			case tt.wantPermanent:
+				assert.ErrorIs(t, err, ErrPermanent)
+			case tt.wantErr != nil:
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.NotErrorIs(t, err, ErrPermanent)
+			default:
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantString, gotString)
+			assert.Equal(t, tt.wantInt, gotInt)
+			assert.Equal(t, tt.wantFallback, fallbackUsed)
+		})
+	}
+}
+
+func TestHandle_DuplicatePanics(t *testing.T) {
+	r := NewRegistry(nil)
+	h := func(context.Context, *wrapperspb.StringValue) error { return nil }
+	Handle(r, testTopic, h)
+
+	assert.Panics(t, func() { Handle(r, testTopic, h) })
+	assert.NotPanics(t, func() { Handle(r, "other_topic", h) })
+}
+
+func TestRegistry_Router(t *testing.T) {
+	tests := []struct {
+		name         string
+		workers      int
+		wantHandlers []string
+	}{
+		{name: "single worker keeps the topic name", workers: 1, wantHandlers: []string{testTopic, "other_topic"}},
+		{name: "handler per worker", workers: 2, wantHandlers: []string{testTopic + "-1", testTopic + "-2", "other_topic"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRegistry(nil, WithWorkers(func(name string) int {
+				if name == testTopic {
+					return tt.workers
+				}
+				return 1
+			}))
+			Handle(r, testTopic, func(context.Context, *wrapperspb.StringValue) error { return nil })
+			Handle(r, testTopic, func(context.Context, *wrapperspb.Int64Value) error { return nil })
+			Handle(r, "other_topic", func(context.Context, *wrapperspb.StringValue) error { return nil })
+
+			router, err := r.Router(watermill.NopLogger{})
+			require.NoError(t, err)
+
+			var names []string
+			for name := range router.Handlers() {
+				names = append(names, name)
+			}
+			assert.ElementsMatch(t, tt.wantHandlers, names)
+		})
+	}
+}
+
+func TestRegistry_RouterConsumes(t *testing.T) {
+	pubSub := gochannel.NewGoChannel(gochannel.Config{}, watermill.NopLogger{})
+	defer pubSub.Close()
+
+	var middlewareCalls int
+	received := make(chan string, 1)
+	r := NewRegistry(pubSub, WithMiddleware(func(h message.HandlerFunc) message.HandlerFunc {
+		return func(msg *message.Message) ([]*message.Message, error) {
+			middlewareCalls++
+			return h(msg)
+		}
+	}))
+	Handle(r, testTopic, func(_ context.Context, evt *wrapperspb.StringValue) error {
+		received <- evt.GetValue()
+		return nil
+	})
+
+	router, err := r.Router(watermill.NopLogger{})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go func() { _ = router.Run(ctx) }()
+	defer router.Close()
+	<-router.Running()
+
+	require.NoError(t, pubSub.Publish(testTopic, eventMessage(t, wrapperspb.String("hello"))))
+
+	select {
+	case got := <-received:
+		assert.Equal(t, "hello", got)
+		assert.Equal(t, 1, middlewareCalls)
+	case <-ctx.Done():
+		t.Fatal("event was not handled")
+	}
+}
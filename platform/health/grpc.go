@@ -0,0 +1,87 @@
+package health
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// minWatchInterval bounds how often a Watch stream re-checks the readiness when caching is disabled.
+const minWatchInterval = time.Second
+
+type grpcServer struct {
+	healthpb.UnimplementedHealthServer
+
+	health   *Health
+	services map[string]bool
+}
+
+// GRPCServer returns the grpc.health.v1 service of h. The overall health (the empty service name) and
+// each of services report the readiness; other services are unknown.
+func (h *Health) GRPCServer(services ...string) healthpb.HealthServer {
+	s := &grpcServer{health: h, services: map[string]bool{"": true}}
+	for _, name := range services {
+		s.services[name] = true
+	}
+	return s
+}
+
+// servingStatus returns the status of a known service.
+func (s *grpcServer) servingStatus(ctx context.Context) healthpb.HealthCheckResponse_ServingStatus {
+	if s.health.Ready(ctx).Up() {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
+
+// Check returns the readiness of a service, or NotFound for an unknown one.
+func (s *grpcServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	if !s.services[req.GetService()] {
+		return nil, status.Errorf(codes.NotFound, "unknown service %q", req.GetService())
+	}
+	return &healthpb.HealthCheckResponse{Status: s.servingStatus(ctx)}, nil
+}
+
+// List returns the readiness of every known service.
+func (s *grpcServer) List(ctx context.Context, _ *healthpb.HealthListRequest) (*healthpb.HealthListResponse, error) {
+	st := s.servingStatus(ctx)
+	statuses := make(map[string]*healthpb.HealthCheckResponse, len(s.services))
+	for name := range s.services {
+		statuses[name] = &healthpb.HealthCheckResponse{Status: st}
+	}
+	return &healthpb.HealthListResponse{Statuses: statuses}, nil
+}
+
+// Watch streams the readiness of a service each time it changes, re-checking it once per cache TTL.
+// An unknown service is reported as SERVICE_UNKNOWN, as the protocol requires.
+func (s *grpcServer) Watch(req *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	ctx := stream.Context()
+	known := s.services[req.GetService()]
+
+	interval := max(s.health.cacheTTL, minWatchInterval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last healthpb.HealthCheckResponse_ServingStatus = -1
+	for {
+		st := healthpb.HealthCheckResponse_SERVICE_UNKNOWN
+		if known {
+			st = s.servingStatus(ctx)
+		}
+		if st != last {
+			if err := stream.Send(&healthpb.HealthCheckResponse{Status: st}); err != nil {
+				return status.Error(codes.Canceled, "stream has ended")
+			}
+			last = st
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return status.Error(codes.Canceled, "stream has ended")
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestGRPCServer_Check(t *testing.T) {
+	tests := []struct {
+		name       string
+		service    string
+		err        error
+		wantStatus healthpb.HealthCheckResponse_ServingStatus
+		wantCode   codes.Code
+	}{
+		{name: "overall serving", service: "", wantStatus: healthpb.HealthCheckResponse_SERVING},
+		{name: "service serving", service: "symbols.v1.SymbolsService", wantStatus: healthpb.HealthCheckResponse_SERVING},
+		{name: "not serving", service: "symbols.v1.SymbolsService", err: errors.New("down"), wantStatus: healthpb.HealthCheckResponse_NOT_SERVING},
+		{name: "unknown service", service: "other.Service", wantCode: codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newHealth(Options{})
+			require.NoError(t, h.Register("database", &countingChecker{err: tt.err}))
+			srv := h.GRPCServer("symbols.v1.SymbolsService")
+
+			resp, err := srv.Check(context.Background(), &healthpb.HealthCheckRequest{Service: tt.service})
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, resp.GetStatus())
+		})
+	}
+}
+
+func TestGRPCServer_List(t *testing.T) {
+	h := newHealth(Options{})
+	srv := h.GRPCServer("symbols.v1.SymbolsService")
+
+	resp, err := srv.List(context.Background(), &healthpb.HealthListRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetStatuses(), 2)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatuses()[""].GetStatus())
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatuses()["symbols.v1.SymbolsService"].GetStatus())
+}
+
+// watchStream records the responses of a Watch stream.
+type watchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan healthpb.HealthCheckResponse_ServingStatus
+}
+
+func (s *watchStream) Context() context.Context { return s.ctx }
+
+func (s *watchStream) Send(resp *healthpb.HealthCheckResponse) error {
+	s.sent <- resp.GetStatus()
+	return nil
+}
+
+func TestGRPCServer_Watch(t *testing.T) {
+	h := newHealth(Options{CacheTTL: -1})
+	c := &countingChecker{}
+	require.NoError(t, h.Register("database", c))
+	srv := h.GRPCServer()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &watchStream{ctx: ctx, sent: make(chan healthpb.HealthCheckResponse_ServingStatus, 4)}
+	done := make(chan error, 1)
+	go func() { done <- srv.Watch(&healthpb.HealthCheckRequest{}, stream) }()
+
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, <-stream.sent)
+
+	h.Shutdown()
+	select {
+	case st := <-stream.sent:
+		assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, st)
+	case <-time.After(3 * time.Second):
+		t.Fatal("the status change was not streamed")
+	}
+
+	cancel()
+	assert.Equal(t, codes.Canceled, status.Code(<-done))
+}
+
+func TestGRPCServer_WatchUnknownService(t *testing.T) {
+	srv := newHealth(Options{}).GRPCServer()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &watchStream{ctx: ctx, sent: make(chan healthpb.HealthCheckResponse_ServingStatus, 1)}
+	done := make(chan error, 1)
+	go func() { done <- srv.Watch(&healthpb.HealthCheckRequest{Service: "other.Service"}, stream) }()
+
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVICE_UNKNOWN, <-stream.sent)
+	cancel()
+	<-done
+}
@@ -0,0 +1,279 @@
+// Package health provides liveness and readiness checks: a registry of dependency checkers whose
+// aggregated report is served over HTTP (/healthz, /readyz) and as the gRPC grpc.health.v1 service.
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"platform/metrics"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultTimeout bounds a check when no timeout is configured.
+const DefaultTimeout = 2 * time.Second
+
+// DefaultCacheTTL is how long a readiness report is reused when no TTL is configured.
+// Probes of every replica and every transport share it, so the dependencies are not hammered.
+const DefaultCacheTTL = 5 * time.Second
+
+// Statuses of a check and of a report.
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// ErrShuttingDown fails the readiness of a service that is shutting down.
+var ErrShuttingDown = errors.New("shutting down")
+
+// Checker checks a dependency of the service. Check returns nil when the dependency is usable.
+// Implementations must be safe for concurrent use and return once ctx is done.
+type Checker interface {
+	Check(ctx context.Context) error
+}
+
+// CheckerFunc adapts a function to a Checker.
+type CheckerFunc func(ctx context.Context) error
+
+// Check calls f.
+func (f CheckerFunc) Check(ctx context.Context) error {
+	return f(ctx)
+}
+
+// Pinger is a connection that can be pinged, e.g. a *sql.DB.
+type Pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+// PingChecker checks a connection by pinging it.
+func PingChecker(p Pinger) Checker {
+	return CheckerFunc(p.PingContext)
+}
+
+// Checks are checkers by name, e.g. the dependencies of a layer.
+type Checks map[string]Checker
+
+// Options configures a Health.
+type Options struct {
+	// Timeout bounds each check (default: DefaultTimeout).
+	Timeout time.Duration
+	// CacheTTL is how long a readiness report is reused (default: DefaultCacheTTL). Negative disables caching.
+	CacheTTL time.Duration
+}
+
+// CheckResult is the outcome of a check.
+type CheckResult struct {
+	Status   string `json:"status"`
+	Error    string `json:"error,omitempty"`
+	Duration string `json:"duration"`
+}
+
+// Report is the readiness of the service: it is up when every check is up.
+type Report struct {
+	Status    string                 `json:"status"`
+	Checks    map[string]CheckResult `json:"checks,omitempty"`
+	CheckedAt time.Time              `json:"checked_at"`
+}
+
+// Up reports whether every check passed.
+func (r Report) Up() bool {
+	return r.Status == StatusUp
+}
+
+type check struct {
+	name    string
+	checker Checker
+}
+
+// Health aggregates the checkers of the dependencies of a service.
+type Health struct {
+	timeout  time.Duration
+	cacheTTL time.Duration
+	now      func() time.Time
+	log      *log.Helper
+
+	checkUp       *prometheus.GaugeVec
+	checkDuration *prometheus.HistogramVec
+
+	mu       sync.Mutex
+	checks   []check
+	report   *Report
+	shutdown bool
+}
+
+// New creates a Health without checkers: it is ready until checkers are registered.
+// The registry may be nil, in which case no metrics are recorded.
+func New(opts Options, reg *metrics.Registry, logger log.Logger) *Health {
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+	if opts.CacheTTL == 0 {
+		opts.CacheTTL = DefaultCacheTTL
+	}
+
+	h := &Health{
+		timeout:  opts.Timeout,
+		cacheTTL: opts.CacheTTL,
+		now:      time.Now,
+		log:      log.NewHelper(logger),
+	}
+
+	if reg != nil {
+		h.checkUp = reg.NewGaugeVec(
+			"health_check_up",
+			"Whether the last readiness check of a dependency passed (1) or failed (0)",
+			[]string{"check"},
+		)
+		h.checkDuration = reg.NewHistogramVec(
+			"health_check_duration_seconds",
+			"Readiness check duration in seconds",
+			[]float64{.001, .005, .01, .05, .1, .5, 1, 2, 5},
+			[]string{"check"},
+		)
+	}
+
+	return h
+}
+
+// Register adds the checker of a dependency to the readiness report.
+func (h *Health) Register(name string, c Checker) error {
+	if name == "" || c == nil {
+		return fmt.Errorf("health: check %q needs a name and a checker", name)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, existing := range h.checks {
+		if existing.name == name {
+			return fmt.Errorf("health: check %q is already registered", name)
+		}
+	}
+	h.checks = append(h.checks, check{name: name, checker: c})
+	h.report = nil
+
+	return nil
+}
+
+// RegisterChecks adds checks to the readiness report, in name order.
+func (h *Health) RegisterChecks(checks Checks) error {
+	names := make([]string, 0, len(checks))
+	for name := range checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := h.Register(name, checks[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Checks returns the names of the registered checks, sorted.
+func (h *Health) Checks() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	names := make([]string, len(h.checks))
+	for i, c := range h.checks {
+		names[i] = c.name
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Shutdown fails the readiness from now on, so load balancers stop routing to the service
+// while it drains.
+func (h *Health) Shutdown() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.shutdown = true
+	h.report = nil
+}
+
+// Ready runs the checks concurrently, each bounded by the timeout, and returns their report.
+// A report younger than the cache TTL is returned as is; concurrent callers share a single run.
+// Cancelling ctx does not cut the checks short, so an impatient probe cannot fail the cached report.
+func (h *Health) Ready(ctx context.Context) Report {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	if h.report != nil && h.cacheTTL > 0 && now.Sub(h.report.CheckedAt) < h.cacheTTL {
+		return *h.report
+	}
+
+	report := Report{Status: StatusUp, Checks: make(map[string]CheckResult, len(h.checks)), CheckedAt: now}
+	if h.shutdown {
+		report.Status = StatusDown
+		report.Checks["shutdown"] = CheckResult{Status: StatusDown, Error: ErrShuttingDown.Error(), Duration: "0s"}
+		h.report = &report
+		return report
+	}
+
+	results := make([]CheckResult, len(h.checks))
+	var wg sync.WaitGroup
+	for i, c := range h.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = h.run(context.WithoutCancel(ctx), c)
+		}()
+	}
+	wg.Wait()
+
+	for i, c := range h.checks {
+		report.Checks[c.name] = results[i]
+		if results[i].Status != StatusUp {
+			report.Status = StatusDown
+		}
+	}
+	h.report = &report
+
+	return report
+}
+
+// run runs a check bounded by the timeout. A checker ignoring its context is abandoned at the timeout.
+func (h *Health) run(ctx context.Context, c check) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	start := h.now()
+	done := make(chan error, 1)
+	go func() {
+		done <- c.checker.Check(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+	}
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("timed out after %s", h.timeout)
+	}
+	elapsed := h.now().Sub(start)
+
+	result := CheckResult{Status: StatusUp, Duration: elapsed.String()}
+	up := 1.0
+	if err != nil {
+		result.Status = StatusDown
+		result.Error = err.Error()
+		up = 0
+		h.log.WithContext(ctx).Warnf("Health check %s failed: %v", c.name, err)
+	}
+
+	if h.checkUp != nil {
+		h.checkUp.WithLabelValues(c.name).Set(up)
+		h.checkDuration.WithLabelValues(c.name).Observe(elapsed.Seconds())
+	}
+
+	return result
+}
@@ -0,0 +1,229 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"os"
+	"platform/build"
+	"platform/metrics"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingChecker counts its checks and fails with err.
+type countingChecker struct {
+	calls atomic.Int32
+	err   error
+}
+
+func (c *countingChecker) Check(context.Context) error {
+	c.calls.Add(1)
+	return c.err
+}
+
+func newHealth(opts Options) *Health {
+	return New(opts, nil, log.NewStdLogger(os.Stdout))
+}
+
+func TestHealth_Register(t *testing.T) {
+	h := newHealth(Options{})
+
+	require.NoError(t, h.Register("database", &countingChecker{}))
+	require.NoError(t, h.Register("broker", &countingChecker{}))
+
+	assert.EqualError(t, h.Register("database", &countingChecker{}), `health: check "database" is already registered`)
+	assert.EqualError(t, h.Register("", &countingChecker{}), `health: check "" needs a name and a checker`)
+	assert.EqualError(t, h.Register("cache", nil), `health: check "cache" needs a name and a checker`)
+	assert.Equal(t, []string{"broker", "database"}, h.Checks())
+}
+
+func TestHealth_RegisterChecks(t *testing.T) {
+	h := newHealth(Options{})
+
+	require.NoError(t, h.RegisterChecks(Checks{"database": &countingChecker{}, "broker": &countingChecker{}}))
+	assert.Equal(t, []string{"broker", "database"}, h.Checks())
+
+	assert.EqualError(t, h.RegisterChecks(Checks{"broker": &countingChecker{}}), `health: check "broker" is already registered`)
+}
+
+func TestHealth_Ready(t *testing.T) {
+	tests := []struct {
+		name       string
+		checks     map[string]Checker
+		wantStatus string
+		wantChecks map[string]CheckResult
+	}{
+		{
+			name:       "no checks",
+			wantStatus: StatusUp,
+			wantChecks: map[string]CheckResult{},
+		},
+		{
+			name: "all up",
+			checks: map[string]Checker{
+				"database": &countingChecker{},
+				"broker":   &countingChecker{},
+			},
+			wantStatus: StatusUp,
+			wantChecks: map[string]CheckResult{
+				"database": {Status: StatusUp},
+				"broker":   {Status: StatusUp},
+			},
+		},
+		{
+			name: "one down",
+			checks: map[string]Checker{
+				"database": &countingChecker{},
+				"broker":   &countingChecker{err: errors.New("connection refused")},
+			},
+			wantStatus: StatusDown,
+			wantChecks: map[string]CheckResult{
+				"database": {Status: StatusUp},
+				"broker":   {Status: StatusDown, Error: "connection refused"},
+			},
+		},
+		{
+			name: "timeout",
+			checks: map[string]Checker{
+				"slow": CheckerFunc(func(ctx context.Context) error {
+					<-ctx.Done()
+					return ctx.Err()
+				}),
+			},
+			wantStatus: StatusDown,
+			wantChecks: map[string]CheckResult{
+				"slow": {Status: StatusDown, Error: "timed out after 20ms"},
+			},
+		},
+		{
+			name: "checker ignoring its context",
+			checks: map[string]Checker{
+				"stuck": CheckerFunc(func(context.Context) error {
+					time.Sleep(time.Second)
+					return nil
+				}),
+			},
+			wantStatus: StatusDown,
+			wantChecks: map[string]CheckResult{
+				"stuck": {Status: StatusDown, Error: "timed out after 20ms"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newHealth(Options{Timeout: 20 * time.Millisecond})
+			for name, c := range tt.checks {
+				require.NoError(t, h.Register(name, c))
+			}
+
+			start := time.Now()
+			report := h.Ready(context.Background())
+			assert.Less(t, time.Since(start), 500*time.Millisecond, "checks should be bounded by the timeout")
+
+			assert.Equal(t, tt.wantStatus, report.Status)
+			require.Len(t, report.Checks, len(tt.wantChecks))
+			for name, want := range tt.wantChecks {
+				got := report.Checks[name]
+				assert.Equal(t, want.Status, got.Status, name)
+				assert.Equal(t, want.Error, got.Error, name)
+				assert.NotEmpty(t, got.Duration, name)
+			}
+		})
+	}
+}
+
+func TestHealth_ReadyCachesReport(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	h := newHealth(Options{CacheTTL: 5 * time.Second})
+	h.now = func() time.Time { return now }
+
+	c := &countingChecker{}
+	require.NoError(t, h.Register("database", c))
+
+	h.Ready(context.Background())
+	h.Ready(context.Background())
+	assert.Equal(t, int32(1), c.calls.Load(), "a fresh report should be reused")
+
+	now = now.Add(5 * time.Second)
+	c.err = errors.New("gone")
+	report := h.Ready(context.Background())
+	assert.Equal(t, int32(2), c.calls.Load(), "an expired report should be refreshed")
+	assert.Equal(t, StatusDown, report.Status)
+
+	// Registering a check invalidates the report
+	require.NoError(t, h.Register("broker", &countingChecker{}))
+	assert.Len(t, h.Ready(context.Background()).Checks, 2)
+}
+
+func TestHealth_ReadyWithoutCache(t *testing.T) {
+	h := newHealth(Options{CacheTTL: -1})
+	c := &countingChecker{}
+	require.NoError(t, h.Register("database", c))
+
+	h.Ready(context.Background())
+	h.Ready(context.Background())
+	assert.Equal(t, int32(2), c.calls.Load())
+}
+
+func TestHealth_ReadyIgnoresCallerCancellation(t *testing.T) {
+	h := newHealth(Options{})
+	require.NoError(t, h.Register("database", CheckerFunc(func(ctx context.Context) error {
+		return ctx.Err()
+	})))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.True(t, h.Ready(ctx).Up(), "a cancelled probe should not fail the checks")
+}
+
+func TestHealth_Shutdown(t *testing.T) {
+	h := newHealth(Options{})
+	c := &countingChecker{}
+	require.NoError(t, h.Register("database", c))
+	require.True(t, h.Ready(context.Background()).Up())
+
+	h.Shutdown()
+
+	report := h.Ready(context.Background())
+	assert.Equal(t, StatusDown, report.Status, "the cached report should be dropped")
+	assert.Equal(t, ErrShuttingDown.Error(), report.Checks["shutdown"].Error)
+	assert.Equal(t, int32(1), c.calls.Load(), "dependencies are not checked while shutting down")
+}
+
+func TestHealth_Metrics(t *testing.T) {
+	reg := metrics.NewRegistry(build.NewBuildInfo("test_service", "1.0.0"))
+	h := New(Options{}, reg, log.NewStdLogger(os.Stdout))
+	require.NoError(t, h.Register("database", &countingChecker{}))
+	require.NoError(t, h.Register("broker", &countingChecker{err: errors.New("down")}))
+
+	h.Ready(context.Background())
+
+	families, err := reg.Unwrap().Gather()
+	require.NoError(t, err)
+	up := map[string]float64{}
+	for _, f := range families {
+		if f.GetName() != "test_service_health_check_up" {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			up[m.GetLabel()[0].GetValue()] = m.GetGauge().GetValue()
+		}
+	}
+	assert.Equal(t, map[string]float64{"database": 1, "broker": 0}, up)
+}
+
+type pinger struct{ err error }
+
+func (p pinger) PingContext(context.Context) error { return p.err }
+
+func TestPingChecker(t *testing.T) {
+	assert.NoError(t, PingChecker(pinger{}).Check(context.Background()))
+	assert.EqualError(t, PingChecker(pinger{err: errors.New("refused")}).Check(context.Background()), "refused")
+}
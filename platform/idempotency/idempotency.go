@@ -0,0 +1,239 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"platform/metrics"
+	platform_middleware "platform/middleware"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// KeyHeader carries the client supplied idempotency key (HTTP header or gRPC metadata).
+const KeyHeader = "Idempotency-Key"
+
+// ReplayedHeader is set to "true" on responses replayed from the store.
+const ReplayedHeader = "Idempotent-Replayed"
+
+// MaxKeyLength is the maximum accepted idempotency key length.
+const MaxKeyLength = 255
+
+// DefaultTTL is used when no TTL is configured.
+const DefaultTTL = 24 * time.Hour
+
+// reservationTTL bounds how long an unfinished request holds its key,
+// so a crashed replica does not block retries for the full TTL.
+const reservationTTL = time.Minute
+
+var (
+	// ErrInvalidKey is returned when the idempotency key is too long.
+	ErrInvalidKey = errors.BadRequest("IDEMPOTENCY_KEY_INVALID", "idempotency key must be at most 255 characters")
+
+	// ErrKeyReused is returned when a key is reused with a different operation or payload.
+	ErrKeyReused = errors.BadRequest("IDEMPOTENCY_KEY_REUSED", "idempotency key was already used with a different request")
+
+	// ErrRequestInProgress is returned when a retry arrives while the original request is still running.
+	ErrRequestInProgress = errors.Conflict("IDEMPOTENCY_REQUEST_IN_PROGRESS", "a request with this idempotency key is still being processed")
+)
+
+// Handler replays the responses of mutating operations retried with the same idempotency key.
+type Handler struct {
+	store         Store
+	operations    map[string]struct{}
+	ttl           time.Duration
+	log           *log.Helper
+	requestsTotal *prometheus.CounterVec
+}
+
+// NewHandler creates an idempotency handler for the given operations
+// (full Kratos operation names, e.g. "/service.symbols.v1.SymbolsService/CreateSymbol").
+// The registry may be nil, in which case no metrics are recorded.
+func NewHandler(store Store, operations []string, ttl time.Duration, reg *metrics.Registry, logger log.Logger) *Handler {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+
+	h := &Handler{
+		store:      store,
+		operations: make(map[string]struct{}, len(operations)),
+		ttl:        ttl,
+		log:        log.NewHelper(logger),
+	}
+	for _, op := range operations {
+		h.operations[op] = struct{}{}
+	}
+
+	if reg != nil {
+		h.requestsTotal = reg.NewCounterVec(
+			"idempotency_requests_total",
+			"Total number of requests carrying an idempotency key by outcome",
+			[]string{"operation", "outcome"},
+		)
+	}
+
+	return h
+}
+
+// Middleware returns a Kratos server middleware enforcing idempotency keys.
+// Requests without a key, or for operations that are not configured, pass through unchanged.
+// Only successful responses are stored; failed requests release the key so they can be retried.
+// Store failures are logged and the request is processed normally (fail open).
+func (h *Handler) Middleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			operation := tr.Operation()
+			if _, ok := h.operations[operation]; !ok {
+				return handler(ctx, req)
+			}
+
+			key := tr.RequestHeader().Get(KeyHeader)
+			if key == "" {
+				return handler(ctx, req)
+			}
+			if len(key) > MaxKeyLength {
+				return nil, ErrInvalidKey
+			}
+
+			msg, ok := req.(proto.Message)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			fingerprint, err := requestFingerprint(operation, msg)
+			if err != nil {
+				h.log.WithContext(ctx).Errorf("failed to fingerprint request: %v", err)
+				return handler(ctx, req)
+			}
+
+			storeKey := scopedKey(ctx, tr, key)
+
+			existing, reserved, err := h.store.Reserve(ctx, storeKey, fingerprint, min(reservationTTL, h.ttl))
+			if err != nil {
+				h.log.WithContext(ctx).Errorf("idempotency store error for %s: %v", storeKey, err)
+				return handler(ctx, req)
+			}
+
+			if !reserved {
+				if existing == nil {
+					// The key was released while the store looked it up: the request runs unreserved
+					return handler(ctx, req)
+				}
+				return h.replay(ctx, tr, operation, fingerprint, existing)
+			}
+
+			reply, err := handler(ctx, req)
+			if err != nil {
+				if releaseErr := h.store.Release(ctx, storeKey); releaseErr != nil {
+					h.log.WithContext(ctx).Errorf("failed to release idempotency key %s: %v", storeKey, releaseErr)
+				}
+				return reply, err
+			}
+
+			h.save(ctx, storeKey, reply)
+			h.observe(operation, "stored")
+
+			return reply, nil
+		}
+	}
+}
+
+// replay answers a retried request from the stored record.
+func (h *Handler) replay(ctx context.Context, tr transport.Transporter, operation, fingerprint string, r *Record) (interface{}, error) {
+	if r.Fingerprint != fingerprint {
+		h.observe(operation, "mismatch")
+		return nil, ErrKeyReused
+	}
+	if !r.Completed {
+		h.observe(operation, "in_progress")
+		return nil, ErrRequestInProgress
+	}
+
+	var stored anypb.Any
+	if err := proto.Unmarshal(r.Response, &stored); err != nil {
+		h.log.WithContext(ctx).Errorf("failed to decode stored response: %v", err)
+		return nil, errors.InternalServer("IDEMPOTENCY_REPLAY_FAILED", "failed to replay stored response")
+	}
+	reply, err := stored.UnmarshalNew()
+	if err != nil {
+		h.log.WithContext(ctx).Errorf("failed to decode stored response: %v", err)
+		return nil, errors.InternalServer("IDEMPOTENCY_REPLAY_FAILED", "failed to replay stored response")
+	}
+
+	tr.ReplyHeader().Set(ReplayedHeader, "true")
+	h.observe(operation, "replayed")
+
+	return reply, nil
+}
+
+// save stores a successful response. On failure the key is released so that
+// a retry is processed again instead of being reported as in progress.
+func (h *Handler) save(ctx context.Context, storeKey string, reply interface{}) {
+	msg, ok := reply.(proto.Message)
+	if !ok {
+		_ = h.store.Release(ctx, storeKey)
+		return
+	}
+
+	packed, err := anypb.New(msg)
+	if err == nil {
+		var raw []byte
+		if raw, err = proto.Marshal(packed); err == nil {
+			err = h.store.Complete(ctx, storeKey, raw, h.ttl)
+		}
+	}
+	if err != nil {
+		h.log.WithContext(ctx).Errorf("failed to store idempotent response for %s: %v", storeKey, err)
+		_ = h.store.Release(ctx, storeKey)
+	}
+}
+
+func (h *Handler) observe(operation, outcome string) {
+	if h.requestsTotal != nil {
+		h.requestsTotal.WithLabelValues(operation, outcome).Inc()
+	}
+}
+
+// requestFingerprint hashes the operation and the deterministic encoding of the request.
+func requestFingerprint(operation string, req proto.Message) (string, error) {
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.New()
+	sum.Write([]byte(operation))
+	sum.Write([]byte{0})
+	sum.Write(raw)
+
+	return hex.EncodeToString(sum.Sum(nil)), nil
+}
+
+// scopedKey scopes key to the caller so clients cannot observe each other's responses. The caller is
+// the actor stored by CallerMiddleware, or the user header without it. The identity headers are only
+// as trustworthy as the gateway setting them (see platform/middleware). Requests without a user share
+// the AnonymousActor scope: anyone knowing or guessing such a key can replay its response.
+func scopedKey(ctx context.Context, tr transport.Transporter, key string) string {
+	actor := tr.RequestHeader().Get(platform_middleware.UserIDHeader)
+	if c, ok := platform_middleware.CallerFromContext(ctx); ok {
+		actor = c.Actor
+	}
+	if actor == "" {
+		actor = platform_middleware.AnonymousActor
+	}
+
+	return actor + "|" + key
+}
@@ -0,0 +1,244 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"platform/build"
+	"platform/metrics"
+	platform_middleware "platform/middleware"
+	"testing"
+	"time"
+
+	kratoserrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// headerCarrier implements transport.Header on top of http.Header.
+type headerCarrier http.Header
+
+func (h headerCarrier) Get(key string) string      { return http.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string)      { http.Header(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string)      { http.Header(h).Add(key, value) }
+func (h headerCarrier) Keys() []string             { return nil }
+func (h headerCarrier) Values(key string) []string { return http.Header(h).Values(key) }
+
+// mockTransporter implements transport.Transporter for testing.
+type mockTransporter struct {
+	operation string
+	reqHeader headerCarrier
+	repHeader headerCarrier
+}
+
+func newMockTransporter(operation, key string) *mockTransporter {
+	tr := &mockTransporter{
+		operation: operation,
+		reqHeader: headerCarrier{},
+		repHeader: headerCarrier{},
+	}
+	if key != "" {
+		tr.reqHeader.Set(KeyHeader, key)
+	}
+	return tr
+}
+
+func (m *mockTransporter) Kind() transport.Kind            { return transport.KindHTTP }
+func (m *mockTransporter) Endpoint() string                { return "localhost:8000" }
+func (m *mockTransporter) Operation() string               { return m.operation }
+func (m *mockTransporter) RequestHeader() transport.Header { return m.reqHeader }
+func (m *mockTransporter) ReplyHeader() transport.Header   { return m.repHeader }
+
+const createOp = "/service.symbols.v1.SymbolsService/CreateSymbol"
+
+// countingHandler returns a new reply on every call and counts the invocations.
+type countingHandler struct {
+	calls int
+	err   error
+}
+
+func (c *countingHandler) handle(ctx context.Context, req interface{}) (interface{}, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return wrapperspb.UInt64(uint64(c.calls)), nil
+}
+
+func newTestHandler(reg *metrics.Registry) *Handler {
+	return NewHandler(NewMemoryStore(), []string{createOp}, time.Hour, reg, log.DefaultLogger)
+}
+
+func call(h *Handler, next *countingHandler, tr *mockTransporter, req proto.Message) (interface{}, error) {
+	ctx := transport.NewServerContext(context.Background(), tr)
+	return h.Middleware()(next.handle)(ctx, req)
+}
+
+func TestHandler_ReplaysOriginalResponse(t *testing.T) {
+	h := newTestHandler(nil)
+	next := &countingHandler{}
+
+	first, err := call(h, next, newMockTransporter(createOp, "key-1"), wrapperspb.String("payload"))
+	require.NoError(t, err)
+
+	tr := newMockTransporter(createOp, "key-1")
+	second, err := call(h, next, tr, wrapperspb.String("payload"))
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, next.calls, "handler must run only once")
+	assert.True(t, proto.Equal(first.(proto.Message), second.(proto.Message)))
+	assert.Equal(t, "true", tr.repHeader.Get(ReplayedHeader))
+}
+
+func TestHandler_RejectsKeyReuseWithDifferentPayload(t *testing.T) {
+	h := newTestHandler(nil)
+	next := &countingHandler{}
+
+	_, err := call(h, next, newMockTransporter(createOp, "key-1"), wrapperspb.String("payload"))
+	require.NoError(t, err)
+
+	_, err = call(h, next, newMockTransporter(createOp, "key-1"), wrapperspb.String("other payload"))
+	require.Error(t, err)
+	assert.Equal(t, "IDEMPOTENCY_KEY_REUSED", kratoserrors.Reason(err))
+	assert.Equal(t, 1, next.calls)
+}
+
+func TestHandler_RejectsRetryWhileInProgress(t *testing.T) {
+	store := NewMemoryStore()
+	h := NewHandler(store, []string{createOp}, time.Hour, nil, log.DefaultLogger)
+	next := &countingHandler{}
+
+	// Simulate an in-flight request holding the key
+	fp, err := requestFingerprint(createOp, wrapperspb.String("payload"))
+	require.NoError(t, err)
+	_, _, _ = store.Reserve(context.Background(), platform_middleware.AnonymousActor+"|key-1", fp, time.Minute)
+
+	_, err = call(h, next, newMockTransporter(createOp, "key-1"), wrapperspb.String("payload"))
+	require.Error(t, err)
+	assert.Equal(t, 409, int(kratoserrors.Code(err)))
+	assert.Equal(t, 0, next.calls)
+}
+
+func TestHandler_ReleasesKeyOnError(t *testing.T) {
+	h := newTestHandler(nil)
+	next := &countingHandler{err: errors.New("boom")}
+
+	_, err := call(h, next, newMockTransporter(createOp, "key-1"), wrapperspb.String("payload"))
+	require.Error(t, err)
+
+	next.err = nil
+	_, err = call(h, next, newMockTransporter(createOp, "key-1"), wrapperspb.String("payload"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, next.calls)
+}
+
+// releasingStore reports keys as taken but released before their record could be read.
+type releasingStore struct {
+	*MemoryStore
+}
+
+func (releasingStore) Reserve(context.Context, string, string, time.Duration) (*Record, bool, error) {
+	return nil, false, nil
+}
+
+func TestHandler_RunsWhenKeyReleasedDuringReserve(t *testing.T) {
+	h := NewHandler(releasingStore{NewMemoryStore()}, []string{createOp}, time.Hour, nil, log.DefaultLogger)
+	next := &countingHandler{}
+
+	reply, err := call(h, next, newMockTransporter(createOp, "key-1"), wrapperspb.String("payload"))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), reply.(*wrapperspb.UInt64Value).Value)
+	assert.Equal(t, 1, next.calls)
+}
+
+func TestHandler_PassesThrough(t *testing.T) {
+	tests := []struct {
+		name string
+		tr   *mockTransporter
+	}{
+		{name: "no key", tr: newMockTransporter(createOp, "")},
+		{name: "operation not configured", tr: newMockTransporter("/service.symbols.v1.SymbolsService/GetSymbol", "key-1")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestHandler(nil)
+			next := &countingHandler{}
+
+			for i := 0; i < 2; i++ {
+				_, err := call(h, next, tt.tr, wrapperspb.String("payload"))
+				require.NoError(t, err)
+			}
+			assert.Equal(t, 2, next.calls)
+		})
+	}
+}
+
+func TestHandler_ScopesKeysPerUser(t *testing.T) {
+	h := newTestHandler(nil)
+	next := &countingHandler{}
+
+	alice := newMockTransporter(createOp, "key-1")
+	alice.reqHeader.Set(platform_middleware.UserIDHeader, "alice")
+	bob := newMockTransporter(createOp, "key-1")
+	bob.reqHeader.Set(platform_middleware.UserIDHeader, "bob")
+
+	_, err := call(h, next, alice, wrapperspb.String("payload"))
+	require.NoError(t, err)
+	_, err = call(h, next, bob, wrapperspb.String("payload"))
+	require.NoError(t, err)
+
+	// The caller identified by CallerMiddleware wins over the header
+	mallory := newMockTransporter(createOp, "key-1")
+	mallory.reqHeader.Set(platform_middleware.UserIDHeader, "alice")
+	ctx := platform_middleware.WithCaller(transport.NewServerContext(context.Background(), mallory), platform_middleware.Caller{Actor: "mallory"})
+	_, err = h.Middleware()(next.handle)(ctx, wrapperspb.String("payload"))
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, next.calls)
+}
+
+func TestHandler_RejectsOversizedKey(t *testing.T) {
+	h := newTestHandler(nil)
+	next := &countingHandler{}
+
+	key := make([]byte, MaxKeyLength+1)
+	for i := range key {
+		key[i] = 'a'
+	}
+
+	_, err := call(h, next, newMockTransporter(createOp, string(key)), wrapperspb.String("payload"))
+	require.Error(t, err)
+	assert.Equal(t, "IDEMPOTENCY_KEY_INVALID", kratoserrors.Reason(err))
+}
+
+func TestHandler_RecordsMetrics(t *testing.T) {
+	reg := metrics.NewRegistry(build.NewBuildInfo("test_service", "1.0.0"))
+	h := newTestHandler(reg)
+	next := &countingHandler{}
+
+	_, _ = call(h, next, newMockTransporter(createOp, "key-1"), wrapperspb.String("payload"))
+	_, _ = call(h, next, newMockTransporter(createOp, "key-1"), wrapperspb.String("payload"))
+
+	families, err := reg.Unwrap().Gather()
+	require.NoError(t, err)
+
+	outcomes := map[string]float64{}
+	for _, mf := range families {
+		if mf.GetName() != "test_service_idempotency_requests_total" {
+			continue
+		}
+		for _, m := range mf.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "outcome" {
+					outcomes[l.GetValue()] = m.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	assert.Equal(t, map[string]float64{"stored": 1, "replayed": 1}, outcomes)
+}
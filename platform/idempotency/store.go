@@ -0,0 +1,110 @@
+// Package idempotency provides idempotency-key handling for mutating Kratos operations.
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Record is the stored state of an idempotency key.
+type Record struct {
+	Fingerprint string // hash of the operation and request payload
+	Response    []byte // serialized response, set once the request completed
+	Completed   bool
+	ExpiresAt   time.Time
+}
+
+// Store keeps idempotency records keyed by an opaque string.
+// Implementations must be safe for concurrent use. The in-memory store is suitable
+// for a single replica; a distributed store (e.g. Redis) can be plugged in to share
+// keys across replicas.
+type Store interface {
+	// Reserve atomically claims key for a request with the given fingerprint.
+	// When the key is already taken, the existing record is returned and reserved is false.
+	// existing may be nil when the key was released while the store looked it up.
+	Reserve(ctx context.Context, key, fingerprint string, ttl time.Duration) (existing *Record, reserved bool, err error)
+
+	// Complete stores the response of a reserved key.
+	Complete(ctx context.Context, key string, response []byte, ttl time.Duration) error
+
+	// Release removes a reservation so the request can be retried.
+	Release(ctx context.Context, key string) error
+}
+
+// Purger is implemented by stores whose expired records are removed by a periodic job rather than
+// lazily, like stores shared by several replicas.
+type Purger interface {
+	// Purge removes the records expired at now and returns how many were removed.
+	Purge(ctx context.Context, now time.Time) (int64, error)
+}
+
+// MemoryStore is an in-process Store implementation.
+type MemoryStore struct {
+	mu        sync.Mutex
+	records   map[string]*Record
+	now       func() time.Time
+	lastSweep time.Time
+}
+
+// NewMemoryStore creates a new in-memory idempotency store.
+// Expired records are evicted lazily.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		records: make(map[string]*Record),
+		now:     time.Now,
+	}
+}
+
+// Reserve claims key unless a non-expired record exists.
+func (s *MemoryStore) Reserve(_ context.Context, key, fingerprint string, ttl time.Duration) (*Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	if r, ok := s.records[key]; ok && now.Before(r.ExpiresAt) {
+		existing := *r
+		return &existing, false, nil
+	}
+
+	s.records[key] = &Record{Fingerprint: fingerprint, ExpiresAt: now.Add(ttl)}
+	return nil, true, nil
+}
+
+// Complete stores the response for key and restarts its TTL.
+func (s *MemoryStore) Complete(_ context.Context, key string, response []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r, ok := s.records[key]; ok {
+		r.Response = response
+		r.Completed = true
+		r.ExpiresAt = s.now().Add(ttl)
+	}
+	return nil
+}
+
+// Release removes key.
+func (s *MemoryStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, key)
+	return nil
+}
+
+// sweep removes expired records at most once per minute. Must be called with the lock held.
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < time.Minute {
+		return
+	}
+	s.lastSweep = now
+
+	for key, r := range s.records {
+		if !now.Before(r.ExpiresAt) {
+			delete(s.records, key)
+		}
+	}
+}
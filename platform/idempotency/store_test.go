@@ -0,0 +1,84 @@
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a controllable time source for the memory store.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestStore(clock *fakeClock) *MemoryStore {
+	s := NewMemoryStore()
+	s.now = clock.Now
+	return s
+}
+
+func TestMemoryStore_ReserveCompleteReplay(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	store := newTestStore(clock)
+	ctx := context.Background()
+
+	existing, reserved, err := store.Reserve(ctx, "k", "fp", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+	assert.Nil(t, existing)
+
+	// A concurrent retry sees the in-flight reservation
+	existing, reserved, err = store.Reserve(ctx, "k", "fp", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, reserved)
+	assert.False(t, existing.Completed)
+
+	require.NoError(t, store.Complete(ctx, "k", []byte("response"), time.Hour))
+
+	existing, reserved, err = store.Reserve(ctx, "k", "fp", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, reserved)
+	assert.True(t, existing.Completed)
+	assert.Equal(t, "fp", existing.Fingerprint)
+	assert.Equal(t, []byte("response"), existing.Response)
+}
+
+func TestMemoryStore_Expiry(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	store := newTestStore(clock)
+	ctx := context.Background()
+
+	_, _, _ = store.Reserve(ctx, "k", "fp", time.Minute)
+	require.NoError(t, store.Complete(ctx, "k", []byte("response"), time.Hour))
+
+	clock.Advance(59 * time.Minute)
+	_, reserved, _ := store.Reserve(ctx, "k", "fp", time.Minute)
+	assert.False(t, reserved)
+
+	clock.Advance(2 * time.Minute)
+	_, reserved, _ = store.Reserve(ctx, "k", "other", time.Minute)
+	assert.True(t, reserved)
+}
+
+func TestMemoryStore_Release(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+
+	_, _, _ = store.Reserve(ctx, "k", "fp", time.Minute)
+	require.NoError(t, store.Release(ctx, "k"))
+
+	_, reserved, err := store.Reserve(ctx, "k", "fp", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+}
@@ -0,0 +1,132 @@
+// Package inbox deduplicates consumed messages, so at-least-once deliveries and handler retries
+// take effect exactly once per consumer.
+package inbox
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+// DefaultTTL is used when no TTL is configured. It must outlive the redeliveries of a message.
+const DefaultTTL = 7 * 24 * time.Hour
+
+// Store records the messages a consumer processed, keyed by message UUID and consumer name.
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Process runs fn unless consumer already processed messageID, and records the message as
+	// processed for ttl when fn succeeds. duplicate reports that fn was skipped.
+	// Database stores run fn in the transaction recording the message, so the handler writes and
+	// the record commit or roll back together.
+	Process(ctx context.Context, consumer, messageID string, ttl time.Duration, fn func(ctx context.Context) error) (duplicate bool, err error)
+}
+
+// Middleware returns router middleware running the handler at most once per message for consumer.
+// Duplicates are acked without running the handler. Place it inside the Retry middleware, so every
+// try runs in its own transaction, and outside Recoverer, so panics roll the transaction back.
+func Middleware(store Store, consumer string, ttl time.Duration, logger watermill.LoggerAdapter) message.HandlerMiddleware {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+
+	return func(h message.HandlerFunc) message.HandlerFunc {
+		return func(msg *message.Message) ([]*message.Message, error) {
+			var produced []*message.Message
+
+			duplicate, err := store.Process(msg.Context(), consumer, msg.UUID, ttl, func(ctx context.Context) error {
+				msg.SetContext(ctx)
+
+				var err error
+				produced, err = h(msg)
+				return err
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			if duplicate {
+				logger.Info("Skipping duplicate message", watermill.LogFields{
+					"message_uuid": msg.UUID,
+					"consumer":     consumer,
+				})
+				return nil, nil
+			}
+
+			return produced, nil
+		}
+	}
+}
+
+// MemoryStore is an in-process Store implementation, suitable for a single replica.
+// Its records do not share a transaction with the handler: a crash between the handler and the
+// record lets the message run again.
+type MemoryStore struct {
+	mu        sync.Mutex
+	records   map[key]record
+	now       func() time.Time
+	lastSweep time.Time
+}
+
+type key struct {
+	consumer  string
+	messageID string
+}
+
+type record struct {
+	completed bool // false while the handler runs
+	expiresAt time.Time
+}
+
+// NewMemoryStore creates a new in-memory inbox store.
+// Expired records are evicted lazily.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		records: make(map[key]record),
+		now:     time.Now,
+	}
+}
+
+// Process runs fn unless the message was processed, or is being processed, by consumer.
+// A message running concurrently counts as a duplicate: when its handler fails, it is redelivered.
+func (s *MemoryStore) Process(ctx context.Context, consumer, messageID string, ttl time.Duration, fn func(ctx context.Context) error) (bool, error) {
+	k := key{consumer: consumer, messageID: messageID}
+
+	s.mu.Lock()
+	now := s.now()
+	s.sweep(now)
+	if r, ok := s.records[k]; ok && (!r.completed || now.Before(r.expiresAt)) {
+		s.mu.Unlock()
+		return true, nil
+	}
+	s.records[k] = record{}
+	s.mu.Unlock()
+
+	err := fn(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil {
+		delete(s.records, k)
+		return false, err
+	}
+	s.records[k] = record{completed: true, expiresAt: s.now().Add(ttl)}
+
+	return false, nil
+}
+
+// sweep removes expired records at most once per minute. Must be called with the lock held.
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < time.Minute {
+		return
+	}
+	s.lastSweep = now
+
+	for k, r := range s.records {
+		if r.completed && !now.Before(r.expiresAt) {
+			delete(s.records, k)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package inbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a controllable time source for the memory store.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestStore(clock *fakeClock) *MemoryStore {
+	s := NewMemoryStore()
+	s.now = clock.Now
+	return s
+}
+
+func TestMemoryStore_Process(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	store := newTestStore(clock)
+	ctx := context.Background()
+
+	calls := 0
+	fn := func(context.Context) error {
+		calls++
+		return nil
+	}
+
+	duplicate, err := store.Process(ctx, "consumer", "m1", time.Hour, fn)
+	require.NoError(t, err)
+	assert.False(t, duplicate)
+
+	duplicate, err = store.Process(ctx, "consumer", "m1", time.Hour, fn)
+	require.NoError(t, err)
+	assert.True(t, duplicate, "a processed message is a duplicate")
+
+	duplicate, err = store.Process(ctx, "other", "m1", time.Hour, fn)
+	require.NoError(t, err)
+	assert.False(t, duplicate, "records are scoped per consumer")
+	assert.Equal(t, 2, calls)
+
+	clock.Advance(time.Hour)
+	duplicate, err = store.Process(ctx, "consumer", "m1", time.Hour, fn)
+	require.NoError(t, err)
+	assert.False(t, duplicate, "expired records no longer deduplicate")
+	assert.Equal(t, 3, calls)
+}
+
+func TestMemoryStore_FailureIsRetried(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+	errHandler := errors.New("db down")
+
+	duplicate, err := store.Process(ctx, "consumer", "m1", time.Hour, func(context.Context) error { return errHandler })
+	assert.ErrorIs(t, err, errHandler)
+	assert.False(t, duplicate)
+
+	calls := 0
+	duplicate, err = store.Process(ctx, "consumer", "m1", time.Hour, func(context.Context) error {
+		calls++
+		return nil
+	})
+	require.NoError(t, err)
+	assert.False(t, duplicate)
+	assert.Equal(t, 1, calls)
+}
+
+func TestMemoryStore_InFlightIsDuplicate(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+
+	_, err := store.Process(ctx, "consumer", "m1", time.Hour, func(ctx context.Context) error {
+		duplicate, err := store.Process(ctx, "consumer", "m1", time.Hour, func(context.Context) error {
+			t.Fatal("duplicate ran while the message was in flight")
+			return nil
+		})
+		require.NoError(t, err)
+		assert.True(t, duplicate)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestMemoryStore_Sweep(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	store := newTestStore(clock)
+	ctx := context.Background()
+	noop := func(context.Context) error { return nil }
+
+	_, err := store.Process(ctx, "consumer", "m1", time.Minute, noop)
+	require.NoError(t, err)
+
+	clock.Advance(2 * time.Minute)
+	_, err = store.Process(ctx, "consumer", "m2", time.Minute, noop)
+	require.NoError(t, err)
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	assert.NotContains(t, store.records, key{consumer: "consumer", messageID: "m1"})
+	assert.Contains(t, store.records, key{consumer: "consumer", messageID: "m2"})
+}
+
+func TestMiddleware(t *testing.T) {
+	store := NewMemoryStore()
+	calls := 0
+	h := Middleware(store, "consumer", 0, watermill.NopLogger{})(func(msg *message.Message) ([]*message.Message, error) {
+		calls++
+		return []*message.Message{message.NewMessage(watermill.NewUUID(), nil)}, nil
+	})
+
+	msg := message.NewMessage(watermill.NewUUID(), nil)
+
+	produced, err := h(msg)
+	require.NoError(t, err)
+	assert.Len(t, produced, 1)
+
+	// A redelivery of the same message is acked without running the handler
+	produced, err = h(msg.Copy())
+	require.NoError(t, err)
+	assert.Empty(t, produced)
+	assert.Equal(t, 1, calls)
+}
+
+func TestMiddleware_HandlerError(t *testing.T) {
+	store := NewMemoryStore()
+	errHandler := errors.New("db down")
+	fail := true
+	h := Middleware(store, "consumer", time.Hour, watermill.NopLogger{})(func(msg *message.Message) ([]*message.Message, error) {
+		if fail {
+			return nil, errHandler
+		}
+		return nil, nil
+	})
+
+	msg := message.NewMessage(watermill.NewUUID(), nil)
+
+	_, err := h(msg)
+	assert.ErrorIs(t, err, errHandler)
+
+	fail = false
+	_, err = h(msg)
+	assert.NoError(t, err, "a failed try does not mark the message processed")
+}
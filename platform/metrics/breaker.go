@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Circuit breaker states, the values of the circuit_breaker_state gauge.
+const (
+	CircuitClosed   = 0
+	CircuitHalfOpen = 1
+	CircuitOpen     = 2
+)
+
+// circuitStateNames labels the state changes of a circuit breaker.
+var circuitStateNames = map[int]string{
+	CircuitClosed:   "closed",
+	CircuitHalfOpen: "half_open",
+	CircuitOpen:     "open",
+}
+
+// CircuitBreakerMetrics records the state of circuit breakers, labelled by breaker name.
+type CircuitBreakerMetrics struct {
+	state        *prometheus.GaugeVec
+	stateChanges *prometheus.CounterVec
+	spooled      *prometheus.GaugeVec
+}
+
+// NewCircuitBreakerMetrics registers the circuit breaker metrics. It returns nil without registry,
+// and a nil *CircuitBreakerMetrics records nothing.
+func NewCircuitBreakerMetrics(registry *Registry) *CircuitBreakerMetrics {
+	if registry == nil {
+		return nil
+	}
+
+	return &CircuitBreakerMetrics{
+		state: registry.NewGaugeVec(
+			"circuit_breaker_state",
+			"Circuit breaker state: 0 closed, 1 half-open, 2 open",
+			[]string{"name"},
+		),
+		stateChanges: registry.NewCounterVec(
+			"circuit_breaker_state_changes_total",
+			"Total number of circuit breaker state changes, by new state",
+			[]string{"name", "state"},
+		),
+		spooled: registry.NewGaugeVec(
+			"circuit_breaker_spooled_messages",
+			"Number of messages spooled while waiting for the breaker to close",
+			[]string{"name"},
+		),
+	}
+}
+
+// SetState records the state of the breaker name, one of CircuitClosed, CircuitHalfOpen and CircuitOpen.
+func (m *CircuitBreakerMetrics) SetState(name string, state int) {
+	if m == nil {
+		return
+	}
+
+	m.state.WithLabelValues(name).Set(float64(state))
+	m.stateChanges.WithLabelValues(name, circuitStateNames[state]).Inc()
+}
+
+// SetSpooled records the number of messages the breaker name holds in its spool.
+func (m *CircuitBreakerMetrics) SetSpooled(name string, n int) {
+	if m == nil {
+		return
+	}
+
+	m.spooled.WithLabelValues(name).Set(float64(n))
+}
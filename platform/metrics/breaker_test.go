@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"platform/build"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreakerMetrics(t *testing.T) {
+	reg := NewRegistry(build.NewBuildInfo("test_service", "1.0.0"))
+	m := NewCircuitBreakerMetrics(reg)
+
+	m.SetState("amqp_publisher", CircuitOpen)
+	m.SetState("amqp_publisher", CircuitHalfOpen)
+	m.SetState("amqp_publisher", CircuitOpen)
+	m.SetSpooled("amqp_publisher", 3)
+
+	state := gatherMetric(t, reg, "test_service_circuit_breaker_state")
+	require.Contains(t, state, "amqp_publisher")
+	assert.Equal(t, float64(CircuitOpen), state["amqp_publisher"].GetGauge().GetValue())
+
+	changes := gatherMetric(t, reg, "test_service_circuit_breaker_state_changes_total")
+	assert.Equal(t, float64(2), changes["amqp_publisher,open"].GetCounter().GetValue())
+	assert.Equal(t, float64(1), changes["amqp_publisher,half_open"].GetCounter().GetValue())
+
+	spooled := gatherMetric(t, reg, "test_service_circuit_breaker_spooled_messages")
+	assert.Equal(t, float64(3), spooled["amqp_publisher"].GetGauge().GetValue())
+}
+
+func TestCircuitBreakerMetrics_Nil(t *testing.T) {
+	m := NewCircuitBreakerMetrics(nil)
+
+	assert.Nil(t, m)
+	assert.NotPanics(t, func() {
+		m.SetState("amqp_publisher", CircuitOpen)
+		m.SetSpooled("amqp_publisher", 1)
+	})
+}
@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Publish outcomes of EventPublishMetrics.
+const (
+	OutcomeSuccess = "success"
+	OutcomeError   = "error"
+)
+
+// EventPublishMetrics records the events a service publishes, labelled by event type.
+// Services wrap their typed event publishers with it, since those do not implement events.Publisher.
+type EventPublishMetrics struct {
+	publishedTotal    *prometheus.CounterVec
+	publishDuration   *prometheus.HistogramVec
+	publishErrorTotal *prometheus.CounterVec
+	lastPublished     *prometheus.GaugeVec
+}
+
+// NewEventPublishMetrics registers the event publishing metrics. It returns nil without registry,
+// and a nil *EventPublishMetrics records nothing.
+func NewEventPublishMetrics(registry *Registry) *EventPublishMetrics {
+	if registry == nil {
+		return nil
+	}
+
+	return &EventPublishMetrics{
+		publishedTotal: registry.NewCounterVec(
+			"events_published_total",
+			"Total number of event publish attempts",
+			[]string{"event_type", "outcome"},
+		),
+		publishDuration: registry.NewHistogramVec(
+			"event_publish_duration_seconds",
+			"Event publish duration in seconds",
+			defaultHistogramBuckets,
+			[]string{"event_type", "outcome"},
+		),
+		publishErrorTotal: registry.NewCounterVec(
+			"event_publish_errors_total",
+			"Total number of event publish errors",
+			[]string{"event_type"},
+		),
+		lastPublished: registry.NewGaugeVec(
+			"event_last_published_timestamp_seconds",
+			"Unix time of the last successful publish",
+			[]string{"event_type"},
+		),
+	}
+}
+
+// Observe records a publish of eventType that started at start and returned err.
+func (m *EventPublishMetrics) Observe(eventType string, start time.Time, err error) {
+	if m == nil {
+		return
+	}
+
+	outcome := OutcomeSuccess
+	if err != nil {
+		outcome = OutcomeError
+		m.publishErrorTotal.WithLabelValues(eventType).Inc()
+	} else {
+		m.lastPublished.WithLabelValues(eventType).SetToCurrentTime()
+	}
+
+	m.publishedTotal.WithLabelValues(eventType, outcome).Inc()
+	m.publishDuration.WithLabelValues(eventType, outcome).Observe(time.Since(start).Seconds())
+}
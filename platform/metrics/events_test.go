@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"errors"
+	"platform/build"
+	"testing"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// gatherMetric returns the metrics of the named family, keyed by their joined label values.
+func gatherMetric(t *testing.T, reg *Registry, name string) map[string]*dto.Metric {
+	families, err := reg.Unwrap().Gather()
+	require.NoError(t, err)
+
+	metrics := make(map[string]*dto.Metric)
+	for _, f := range families {
+		if f.GetName() != name {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			key := ""
+			for i, l := range m.GetLabel() {
+				if i > 0 {
+					key += ","
+				}
+				key += l.GetValue()
+			}
+			metrics[key] = m
+		}
+	}
+	return metrics
+}
+
+func TestEventPublishMetrics_Observe(t *testing.T) {
+	reg := NewRegistry(build.NewBuildInfo("test_service", "1.0.0"))
+	m := NewEventPublishMetrics(reg)
+
+	before := time.Now()
+	m.Observe("symbol.created", time.Now(), nil)
+	m.Observe("symbol.created", time.Now(), nil)
+	m.Observe("symbol.created", time.Now(), errors.New("broker down"))
+	m.Observe("symbol.deleted", time.Now(), errors.New("broker down"))
+
+	published := gatherMetric(t, reg, "test_service_events_published_total")
+	assert.Equal(t, float64(2), published["symbol.created,success"].GetCounter().GetValue())
+	assert.Equal(t, float64(1), published["symbol.created,error"].GetCounter().GetValue())
+	assert.Equal(t, float64(1), published["symbol.deleted,error"].GetCounter().GetValue())
+
+	durations := gatherMetric(t, reg, "test_service_event_publish_duration_seconds")
+	assert.Equal(t, uint64(2), durations["symbol.created,success"].GetHistogram().GetSampleCount())
+	assert.Equal(t, uint64(1), durations["symbol.created,error"].GetHistogram().GetSampleCount())
+
+	errs := gatherMetric(t, reg, "test_service_event_publish_errors_total")
+	assert.Equal(t, float64(1), errs["symbol.created"].GetCounter().GetValue())
+	assert.Equal(t, float64(1), errs["symbol.deleted"].GetCounter().GetValue())
+
+	last := gatherMetric(t, reg, "test_service_event_last_published_timestamp_seconds")
+	require.Contains(t, last, "symbol.created")
+	assert.GreaterOrEqual(t, last["symbol.created"].GetGauge().GetValue(), float64(before.Unix()))
+	assert.NotContains(t, last, "symbol.deleted", "failed publishes leave the timestamp unset")
+}
+
+func TestEventPublishMetrics_Nil(t *testing.T) {
+	m := NewEventPublishMetrics(nil)
+
+	assert.Nil(t, m)
+	assert.NotPanics(t, func() { m.Observe("symbol.created", time.Now(), nil) })
+}
@@ -0,0 +1,200 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	kratoshttp "github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+)
+
+// Request headers used to identify the caller.
+//
+// The services do not authenticate users themselves: the identity and role headers are trusted
+// as received. They must be deployed behind a gateway that authenticates the user, strips these
+// headers from client requests and sets them from the verified identity; a client reaching a
+// service directly can claim any user or role, including AdminRole. For the same reason the CORS
+// allowed headers must not include them.
+const (
+	UserIDHeader       = "X-User-ID"
+	SessionIDHeader    = "X-Session-ID"
+	RolesHeader        = "X-User-Roles" // comma separated
+	ForwardedForHeader = "X-Forwarded-For"
+)
+
+// AdminRole is the role granting administrative overrides.
+const AdminRole = "admin"
+
+// AnonymousActor is recorded when the request does not identify a user.
+const AnonymousActor = "anonymous"
+
+// UnknownClientIP is returned when the client address cannot be determined.
+const UnknownClientIP = "unknown"
+
+type callerKey struct{}
+
+// Caller describes who issued the current request and from where.
+type Caller struct {
+	Actor     string
+	SessionID string
+	Roles     []string
+	RequestID string
+	ClientIP  string
+}
+
+// HasRole reports whether the caller was granted the given role.
+func (c Caller) HasRole(role string) bool {
+	for _, r := range c.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// TrustedProxies lists the networks of the proxies whose X-Forwarded-For header is honoured.
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies parses CIDR ranges or single IP addresses.
+func ParseTrustedProxies(entries []string) (TrustedProxies, error) {
+	proxies := make(TrustedProxies, 0, len(entries))
+	for _, e := range entries {
+		e = strings.TrimSpace(e)
+		if !strings.Contains(e, "/") {
+			ip := net.ParseIP(e)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", e)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(e)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", e, err)
+		}
+		proxies = append(proxies, network)
+	}
+	return proxies, nil
+}
+
+// Contains reports whether the address belongs to a trusted proxy.
+func (p TrustedProxies) Contains(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, network := range p {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// CallerMiddleware stores the Caller of the request in the context.
+// It must run after RequestIDMiddleware so the request ID is available.
+// X-Forwarded-For is only honoured on requests coming from the trusted proxies.
+func CallerMiddleware(trusted TrustedProxies) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			actor := tr.RequestHeader().Get(UserIDHeader)
+			if actor == "" {
+				actor = AnonymousActor
+			}
+
+			ctx = WithCaller(ctx, Caller{
+				Actor:     actor,
+				SessionID: tr.RequestHeader().Get(SessionIDHeader),
+				Roles:     parseRoles(tr.RequestHeader().Get(RolesHeader)),
+				RequestID: RequestIDFromContext(ctx),
+				ClientIP:  ClientIP(ctx, tr, trusted),
+			})
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+// WithCaller returns a copy of ctx carrying the given Caller.
+func WithCaller(ctx context.Context, c Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, c)
+}
+
+// CallerFromContext returns the Caller stored by CallerMiddleware.
+func CallerFromContext(ctx context.Context) (Caller, bool) {
+	c, ok := ctx.Value(callerKey{}).(Caller)
+	return c, ok
+}
+
+// RequestIDFromContext returns the request ID stored by RequestIDMiddleware, or an empty string.
+func RequestIDFromContext(ctx context.Context) string {
+	rid, _ := ctx.Value(requestIDKey{}).(string)
+	return rid
+}
+
+// parseRoles splits a comma separated role list, dropping empty entries.
+func parseRoles(header string) []string {
+	var roles []string
+	for _, r := range strings.Split(header, ",") {
+		if r = strings.TrimSpace(r); r != "" {
+			roles = append(roles, r)
+		}
+	}
+	return roles
+}
+
+// ClientIP returns the originating client address of the request: the HTTP remote address or the
+// gRPC peer. When that address is a trusted proxy, X-Forwarded-For is walked from the right and the
+// first entry not added by a trusted proxy wins; entries further left are set by the client and
+// cannot be relied upon.
+func ClientIP(ctx context.Context, tr transport.Transporter, trusted TrustedProxies) string {
+	ip := remoteIP(ctx, tr)
+	if ip == UnknownClientIP || !trusted.Contains(ip) {
+		return ip
+	}
+
+	hops := strings.Split(tr.RequestHeader().Get(ForwardedForHeader), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			break
+		}
+		ip = hop
+		if !trusted.Contains(hop) {
+			break
+		}
+	}
+	return ip
+}
+
+// remoteIP returns the address of the peer connected to the server.
+func remoteIP(ctx context.Context, tr transport.Transporter) string {
+	var addr string
+	if ht, ok := tr.(kratoshttp.Transporter); ok && ht.Request() != nil {
+		addr = ht.Request().RemoteAddr
+	} else if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+	}
+	if addr == "" {
+		return UnknownClientIP
+	}
+
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
@@ -0,0 +1,158 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/peer"
+)
+
+func TestCallerMiddleware(t *testing.T) {
+	tests := []struct {
+		name       string
+		headers    map[string][]string
+		wantActor  string
+		wantIP     string
+		wantReqID  string
+		wantSess   string
+		wantRoles  []string
+		setupCtxFn func(context.Context) context.Context
+	}{
+		{
+			name: "user header and forwarded address of an untrusted peer",
+			headers: map[string][]string{
+				UserIDHeader:       {"user-42"},
+				ForwardedForHeader: {"203.0.113.5, 10.0.0.1"},
+			},
+			wantActor: "user-42",
+			wantIP:    UnknownClientIP,
+		},
+		{
+			name:      "anonymous caller without address",
+			headers:   map[string][]string{},
+			wantActor: AnonymousActor,
+			wantIP:    UnknownClientIP,
+		},
+		{
+			name: "session and roles",
+			headers: map[string][]string{
+				UserIDHeader:    {"user-42"},
+				SessionIDHeader: {"sess-1"},
+				RolesHeader:     {"editor, admin,"},
+			},
+			wantActor: "user-42",
+			wantIP:    UnknownClientIP,
+			wantSess:  "sess-1",
+			wantRoles: []string{"editor", "admin"},
+		},
+		{
+			name:      "request id and grpc peer",
+			headers:   map[string][]string{},
+			wantActor: AnonymousActor,
+			wantIP:    "192.0.2.10",
+			wantReqID: "550e8400-e29b-41d4-a716-446655440000",
+			setupCtxFn: func(ctx context.Context) context.Context {
+				ctx = context.WithValue(ctx, requestIDKey{}, "550e8400-e29b-41d4-a716-446655440000")
+				return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.10"), Port: 5555}})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mt := newMockTransporter()
+			mt.requestHeaders = tt.headers
+
+			ctx := transport.NewServerContext(context.Background(), mt)
+			if tt.setupCtxFn != nil {
+				ctx = tt.setupCtxFn(ctx)
+			}
+
+			var got Caller
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				var ok bool
+				got, ok = CallerFromContext(ctx)
+				require.True(t, ok)
+				return nil, nil
+			}
+
+			_, err := CallerMiddleware(nil)(handler)(ctx, nil)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantActor, got.Actor)
+			assert.Equal(t, tt.wantIP, got.ClientIP)
+			assert.Equal(t, tt.wantReqID, got.RequestID)
+			assert.Equal(t, tt.wantSess, got.SessionID)
+			assert.Equal(t, tt.wantRoles, got.Roles)
+		})
+	}
+}
+
+func TestCallerMiddleware_NoTransport(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		_, ok := CallerFromContext(ctx)
+		assert.False(t, ok)
+		return nil, nil
+	}
+
+	_, err := CallerMiddleware(nil)(handler)(context.Background(), nil)
+	assert.NoError(t, err)
+}
+
+func TestCaller_HasRole(t *testing.T) {
+	c := Caller{Roles: []string{"editor", AdminRole}}
+
+	assert.True(t, c.HasRole(AdminRole))
+	assert.False(t, c.HasRole("owner"))
+	assert.False(t, Caller{}.HasRole(AdminRole))
+}
+
+func TestClientIP(t *testing.T) {
+	trusted, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.0.2.1"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		peer      string
+		forwarded string
+		trusted   TrustedProxies
+		want      string
+	}{
+		{name: "no trusted proxies", peer: "10.0.0.1", forwarded: "203.0.113.5", want: "10.0.0.1"},
+		{name: "untrusted peer", peer: "198.51.100.7", forwarded: "203.0.113.5", trusted: trusted, want: "198.51.100.7"},
+		{name: "trusted proxy", peer: "10.0.0.1", forwarded: "203.0.113.5", trusted: trusted, want: "203.0.113.5"},
+		{name: "chain of trusted proxies", peer: "10.0.0.1", forwarded: "203.0.113.5, 192.0.2.1, 10.0.0.2", trusted: trusted, want: "203.0.113.5"},
+		{name: "spoofed entries left of the client", peer: "10.0.0.1", forwarded: "1.2.3.4, 203.0.113.5", trusted: trusted, want: "203.0.113.5"},
+		{name: "trusted proxy without header", peer: "192.0.2.1", trusted: trusted, want: "192.0.2.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mt := newMockTransporter()
+			if tt.forwarded != "" {
+				mt.requestHeaders[ForwardedForHeader] = []string{tt.forwarded}
+			}
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(tt.peer), Port: 5555}})
+
+			assert.Equal(t, tt.want, ClientIP(ctx, mt, tt.trusted))
+		})
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"172.16.0.0/12", " 2001:db8::1 "})
+	require.NoError(t, err)
+	assert.True(t, proxies.Contains("172.20.0.3"))
+	assert.True(t, proxies.Contains("2001:db8::1"))
+	assert.False(t, proxies.Contains("2001:db8::2"))
+	assert.False(t, proxies.Contains("not-an-ip"))
+
+	_, err = ParseTrustedProxies([]string{"proxy.local"})
+	assert.Error(t, err)
+	_, err = ParseTrustedProxies([]string{"10.0.0.0/33"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,212 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"platform/metrics"
+	platform_middleware "platform/middleware"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	kratosratelimit "github.com/go-kratos/kratos/v2/middleware/ratelimit"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Request headers used to identify the caller.
+const (
+	APIKeyHeader       = "X-API-Key"
+	UserIDHeader       = platform_middleware.UserIDHeader
+	ForwardedForHeader = platform_middleware.ForwardedForHeader
+)
+
+// Response headers describing the limit state (draft-ietf-httpapi-ratelimit-headers).
+const (
+	RetryAfterHeader = "Retry-After"
+	LimitHeader      = "RateLimit-Limit"
+	RemainingHeader  = "RateLimit-Remaining"
+	ResetHeader      = "RateLimit-Reset"
+)
+
+// AnyOperation matches every operation in a Rule.
+const AnyOperation = "*"
+
+// KeyType selects which caller attribute a rule is keyed by.
+//
+// KeyAPIKey and KeyUser are keyed by the raw X-API-Key and X-User-ID request headers, and their
+// rules are skipped for requests without the header. A client can evade them by rotating or
+// omitting the header, so they only limit anything behind a gateway that authenticates the
+// caller and sets or strips these headers.
+type KeyType string
+
+const (
+	KeyAPIKey  KeyType = "api_key"
+	KeyUser    KeyType = "user"
+	KeyProject KeyType = "project"
+	KeyIP      KeyType = "ip"
+)
+
+// Rule limits calls to an operation per caller attribute.
+// Operation is the full Kratos operation (e.g. "/service.symbols.v1.SymbolsService/CreateSymbol")
+// or AnyOperation to match every call.
+type Rule struct {
+	Operation string
+	Key       KeyType
+	Limit     Limit
+}
+
+// projectScoped is implemented by request messages that carry a project id.
+type projectScoped interface {
+	GetProjectId() uint64
+}
+
+// Limiter applies token-bucket rules to incoming requests.
+type Limiter struct {
+	store          Store
+	rules          []Rule
+	log            *log.Helper
+	throttledTotal *prometheus.CounterVec
+}
+
+// NewLimiter creates a limiter for the given rules.
+// The registry may be nil, in which case no metrics are recorded.
+func NewLimiter(store Store, rules []Rule, reg *metrics.Registry, logger log.Logger) *Limiter {
+	l := &Limiter{
+		store: store,
+		rules: rules,
+		log:   log.NewHelper(logger),
+	}
+
+	if reg != nil {
+		l.throttledTotal = reg.NewCounterVec(
+			"ratelimit_throttled_total",
+			"Total number of requests rejected by the per-client rate limiter",
+			[]string{"operation", "key"},
+		)
+	}
+
+	return l
+}
+
+// Middleware returns a Kratos server middleware enforcing the limiter rules.
+// Requests that exceed a limit are rejected with HTTP 429 / gRPC RESOURCE_EXHAUSTED, and the tokens
+// the other rules took for them are refunded, so rejected requests only count against the limit
+// that rejected them. Store failures are logged and the request is allowed (fail open).
+func (l *Limiter) Middleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+
+			operation := tr.Operation()
+
+			var (
+				tightest *Result
+				denied   *Result
+				taken    []takenToken
+			)
+			for _, rule := range l.rules {
+				if rule.Operation != AnyOperation && rule.Operation != operation {
+					continue
+				}
+
+				value, ok := keyValue(ctx, tr, req, rule.Key)
+				if !ok {
+					// The caller attribute is not available for this request; the rule does not apply.
+					continue
+				}
+
+				key := fmt.Sprintf("%s|%s|%s", rule.Operation, rule.Key, value)
+				res, err := l.store.Allow(ctx, key, rule.Limit)
+				if err != nil {
+					l.log.WithContext(ctx).Errorf("rate limit store error for %s: %v", key, err)
+					continue
+				}
+
+				if tightest == nil || res.Remaining < tightest.Remaining {
+					r := res
+					tightest = &r
+				}
+
+				if !res.Allowed {
+					r := res
+					denied = &r
+					if l.throttledTotal != nil {
+						l.throttledTotal.WithLabelValues(operation, string(rule.Key)).Inc()
+					}
+					break
+				}
+				taken = append(taken, takenToken{key: key, limit: rule.Limit})
+			}
+
+			if denied != nil {
+				l.refund(ctx, taken)
+				setHeaders(tr, *denied)
+				tr.ReplyHeader().Set(RetryAfterHeader, ceilSeconds(denied.RetryAfter))
+				return nil, kratosratelimit.ErrLimitExceed
+			}
+
+			if tightest != nil {
+				setHeaders(tr, *tightest)
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+// takenToken is a token taken from the bucket of key for the current request.
+type takenToken struct {
+	key   string
+	limit Limit
+}
+
+// refund gives back the tokens taken for a rejected request.
+func (l *Limiter) refund(ctx context.Context, taken []takenToken) {
+	for _, t := range taken {
+		if err := l.store.Refund(ctx, t.key, t.limit); err != nil {
+			l.log.WithContext(ctx).Errorf("rate limit store error refunding %s: %v", t.key, err)
+		}
+	}
+}
+
+// setHeaders writes the RateLimit-* response headers.
+func setHeaders(tr transport.Transporter, res Result) {
+	tr.ReplyHeader().Set(LimitHeader, strconv.Itoa(res.Limit))
+	tr.ReplyHeader().Set(RemainingHeader, strconv.Itoa(res.Remaining))
+	tr.ReplyHeader().Set(ResetHeader, ceilSeconds(res.ResetAfter))
+}
+
+// keyValue resolves the caller attribute for the given key type.
+func keyValue(ctx context.Context, tr transport.Transporter, req interface{}, key KeyType) (string, bool) {
+	switch key {
+	case KeyAPIKey:
+		v := tr.RequestHeader().Get(APIKeyHeader)
+		return v, v != ""
+	case KeyUser:
+		v := tr.RequestHeader().Get(UserIDHeader)
+		return v, v != ""
+	case KeyProject:
+		if p, ok := req.(projectScoped); ok && p.GetProjectId() > 0 {
+			return strconv.FormatUint(p.GetProjectId(), 10), true
+		}
+		return "", false
+	case KeyIP:
+		// Prefer the address resolved by CallerMiddleware, which knows the trusted proxies
+		if c, ok := platform_middleware.CallerFromContext(ctx); ok {
+			return c.ClientIP, true
+		}
+		return platform_middleware.ClientIP(ctx, tr, nil), true
+	}
+	return "", false
+}
+
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,192 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"platform/build"
+	"platform/metrics"
+	"testing"
+	"time"
+
+	kratoserrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// headerCarrier implements transport.Header on top of http.Header.
+type headerCarrier http.Header
+
+func (h headerCarrier) Get(key string) string      { return http.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string)      { http.Header(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string)      { http.Header(h).Add(key, value) }
+func (h headerCarrier) Keys() []string             { return nil }
+func (h headerCarrier) Values(key string) []string { return http.Header(h).Values(key) }
+
+// mockTransporter implements transport.Transporter for testing.
+type mockTransporter struct {
+	operation string
+	reqHeader headerCarrier
+	repHeader headerCarrier
+}
+
+func newMockTransporter(operation string) *mockTransporter {
+	return &mockTransporter{
+		operation: operation,
+		reqHeader: headerCarrier{},
+		repHeader: headerCarrier{},
+	}
+}
+
+func (m *mockTransporter) Kind() transport.Kind            { return transport.KindGRPC }
+func (m *mockTransporter) Endpoint() string                { return "localhost:9000" }
+func (m *mockTransporter) Operation() string               { return m.operation }
+func (m *mockTransporter) RequestHeader() transport.Header { return m.reqHeader }
+func (m *mockTransporter) ReplyHeader() transport.Header   { return m.repHeader }
+
+// projectRequest is a request message carrying a project id.
+type projectRequest struct {
+	projectID uint64
+}
+
+func (r *projectRequest) GetProjectId() uint64 { return r.projectID }
+
+// failingStore always returns an error.
+type failingStore struct{}
+
+func (failingStore) Allow(context.Context, string, Limit) (Result, error) {
+	return Result{}, errors.New("store unavailable")
+}
+
+func (failingStore) Refund(context.Context, string, Limit) error {
+	return errors.New("store unavailable")
+}
+
+const createOp = "/service.symbols.v1.SymbolsService/CreateSymbol"
+
+func okHandler(ctx context.Context, req interface{}) (interface{}, error) {
+	return "ok", nil
+}
+
+func call(l *Limiter, tr *mockTransporter, req interface{}) (interface{}, error) {
+	ctx := transport.NewServerContext(context.Background(), tr)
+	return l.Middleware()(okHandler)(ctx, req)
+}
+
+func TestLimiter_Middleware_ThrottlesPerAPIKey(t *testing.T) {
+	rules := []Rule{{Operation: createOp, Key: KeyAPIKey, Limit: Limit{Rate: 1, Burst: 2}}}
+	l := NewLimiter(NewMemoryStore(time.Minute), rules, nil, log.DefaultLogger)
+
+	tr := newMockTransporter(createOp)
+	tr.reqHeader.Set(APIKeyHeader, "client-a")
+
+	for i := 0; i < 2; i++ {
+		resp, err := call(l, tr, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	}
+	assert.Equal(t, "2", tr.repHeader.Get(LimitHeader))
+	assert.Equal(t, "0", tr.repHeader.Get(RemainingHeader))
+
+	_, err := call(l, tr, nil)
+	require.Error(t, err)
+	assert.Equal(t, 429, int(kratoserrors.FromError(err).Code))
+	assert.Equal(t, "1", tr.repHeader.Get(RetryAfterHeader))
+
+	// Another client is not affected
+	other := newMockTransporter(createOp)
+	other.reqHeader.Set(APIKeyHeader, "client-b")
+	_, err = call(l, other, nil)
+	assert.NoError(t, err)
+}
+
+func TestLimiter_Middleware_ThrottlesPerProject(t *testing.T) {
+	rules := []Rule{{Operation: AnyOperation, Key: KeyProject, Limit: Limit{Rate: 1, Burst: 1}}}
+	l := NewLimiter(NewMemoryStore(time.Minute), rules, nil, log.DefaultLogger)
+
+	_, err := call(l, newMockTransporter(createOp), &projectRequest{projectID: 7})
+	require.NoError(t, err)
+
+	_, err = call(l, newMockTransporter(createOp), &projectRequest{projectID: 7})
+	assert.Error(t, err)
+
+	_, err = call(l, newMockTransporter(createOp), &projectRequest{projectID: 8})
+	assert.NoError(t, err)
+}
+
+func TestLimiter_Middleware_RefundsTokensOfRejectedRequests(t *testing.T) {
+	rules := []Rule{
+		{Operation: AnyOperation, Key: KeyIP, Limit: Limit{Rate: 1, Burst: 2}},
+		{Operation: createOp, Key: KeyProject, Limit: Limit{Rate: 1, Burst: 1}},
+	}
+	store := NewMemoryStore(time.Minute)
+	l := NewLimiter(store, rules, nil, log.DefaultLogger)
+
+	_, err := call(l, newMockTransporter(createOp), &projectRequest{projectID: 7})
+	require.NoError(t, err)
+
+	// The project rule rejects these; the IP bucket must not pay for them
+	for i := 0; i < 3; i++ {
+		_, err = call(l, newMockTransporter(createOp), &projectRequest{projectID: 7})
+		require.Error(t, err)
+	}
+
+	tr := newMockTransporter(createOp)
+	_, err = call(l, tr, &projectRequest{projectID: 8})
+	require.NoError(t, err, "the IP bucket still holds the token of the rejected requests")
+	assert.Equal(t, "0", tr.repHeader.Get(RemainingHeader))
+}
+
+func TestLimiter_Middleware_SkipsRulesThatDoNotApply(t *testing.T) {
+	rules := []Rule{
+		{Operation: "/other/Operation", Key: KeyIP, Limit: Limit{Rate: 1, Burst: 1}},
+		{Operation: createOp, Key: KeyUser, Limit: Limit{Rate: 1, Burst: 1}},
+		{Operation: createOp, Key: KeyProject, Limit: Limit{Rate: 1, Burst: 1}},
+	}
+	l := NewLimiter(NewMemoryStore(time.Minute), rules, nil, log.DefaultLogger)
+
+	// No user header and no project id in the request
+	for i := 0; i < 3; i++ {
+		tr := newMockTransporter(createOp)
+		_, err := call(l, tr, "request")
+		require.NoError(t, err)
+		assert.Empty(t, tr.repHeader.Get(LimitHeader))
+	}
+}
+
+func TestLimiter_Middleware_FailsOpenOnStoreError(t *testing.T) {
+	rules := []Rule{{Operation: AnyOperation, Key: KeyIP, Limit: Limit{Rate: 1, Burst: 1}}}
+	l := NewLimiter(failingStore{}, rules, nil, log.DefaultLogger)
+
+	resp, err := call(l, newMockTransporter(createOp), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+}
+
+func TestLimiter_Middleware_RecordsThrottledMetric(t *testing.T) {
+	reg := metrics.NewRegistry(build.NewBuildInfo("test_service", "1.0.0"))
+	rules := []Rule{{Operation: AnyOperation, Key: KeyIP, Limit: Limit{Rate: 1, Burst: 1}}}
+	l := NewLimiter(NewMemoryStore(time.Minute), rules, reg, log.DefaultLogger)
+
+	tr := newMockTransporter(createOp)
+	tr.reqHeader.Set(ForwardedForHeader, "10.0.0.1, 10.0.0.2")
+
+	_, _ = call(l, tr, nil)
+	_, err := call(l, tr, nil)
+	require.Error(t, err)
+
+	families, err := reg.Unwrap().Gather()
+	require.NoError(t, err)
+
+	var found bool
+	for _, mf := range families {
+		if mf.GetName() == "test_service_ratelimit_throttled_total" {
+			found = true
+			require.Len(t, mf.GetMetric(), 1)
+			assert.Equal(t, float64(1), mf.GetMetric()[0].GetCounter().GetValue())
+		}
+	}
+	assert.True(t, found, "Should have throttled requests counter")
+}
@@ -0,0 +1,133 @@
+// Package ratelimit provides per-client token-bucket rate limiting for Kratos servers.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limit describes a token bucket: Rate tokens are added per second up to Burst tokens.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Result is the outcome of a single Allow call against a bucket.
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	ResetAfter time.Duration // time until the bucket is full again
+	RetryAfter time.Duration // time until the next token is available (zero when allowed)
+}
+
+// Store keeps token buckets keyed by an opaque string.
+// Implementations must be safe for concurrent use. The in-memory store is suitable
+// for a single replica; a shared store (such as the SQL store of a service) enforces
+// the limits across replicas.
+type Store interface {
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+	// Refund gives back a token taken by Allow, for a request that was not served.
+	Refund(ctx context.Context, key string, limit Limit) error
+}
+
+// bucket is the state of a single token bucket.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// MemoryStore is an in-process Store implementation.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	now       func() time.Time
+	idleTTL   time.Duration
+	lastSweep time.Time
+}
+
+// NewMemoryStore creates a new in-memory token bucket store.
+// Buckets that have been idle for longer than idleTTL are evicted lazily.
+func NewMemoryStore(idleTTL time.Duration) *MemoryStore {
+	if idleTTL <= 0 {
+		idleTTL = 10 * time.Minute
+	}
+	return &MemoryStore{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+		idleTTL: idleTTL,
+	}
+}
+
+// Allow takes a token from the bucket identified by key.
+func (s *MemoryStore) Allow(_ context.Context, key string, limit Limit) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), last: now}
+		s.buckets[key] = b
+	}
+
+	var res Result
+	b.tokens, res = Take(b.tokens, now.Sub(b.last), limit)
+	b.last = now
+
+	return res, nil
+}
+
+// Refund gives back a token to the bucket identified by key, up to its burst.
+func (s *MemoryStore) Refund(_ context.Context, key string, limit Limit) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if b, ok := s.buckets[key]; ok {
+		b.tokens = math.Min(float64(limit.Burst), b.tokens+1)
+	}
+
+	return nil
+}
+
+// Take refills a bucket holding tokens for the time elapsed since its last use and takes a token
+// from it. It returns the tokens left in the bucket and the outcome. Stores keep the bucket state
+// and call Take to apply the limit.
+func Take(tokens float64, elapsed time.Duration, limit Limit) (float64, Result) {
+	tokens = math.Min(float64(limit.Burst), tokens+elapsed.Seconds()*limit.Rate)
+
+	res := Result{Limit: limit.Burst}
+	if tokens >= 1 {
+		tokens--
+		res.Allowed = true
+	} else {
+		res.RetryAfter = secondsToDuration((1 - tokens) / limit.Rate)
+	}
+
+	res.Remaining = int(math.Floor(tokens))
+	res.ResetAfter = secondsToDuration((float64(limit.Burst) - tokens) / limit.Rate)
+
+	return tokens, res
+}
+
+// sweep removes idle buckets. Must be called with the lock held.
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < s.idleTTL {
+		return
+	}
+	s.lastSweep = now
+
+	for key, b := range s.buckets {
+		if now.Sub(b.last) > s.idleTTL {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,119 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a controllable time source for the memory store.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestStore(clock *fakeClock) *MemoryStore {
+	s := NewMemoryStore(time.Minute)
+	s.now = clock.Now
+	return s
+}
+
+func TestMemoryStore_Allow_ConsumesBurst(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	store := newTestStore(clock)
+	limit := Limit{Rate: 1, Burst: 3}
+
+	for i := 0; i < 3; i++ {
+		res, err := store.Allow(context.Background(), "k", limit)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, 3, res.Limit)
+		assert.Equal(t, 2-i, res.Remaining)
+	}
+
+	res, err := store.Allow(context.Background(), "k", limit)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 0, res.Remaining)
+	assert.Equal(t, time.Second, res.RetryAfter)
+	assert.Equal(t, 3*time.Second, res.ResetAfter)
+}
+
+func TestMemoryStore_Refund(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	store := newTestStore(clock)
+	limit := Limit{Rate: 1, Burst: 1}
+
+	res, _ := store.Allow(context.Background(), "k", limit)
+	assert.True(t, res.Allowed)
+
+	require.NoError(t, store.Refund(context.Background(), "k", limit))
+	res, _ = store.Allow(context.Background(), "k", limit)
+	assert.True(t, res.Allowed, "the refunded token can be taken again")
+
+	// Refunds never exceed the burst
+	require.NoError(t, store.Refund(context.Background(), "k", limit))
+	require.NoError(t, store.Refund(context.Background(), "k", limit))
+	res, _ = store.Allow(context.Background(), "k", limit)
+	assert.True(t, res.Allowed)
+	res, _ = store.Allow(context.Background(), "k", limit)
+	assert.False(t, res.Allowed)
+}
+
+func TestMemoryStore_Allow_Refills(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	store := newTestStore(clock)
+	limit := Limit{Rate: 2, Burst: 1}
+
+	res, _ := store.Allow(context.Background(), "k", limit)
+	assert.True(t, res.Allowed)
+
+	res, _ = store.Allow(context.Background(), "k", limit)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 500*time.Millisecond, res.RetryAfter)
+
+	clock.Advance(500 * time.Millisecond)
+
+	res, _ = store.Allow(context.Background(), "k", limit)
+	assert.True(t, res.Allowed)
+}
+
+func TestMemoryStore_Allow_KeysAreIndependent(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	store := newTestStore(clock)
+	limit := Limit{Rate: 1, Burst: 1}
+
+	res, _ := store.Allow(context.Background(), "a", limit)
+	assert.True(t, res.Allowed)
+	res, _ = store.Allow(context.Background(), "a", limit)
+	assert.False(t, res.Allowed)
+
+	res, _ = store.Allow(context.Background(), "b", limit)
+	assert.True(t, res.Allowed)
+}
+
+func TestMemoryStore_EvictsIdleBuckets(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	store := newTestStore(clock)
+	limit := Limit{Rate: 1, Burst: 1}
+
+	_, _ = store.Allow(context.Background(), "a", limit)
+	_, _ = store.Allow(context.Background(), "b", limit)
+	assert.Len(t, store.buckets, 2)
+
+	clock.Advance(2 * time.Minute)
+	_, _ = store.Allow(context.Background(), "c", limit)
+
+	assert.Len(t, store.buckets, 1)
+	assert.Contains(t, store.buckets, "c")
+}
@@ -0,0 +1,70 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_Next(t *testing.T) {
+	// A Monday
+	from := time.Date(2024, 1, 15, 10, 7, 30, 0, time.UTC)
+
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{spec: "* * * * *", want: time.Date(2024, 1, 15, 10, 8, 0, 0, time.UTC)},
+		{spec: "*/15 * * * *", want: time.Date(2024, 1, 15, 10, 15, 0, 0, time.UTC)},
+		{spec: "30 3 * * *", want: time.Date(2024, 1, 16, 3, 30, 0, 0, time.UTC)},
+		{spec: "0 9-17/4 * * *", want: time.Date(2024, 1, 15, 13, 0, 0, 0, time.UTC)},
+		{spec: "5,10 10 * * *", want: time.Date(2024, 1, 15, 10, 10, 0, 0, time.UTC)},
+		{spec: "0 0 * * 0", want: time.Date(2024, 1, 21, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 * * 7", want: time.Date(2024, 1, 21, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 29 2 *", want: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// Both day fields restricted: either matches
+		{spec: "0 0 1 * 3", want: time.Date(2024, 1, 17, 0, 0, 0, 0, time.UTC)},
+		{spec: "@hourly", want: time.Date(2024, 1, 15, 11, 0, 0, 0, time.UTC)},
+		{spec: "@daily", want: time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC)},
+		{spec: "@weekly", want: time.Date(2024, 1, 21, 0, 0, 0, 0, time.UTC)},
+		{spec: "@monthly", want: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{spec: "@yearly", want: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{spec: "@every 10m", want: time.Date(2024, 1, 15, 10, 10, 0, 0, time.UTC)},
+		{spec: "0 0 30 2 *", want: time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			schedule, err := Parse(tt.spec)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, schedule.Next(from))
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"@every",
+		"@every 10ms",
+		"@every soon",
+	}
+
+	for _, spec := range tests {
+		t.Run(spec, func(t *testing.T) {
+			_, err := Parse(spec)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,323 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"platform/metrics"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultLockTTL is how long a claimed run locks its job when no TTL is configured.
+// Running jobs renew their lock, so the TTL only bounds how long the lock of a crashed replica lasts.
+const DefaultLockTTL = time.Minute
+
+// Job outcomes recorded by the scheduler_job_runs_total metric.
+const (
+	OutcomeSuccess = "success"
+	OutcomeError   = "error"
+	// OutcomeSkipped is a run another replica claimed, or a run missed while the job was still running.
+	OutcomeSkipped = "skipped"
+)
+
+// Job is a periodic task.
+type Job struct {
+	// Name identifies the job in locks, logs and metrics.
+	Name string
+	// Schedule sets when the job runs.
+	Schedule Schedule
+	// Timeout cancels a run lasting longer. Zero runs without timeout.
+	Timeout time.Duration
+	// Run does the work of a run.
+	Run func(ctx context.Context) error
+}
+
+// Locker claims the runs of jobs so that every run happens on a single replica.
+// Implementations must be safe for concurrent use.
+type Locker interface {
+	// Claim claims the run of job scheduled at scheduledAt for holder and locks the job for ttl.
+	// It returns false when the run was claimed already or the job is locked by a run still going on.
+	Claim(ctx context.Context, job, holder string, scheduledAt time.Time, ttl time.Duration) (bool, error)
+
+	// Renew extends the lock holder has on job by ttl.
+	Renew(ctx context.Context, job, holder string, ttl time.Duration) error
+
+	// Release unlocks job once the run of holder is over.
+	Release(ctx context.Context, job, holder string) error
+}
+
+// Options configures a Scheduler.
+type Options struct {
+	// Holder identifies the replica in the locks, e.g. its hostname.
+	Holder string
+	// LockTTL is how long a claimed run locks its job between renewals (default: DefaultLockTTL).
+	LockTTL time.Duration
+}
+
+// Scheduler runs jobs on their schedule until it is stopped.
+type Scheduler struct {
+	jobs    []Job
+	locker  Locker
+	holder  string
+	lockTTL time.Duration
+	now     func() time.Time
+	log     *log.Helper
+
+	runsTotal   *prometheus.CounterVec
+	runDuration *prometheus.HistogramVec
+	lastSuccess *prometheus.GaugeVec
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// New creates a scheduler claiming the runs of its jobs through locker.
+// The registry may be nil, in which case no metrics are recorded.
+func New(locker Locker, opts Options, reg *metrics.Registry, logger log.Logger) *Scheduler {
+	if opts.LockTTL <= 0 {
+		opts.LockTTL = DefaultLockTTL
+	}
+
+	s := &Scheduler{
+		locker:  locker,
+		holder:  opts.Holder,
+		lockTTL: opts.LockTTL,
+		now:     time.Now,
+		log:     log.NewHelper(logger),
+		stop:    make(chan struct{}),
+	}
+
+	if reg != nil {
+		s.runsTotal = reg.NewCounterVec(
+			"scheduler_job_runs_total",
+			"Total number of scheduled job runs by outcome",
+			[]string{"job", "outcome"},
+		)
+		s.runDuration = reg.NewHistogramVec(
+			"scheduler_job_duration_seconds",
+			"Scheduled job run duration in seconds",
+			[]float64{.1, .5, 1, 5, 10, 30, 60, 300, 900},
+			[]string{"job", "outcome"},
+		)
+		s.lastSuccess = reg.NewGaugeVec(
+			"scheduler_job_last_success_timestamp_seconds",
+			"Unix time of the last successful run of a job",
+			[]string{"job"},
+		)
+	}
+
+	return s
+}
+
+// Register adds a job. Jobs must be registered before Start.
+func (s *Scheduler) Register(jobs ...Job) error {
+	for _, job := range jobs {
+		if job.Name == "" || job.Schedule == nil || job.Run == nil {
+			return fmt.Errorf("scheduler: job %q needs a name, a schedule and a run function", job.Name)
+		}
+		s.jobs = append(s.jobs, job)
+	}
+	return nil
+}
+
+// Jobs returns the names of the registered jobs.
+func (s *Scheduler) Jobs() []string {
+	names := make([]string, len(s.jobs))
+	for i, job := range s.jobs {
+		names[i] = job.Name
+	}
+	return names
+}
+
+// Start runs every job on its schedule in the background, until Stop is called or ctx is done.
+func (s *Scheduler) Start(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		defer cancel()
+		select {
+		case <-ctx.Done():
+		case <-s.stop:
+		}
+	}()
+
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.loop(ctx, job)
+		}()
+	}
+}
+
+// Stop cancels the running jobs and waits for them to return.
+func (s *Scheduler) Stop() {
+	s.stopOnce.Do(func() { close(s.stop) })
+	s.wg.Wait()
+}
+
+// loop runs job at each activation time of its schedule. Runs are sequential, so an activation
+// missed while the previous run was going on is skipped.
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	for {
+		next := job.Schedule.Next(s.now())
+		if next.IsZero() {
+			s.log.WithContext(ctx).Warnf("Job %s has no next run, it is no longer scheduled", job.Name)
+			return
+		}
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s.run(ctx, job, next)
+	}
+}
+
+// run claims the run of job scheduled at scheduledAt and runs it, renewing the lock until it returns.
+func (s *Scheduler) run(ctx context.Context, job Job, scheduledAt time.Time) {
+	claimed, err := s.locker.Claim(ctx, job.Name, s.holder, scheduledAt, s.lockTTL)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Failed to claim the run of job %s: %v", job.Name, err)
+		s.observe(job.Name, OutcomeError, 0)
+		return
+	}
+	if !claimed {
+		s.log.WithContext(ctx).Debugf("Run of job %s at %s is claimed by another replica", job.Name, scheduledAt)
+		s.observe(job.Name, OutcomeSkipped, 0)
+		return
+	}
+
+	var runCtx context.Context
+	var cancel context.CancelFunc
+	if job.Timeout > 0 {
+		runCtx, cancel = context.WithTimeout(ctx, job.Timeout)
+	} else {
+		runCtx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	renewed := make(chan struct{})
+	go func() {
+		defer close(renewed)
+		s.renew(runCtx, job.Name)
+	}()
+
+	start := s.now()
+	s.log.WithContext(ctx).Infof("Running job %s", job.Name)
+	err = job.Run(runCtx)
+	duration := s.now().Sub(start)
+	cancel()
+	<-renewed
+
+	// The lock is released even when ctx is canceled, so the next run is not delayed by its TTL
+	if releaseErr := s.locker.Release(context.WithoutCancel(ctx), job.Name, s.holder); releaseErr != nil {
+		s.log.WithContext(ctx).Errorf("Failed to release job %s: %v", job.Name, releaseErr)
+	}
+
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("Job %s failed after %s: %v", job.Name, duration, err)
+		s.observe(job.Name, OutcomeError, duration)
+		return
+	}
+
+	s.log.WithContext(ctx).Infof("Job %s succeeded in %s", job.Name, duration)
+	s.observe(job.Name, OutcomeSuccess, duration)
+}
+
+// renew extends the lock on job every third of its TTL until ctx is done.
+func (s *Scheduler) renew(ctx context.Context, job string) {
+	ticker := time.NewTicker(s.lockTTL / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.locker.Renew(ctx, job, s.holder, s.lockTTL); err != nil {
+				s.log.WithContext(ctx).Errorf("Failed to renew the lock of job %s: %v", job, err)
+			}
+		}
+	}
+}
+
+func (s *Scheduler) observe(job, outcome string, duration time.Duration) {
+	if s.runsTotal == nil {
+		return
+	}
+
+	s.runsTotal.WithLabelValues(job, outcome).Inc()
+	if outcome == OutcomeSkipped {
+		return
+	}
+	s.runDuration.WithLabelValues(job, outcome).Observe(duration.Seconds())
+	if outcome == OutcomeSuccess {
+		s.lastSuccess.WithLabelValues(job).SetToCurrentTime()
+	}
+}
+
+// MemoryLocker is an in-process Locker, suitable for a single replica.
+type MemoryLocker struct {
+	mu    sync.Mutex
+	locks map[string]*memoryLock
+	now   func() time.Time
+}
+
+type memoryLock struct {
+	holder      string
+	scheduledAt time.Time
+	lockedUntil time.Time
+}
+
+// NewMemoryLocker creates a new in-memory locker.
+func NewMemoryLocker() *MemoryLocker {
+	return &MemoryLocker{
+		locks: make(map[string]*memoryLock),
+		now:   time.Now,
+	}
+}
+
+// Claim claims the run unless it was claimed already or job is locked.
+func (l *MemoryLocker) Claim(_ context.Context, job, holder string, scheduledAt time.Time, ttl time.Duration) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if lock, ok := l.locks[job]; ok && (!lock.scheduledAt.Before(scheduledAt) || now.Before(lock.lockedUntil)) {
+		return false, nil
+	}
+
+	l.locks[job] = &memoryLock{holder: holder, scheduledAt: scheduledAt, lockedUntil: now.Add(ttl)}
+	return true, nil
+}
+
+// Renew extends the lock of holder on job.
+func (l *MemoryLocker) Renew(_ context.Context, job, holder string, ttl time.Duration) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if lock, ok := l.locks[job]; ok && lock.holder == holder {
+		lock.lockedUntil = l.now().Add(ttl)
+	}
+	return nil
+}
+
+// Release unlocks job if holder locks it. The claimed run is remembered.
+func (l *MemoryLocker) Release(_ context.Context, job, holder string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if lock, ok := l.locks[job]; ok && lock.holder == holder {
+		lock.lockedUntil = time.Time{}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"os"
+	"platform/build"
+	"platform/metrics"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runsTotal returns the scheduler_job_runs_total counters of reg by outcome.
+func runsTotal(t *testing.T, reg *metrics.Registry) map[string]float64 {
+	families, err := reg.Unwrap().Gather()
+	require.NoError(t, err)
+
+	runs := make(map[string]float64)
+	for _, f := range families {
+		if f.GetName() != "test_service_scheduler_job_runs_total" {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "outcome" {
+					runs[l.GetValue()] += m.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return runs
+}
+
+func TestMemoryLocker(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	locker := NewMemoryLocker()
+	locker.now = func() time.Time { return now }
+	ctx := context.Background()
+	run1 := now
+	run2 := now.Add(time.Minute)
+
+	claimed, err := locker.Claim(ctx, "job", "a", run1, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	claimed, err = locker.Claim(ctx, "job", "b", run1, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, claimed, "a run is claimed once")
+
+	claimed, err = locker.Claim(ctx, "job", "b", run2, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, claimed, "the job is locked while its previous run goes on")
+
+	claimed, err = locker.Claim(ctx, "other", "b", run1, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed, "jobs are locked separately")
+
+	require.NoError(t, locker.Release(ctx, "job", "a"))
+	claimed, err = locker.Claim(ctx, "job", "b", run1, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, claimed, "a released run is not claimed again")
+
+	claimed, err = locker.Claim(ctx, "job", "b", run2, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	// The lock of a crashed holder expires
+	now = now.Add(2 * time.Minute)
+	claimed, err = locker.Claim(ctx, "job", "a", now, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+}
+
+func TestScheduler_RunsEachActivationOnOneReplica(t *testing.T) {
+	locker := NewMemoryLocker()
+	var runs atomic.Int32
+
+	var replicas []*Scheduler
+	var registries []*metrics.Registry
+	for _, holder := range []string{"a", "b"} {
+		reg := metrics.NewRegistry(build.NewBuildInfo("test_service", "1.0.0"))
+		s := New(locker, Options{Holder: holder}, reg, log.NewStdLogger(os.Stdout))
+		require.NoError(t, s.Register(Job{
+			Name:     "purge",
+			Schedule: every(20 * time.Millisecond),
+			Run: func(context.Context) error {
+				runs.Add(1)
+				return nil
+			},
+		}))
+		s.Start(context.Background())
+		replicas = append(replicas, s)
+		registries = append(registries, reg)
+	}
+
+	require.Eventually(t, func() bool { return runs.Load() >= 3 }, 5*time.Second, 5*time.Millisecond)
+	for _, s := range replicas {
+		s.Stop()
+	}
+
+	var success, skipped float64
+	for _, reg := range registries {
+		r := runsTotal(t, reg)
+		success += r[OutcomeSuccess]
+		skipped += r[OutcomeSkipped]
+	}
+	assert.Equal(t, float64(runs.Load()), success)
+	assert.InDelta(t, success, skipped, 1, "the other replica skips every run")
+}
+
+func TestScheduler_RecordsFailures(t *testing.T) {
+	reg := metrics.NewRegistry(build.NewBuildInfo("test_service", "1.0.0"))
+	s := New(NewMemoryLocker(), Options{Holder: "a"}, reg, log.NewStdLogger(os.Stdout))
+
+	var deadline atomic.Bool
+	require.NoError(t, s.Register(Job{
+		Name:     "refresh",
+		Schedule: every(10 * time.Millisecond),
+		Timeout:  5 * time.Millisecond,
+		Run: func(ctx context.Context) error {
+			<-ctx.Done()
+			_, ok := ctx.Deadline()
+			deadline.Store(ok)
+			return errors.New("timed out")
+		},
+	}))
+	s.Start(context.Background())
+	defer s.Stop()
+
+	require.Eventually(t, func() bool { return runsTotal(t, reg)[OutcomeError] >= 1 }, 5*time.Second, 5*time.Millisecond)
+	assert.True(t, deadline.Load(), "runs are canceled after the job timeout")
+}
+
+func TestScheduler_Register(t *testing.T) {
+	s := New(NewMemoryLocker(), Options{}, nil, log.NewStdLogger(os.Stdout))
+
+	assert.Error(t, s.Register(Job{Name: "no schedule", Run: func(context.Context) error { return nil }}))
+	assert.Error(t, s.Register(Job{Name: "no run", Schedule: every(time.Second)}))
+	require.NoError(t, s.Register(Job{Name: "ok", Schedule: every(time.Second), Run: func(context.Context) error { return nil }}))
+	assert.Equal(t, []string{"ok"}, s.Jobs())
+}
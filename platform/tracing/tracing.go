@@ -0,0 +1,139 @@
+// Package tracing provides OpenTelemetry tracing: the tracer provider and its exporters, and the
+// propagation of the trace context through Watermill messages.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"platform/build"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// Exporters of the spans.
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+// OTLP transports.
+const (
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http"
+)
+
+// shutdownTimeout bounds how long the cleanup flushes the spans still buffered.
+const shutdownTimeout = 5 * time.Second
+
+// Config holds tracing configuration.
+type Config struct {
+	Exporter    string            // none (default), stdout or otlp
+	Endpoint    string            // OTLP collector host:port (default: the OTEL_EXPORTER_OTLP_* environment variables)
+	Protocol    string            // OTLP transport: grpc (default) or http
+	Insecure    bool              // Connect to the collector without TLS
+	Headers     map[string]string // Headers sent with every OTLP export
+	SampleRatio float64           // Fraction of the new traces sampled; traces started upstream follow their parent
+}
+
+// DefaultConfig returns default tracing configuration: spans are not exported.
+func DefaultConfig() *Config {
+	return &Config{
+		Exporter:    ExporterNone,
+		Protocol:    ProtocolGRPC,
+		SampleRatio: 1,
+	}
+}
+
+// NewTracerProvider creates the tracer provider exporting the spans of the service, and registers it
+// and the W3C trace context propagator globally. With ExporterNone it returns a no-op provider:
+// trace context received from callers is still propagated, so logs and messages keep their trace ID.
+// The cleanup flushes the buffered spans.
+func NewTracerProvider(ctx context.Context, cfg *Config, info *build.ServiceBuildInfo, instanceID string) (trace.TracerProvider, func(), error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if cfg == nil || cfg.Exporter == "" || cfg.Exporter == ExporterNone {
+		tp := noop.NewTracerProvider()
+		otel.SetTracerProvider(tp)
+		return tp, func() {}, nil
+	}
+
+	exporter, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(info.ServiceName),
+		semconv.ServiceVersion(info.Version),
+		semconv.ServiceInstanceID(instanceID),
+	))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build the tracing resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(tp)
+
+	cleanup := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		_ = tp.Shutdown(ctx)
+	}
+
+	return tp, cleanup, nil
+}
+
+// newExporter creates the span exporter selected by cfg.Exporter.
+func newExporter(ctx context.Context, cfg *Config) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case ExporterOTLP:
+		switch cfg.Protocol {
+		case "", ProtocolGRPC:
+			var opts []otlptracegrpc.Option
+			if cfg.Endpoint != "" {
+				opts = append(opts, otlptracegrpc.WithEndpoint(cfg.Endpoint))
+			}
+			if cfg.Insecure {
+				opts = append(opts, otlptracegrpc.WithInsecure())
+			}
+			if len(cfg.Headers) > 0 {
+				opts = append(opts, otlptracegrpc.WithHeaders(cfg.Headers))
+			}
+			return otlptracegrpc.New(ctx, opts...)
+		case ProtocolHTTP:
+			var opts []otlptracehttp.Option
+			if cfg.Endpoint != "" {
+				opts = append(opts, otlptracehttp.WithEndpoint(cfg.Endpoint))
+			}
+			if cfg.Insecure {
+				opts = append(opts, otlptracehttp.WithInsecure())
+			}
+			if len(cfg.Headers) > 0 {
+				opts = append(opts, otlptracehttp.WithHeaders(cfg.Headers))
+			}
+			return otlptracehttp.New(ctx, opts...)
+		default:
+			return nil, fmt.Errorf("unknown OTLP protocol %q", cfg.Protocol)
+		}
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", cfg.Exporter)
+	}
+}
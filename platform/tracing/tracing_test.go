@@ -0,0 +1,92 @@
+package tracing
+
+import (
+	"context"
+	"platform/build"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// restoreGlobals resets the global tracer provider and propagator after a test.
+func restoreGlobals(t *testing.T) {
+	tp, prop := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(tp)
+		otel.SetTextMapPropagator(prop)
+	})
+}
+
+func TestConfig_DefaultConfig(t *testing.T) {
+	cfg := DefaultConfig()
+
+	assert.Equal(t, ExporterNone, cfg.Exporter, "Spans should not be exported by default")
+	assert.Equal(t, ProtocolGRPC, cfg.Protocol)
+	assert.Equal(t, 1.0, cfg.SampleRatio)
+}
+
+func TestNewTracerProvider(t *testing.T) {
+	info := build.NewBuildInfo("test_service", "1.0.0")
+
+	tests := []struct {
+		name    string
+		cfg     *Config
+		wantSDK bool
+		wantErr string
+	}{
+		{name: "nil config", cfg: nil},
+		{name: "none", cfg: DefaultConfig()},
+		{name: "empty exporter", cfg: &Config{}},
+		{name: "stdout", cfg: &Config{Exporter: ExporterStdout, SampleRatio: 1}, wantSDK: true},
+		{name: "otlp grpc", cfg: &Config{Exporter: ExporterOTLP, Endpoint: "localhost:4317", Insecure: true, SampleRatio: 1}, wantSDK: true},
+		{name: "otlp http", cfg: &Config{Exporter: ExporterOTLP, Protocol: ProtocolHTTP, Endpoint: "localhost:4318", Insecure: true, Headers: map[string]string{"authorization": "token"}}, wantSDK: true},
+		{name: "unknown exporter", cfg: &Config{Exporter: "jaeger"}, wantErr: `unknown tracing exporter "jaeger"`},
+		{name: "unknown protocol", cfg: &Config{Exporter: ExporterOTLP, Protocol: "udp"}, wantErr: `unknown OTLP protocol "udp"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restoreGlobals(t)
+
+			tp, cleanup, err := NewTracerProvider(context.Background(), tt.cfg, info, "instance-1")
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			defer cleanup()
+
+			if tt.wantSDK {
+				assert.IsType(t, &sdktrace.TracerProvider{}, tp)
+			} else {
+				assert.IsType(t, noop.TracerProvider{}, tp)
+			}
+			assert.Equal(t, tp, otel.GetTracerProvider(), "The provider should be registered globally")
+			assert.ElementsMatch(t, []string{"traceparent", "tracestate", "baggage"}, otel.GetTextMapPropagator().Fields())
+		})
+	}
+}
+
+func TestNewTracerProvider_NonePropagatesContext(t *testing.T) {
+	restoreGlobals(t)
+
+	tp, cleanup, err := NewTracerProvider(context.Background(), DefaultConfig(), build.NewBuildInfo("test_service", "1.0.0"), "instance-1")
+	require.NoError(t, err)
+	defer cleanup()
+
+	parent := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), parent)
+
+	_, span := tp.Tracer("test").Start(ctx, "operation")
+	assert.Equal(t, parent.TraceID(), span.SpanContext().TraceID(), "The trace ID of the caller should be kept")
+	assert.False(t, span.IsRecording())
+}
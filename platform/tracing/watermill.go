@@ -0,0 +1,106 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName names the tracer of the Watermill spans.
+const instrumentationName = "platform/tracing"
+
+// MetadataCarrier adapts Watermill message metadata to a propagation.TextMapCarrier.
+// With the W3C propagator the context travels in the traceparent and tracestate metadata,
+// the names of the CloudEvents distributed tracing extension.
+type MetadataCarrier message.Metadata
+
+// Get returns the metadata value of key.
+func (c MetadataCarrier) Get(key string) string {
+	return message.Metadata(c).Get(key)
+}
+
+// Set stores a metadata value.
+func (c MetadataCarrier) Set(key, value string) {
+	message.Metadata(c).Set(key, value)
+}
+
+// Keys lists the metadata keys.
+func (c MetadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Inject writes the trace context of ctx into the message metadata.
+func Inject(ctx context.Context, msg *message.Message) {
+	if msg.Metadata == nil {
+		msg.Metadata = make(message.Metadata)
+	}
+	otel.GetTextMapPropagator().Inject(ctx, MetadataCarrier(msg.Metadata))
+}
+
+// Extract returns the message context carrying the trace context of the message metadata.
+func Extract(msg *message.Message) context.Context {
+	return otel.GetTextMapPropagator().Extract(msg.Context(), MetadataCarrier(msg.Metadata))
+}
+
+// StartPublishSpan starts the producer span of a message published to topic with the global tracer
+// provider, and injects its context into the message metadata. The caller ends the span.
+func StartPublishSpan(ctx context.Context, topic string, msg *message.Message) (context.Context, trace.Span) {
+	ctx, span := otel.Tracer(instrumentationName).Start(ctx, "publish "+topic,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingOperationTypeSend,
+			semconv.MessagingDestinationName(topic),
+			semconv.MessagingMessageID(msg.UUID),
+		),
+	)
+	Inject(ctx, msg)
+
+	return ctx, span
+}
+
+// EndSpan records err on span, if any, and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Middleware is a Watermill router middleware running each handler in a consumer span, the child of
+// the trace context of the message metadata. With a nil provider the global one is used.
+func Middleware(tp trace.TracerProvider) message.HandlerMiddleware {
+	return func(h message.HandlerFunc) message.HandlerFunc {
+		return func(msg *message.Message) ([]*message.Message, error) {
+			provider := tp
+			if provider == nil {
+				provider = otel.GetTracerProvider()
+			}
+
+			handler := message.HandlerNameFromCtx(msg.Context())
+			ctx, span := provider.Tracer(instrumentationName).Start(Extract(msg), "process "+handler,
+				trace.WithSpanKind(trace.SpanKindConsumer),
+				trace.WithAttributes(
+					semconv.MessagingOperationTypeProcess,
+					semconv.MessagingDestinationName(message.SubscribeTopicFromCtx(msg.Context())),
+					semconv.MessagingConsumerGroupName(handler),
+					semconv.MessagingMessageID(msg.UUID),
+				),
+			)
+			msg.SetContext(ctx)
+
+			produced, err := h(msg)
+			EndSpan(span, err)
+
+			return produced, err
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// newRecorder registers a recording tracer provider and the W3C propagator globally for a test.
+func newRecorder(t *testing.T) (*sdktrace.TracerProvider, *tracetest.SpanRecorder) {
+	restoreGlobals(t)
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return tp, recorder
+}
+
+func TestInjectExtract(t *testing.T) {
+	tp, _ := newRecorder(t)
+
+	ctx, span := tp.Tracer("test").Start(context.Background(), "request")
+	defer span.End()
+
+	msg := message.NewMessage("uuid-1", nil)
+	msg.Metadata = nil
+	Inject(ctx, msg)
+	assert.NotEmpty(t, msg.Metadata.Get("traceparent"))
+
+	received := message.NewMessage("uuid-1", nil)
+	received.Metadata = msg.Metadata
+	got := trace.SpanContextFromContext(Extract(received))
+	assert.Equal(t, span.SpanContext().TraceID(), got.TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), got.SpanID())
+	assert.True(t, got.IsRemote())
+}
+
+func TestExtract_WithoutTraceContext(t *testing.T) {
+	newRecorder(t)
+
+	got := trace.SpanContextFromContext(Extract(message.NewMessage("uuid-1", nil)))
+	assert.False(t, got.IsValid())
+}
+
+func TestMetadataCarrier_Keys(t *testing.T) {
+	c := MetadataCarrier{"traceparent": "00-1", "routing_key": "symbol.created"}
+	assert.ElementsMatch(t, []string{"traceparent", "routing_key"}, c.Keys())
+}
+
+func TestStartPublishSpan(t *testing.T) {
+	tp, recorder := newRecorder(t)
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "request")
+	msg := message.NewMessage("uuid-1", nil)
+
+	_, span := StartPublishSpan(ctx, "symbol.created", msg)
+	EndSpan(span, errors.New("broker unavailable"))
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	publish := spans[0]
+	assert.Equal(t, "publish symbol.created", publish.Name())
+	assert.Equal(t, trace.SpanKindProducer, publish.SpanKind())
+	assert.Equal(t, parent.SpanContext().SpanID(), publish.Parent().SpanID())
+	assert.Equal(t, codes.Error, publish.Status().Code)
+	assert.Contains(t, publish.Attributes(), attribute.String("messaging.destination.name", "symbol.created"))
+	assert.Contains(t, publish.Attributes(), attribute.String("messaging.message.id", "uuid-1"))
+
+	got := trace.SpanContextFromContext(Extract(msg))
+	assert.Equal(t, publish.SpanContext().SpanID(), got.SpanID(), "The message should carry the publish span")
+}
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name       string
+		handlerErr error
+		wantStatus codes.Code
+	}{
+		{name: "success", wantStatus: codes.Unset},
+		{name: "failure", handlerErr: errors.New("handler failed"), wantStatus: codes.Error},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tp, recorder := newRecorder(t)
+
+			ctx, publisher := tp.Tracer("test").Start(context.Background(), "publish")
+			msg := message.NewMessage("uuid-1", nil)
+			Inject(ctx, msg)
+			publisher.End()
+			msg.SetContext(context.Background())
+
+			var handlerSpan trace.SpanContext
+			h := Middleware(tp)(func(msg *message.Message) ([]*message.Message, error) {
+				handlerSpan = trace.SpanContextFromContext(msg.Context())
+				return nil, tt.handlerErr
+			})
+
+			_, err := h(msg)
+			assert.Equal(t, tt.handlerErr, err)
+
+			spans := recorder.Ended()
+			require.Len(t, spans, 2)
+			process := spans[1]
+			assert.Equal(t, trace.SpanKindConsumer, process.SpanKind())
+			assert.Equal(t, publisher.SpanContext().TraceID(), process.SpanContext().TraceID())
+			assert.Equal(t, publisher.SpanContext().SpanID(), process.Parent().SpanID())
+			assert.Equal(t, process.SpanContext().SpanID(), handlerSpan.SpanID(), "The handler should run in the consumer span")
+			assert.Equal(t, tt.wantStatus, process.Status().Code)
+		})
+	}
+}
+
+func TestMiddleware_GlobalProvider(t *testing.T) {
+	_, recorder := newRecorder(t)
+
+	h := Middleware(nil)(func(msg *message.Message) ([]*message.Message, error) {
+		return nil, nil
+	})
+	_, err := h(message.NewMessage("uuid-1", nil))
+	require.NoError(t, err)
+
+	assert.Len(t, recorder.Ended(), 1)
+}
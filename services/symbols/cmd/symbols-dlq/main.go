@@ -0,0 +1,186 @@
+// Command symbols-dlq lists, inspects, requeues and discards the messages the symbols worker dead-lettered.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"symbols/internal/conf/gen"
+	"symbols/internal/data/mq"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/env"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/rabbitmq/amqp091-go"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var (
+	// configFile is the config flag.
+	configFile string
+	// limit is the maximum number of dead letters listed.
+	limit int
+	// timeout bounds a whole command.
+	timeout time.Duration
+)
+
+func init() {
+	flag.StringVar(&configFile, "conf", "configs/config.yaml", "config path, eg: --conf config.yaml")
+	flag.IntVar(&limit, "limit", 50, fmt.Sprintf("maximum number of dead letters to list (1-%d)", mq.MaxListLimit))
+	flag.DurationVar(&timeout, "timeout", time.Minute, "command timeout")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] list | inspect <id> | requeue <id> | discard <id>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+}
+
+func main() {
+	flag.Parse()
+
+	if err := run(flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	if len(args) == 0 {
+		flag.Usage()
+		return errors.New("missing command")
+	}
+
+	mqConf, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	conn, err := amqp091.Dial(mqConf.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to AMQP: %w", err)
+	}
+	defer conn.Close()
+
+	q := mq.NewDeadLetterQueue(
+		func() (mq.Channel, error) { return conn.Channel() },
+		mqConf.DeadLetter.Queue,
+		mqConf.Exchange.Name,
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	cmd, args := args[0], args[1:]
+	if cmd == "list" {
+		// Listed dead letters are held unacknowledged, so a listing is always bounded
+		if limit <= 0 || limit > mq.MaxListLimit {
+			return fmt.Errorf("limit must be between 1 and %d, got %d", mq.MaxListLimit, limit)
+		}
+		letters, err := q.List(ctx, limit)
+		if err != nil {
+			return err
+		}
+		printList(letters)
+		return nil
+	}
+
+	if len(args) != 1 {
+		flag.Usage()
+		return fmt.Errorf("%s: expected one message id", cmd)
+	}
+	id := args[0]
+
+	switch cmd {
+	case "inspect":
+		dl, err := q.Inspect(ctx, id)
+		if err != nil {
+			return err
+		}
+		printDeadLetter(dl)
+	case "requeue":
+		dl, err := q.Requeue(ctx, id)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("requeued %s to %s with routing key %s\n", dl.ID, mqConf.Exchange.Name, dl.RoutingKey)
+	case "discard":
+		dl, err := q.Discard(ctx, id)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("discarded %s\n", dl.ID)
+	default:
+		flag.Usage()
+		return fmt.Errorf("unknown command %q", cmd)
+	}
+
+	return nil
+}
+
+// loadConfig reads the AMQP settings of the service config.
+func loadConfig() (*conf.RabbitMQServer, error) {
+	c := config.New(
+		config.WithSource(
+			env.NewSource(),
+			file.NewSource(configFile),
+		),
+		config.WithResolveActualTypes(true),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		return nil, err
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		return nil, err
+	}
+
+	mqConf := bc.GetData().GetMq()
+	if err := mqConf.Validate(); err != nil {
+		return nil, err
+	}
+	if mqConf.GetDeadLetter().GetQueue() == "" {
+		return nil, errors.New("data.mq.dead_letter.queue is not configured")
+	}
+
+	return mqConf, nil
+}
+
+func printList(letters []*mq.DeadLetter) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintln(w, "ID\tROUTING KEY\tATTEMPTS\tREASON")
+	for _, dl := range letters {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", dl.ID, dl.RoutingKey, dl.Attempts, dl.Reason)
+	}
+}
+
+func printDeadLetter(dl *mq.DeadLetter) {
+	fmt.Printf("ID:          %s\n", dl.ID)
+	fmt.Printf("Routing key: %s\n", dl.RoutingKey)
+	fmt.Printf("Handler:     %s\n", dl.Handler)
+	fmt.Printf("Attempts:    %d\n", dl.Attempts)
+	fmt.Printf("Reason:      %s\n", dl.Reason)
+	fmt.Println("Metadata:")
+	for _, k := range slices.Sorted(maps.Keys(dl.Metadata)) {
+		fmt.Printf("  %s: %s\n", k, dl.Metadata[k])
+	}
+
+	msg := message.NewMessage(dl.ID, dl.Payload)
+	msg.Metadata = dl.Metadata
+	evt, err := mq.DecodeEvent(msg)
+	if err != nil {
+		fmt.Printf("Payload (%d bytes, undecodable: %v):\n%x\n", len(dl.Payload), err, dl.Payload)
+		return
+	}
+	fmt.Printf("Payload:\n%s\n", protojson.Format(evt))
+}
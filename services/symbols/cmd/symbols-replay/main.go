@@ -0,0 +1,179 @@
+// Command symbols-replay re-publishes SymbolCreated snapshots for consumers that lost their state.
+// Replayed events carry the replay=true metadata flag.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	platform_logger "platform/logger"
+	"symbols/internal/biz/domain"
+	"symbols/internal/biz/usecase"
+	"symbols/internal/conf/gen"
+	"symbols/internal/data"
+	"symbols/internal/data/mq"
+	"symbols/internal/data/repo"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/env"
+	"github.com/go-kratos/kratos/v2/config/file"
+)
+
+var (
+	// Name is the name of the compiled software.
+	Name = "symbols-replay"
+	// Version is the version of the compiled software.
+	Version = "1.0"
+
+	// configFile is the config flag.
+	configFile string
+	// projectID, minID and maxID select the symbols by project and id range.
+	projectID, minID, maxID uint64
+	// updatedAfter and updatedBefore select the symbols by their updated_at window (RFC 3339).
+	updatedAfter, updatedBefore string
+	// rate is the maximum number of events published per second.
+	rate float64
+	// dryRun lists the matching symbols without publishing.
+	dryRun bool
+	// routingKey overrides the routing key of the replayed events.
+	routingKey string
+	// batchSize is the number of symbols loaded per query.
+	batchSize int
+	// timeout bounds the whole replay.
+	timeout time.Duration
+
+	id, _ = os.Hostname()
+)
+
+func init() {
+	flag.StringVar(&configFile, "conf", "configs/config.yaml", "config path, eg: --conf config.yaml")
+	flag.Uint64Var(&projectID, "project", 0, "replay the symbols of this project")
+	flag.Uint64Var(&minID, "min-id", 0, "replay symbols with an id of at least this value")
+	flag.Uint64Var(&maxID, "max-id", 0, "replay symbols with an id of at most this value")
+	flag.StringVar(&updatedAfter, "updated-after", "", "replay symbols updated at or after this time (RFC 3339)")
+	flag.StringVar(&updatedBefore, "updated-before", "", "replay symbols updated before this time (RFC 3339)")
+	flag.Float64Var(&rate, "rate", 100, "maximum events published per second (0: unlimited)")
+	flag.BoolVar(&dryRun, "dry-run", false, "list the matching symbols without publishing")
+	flag.StringVar(&routingKey, "routing-key", "", "publish with this routing key instead of symbol.created")
+	flag.IntVar(&batchSize, "batch", domain.DefaultReplayBatchSize, "symbols loaded per query")
+	flag.DurationVar(&timeout, "timeout", time.Hour, "replay timeout")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+}
+
+func main() {
+	flag.Parse()
+
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	filter, err := replayFilter()
+	if err != nil {
+		flag.Usage()
+		return err
+	}
+
+	bc, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	logger := platform_logger.NewLogger(bc.Log.GetLevel(), id, Name, Version)
+
+	db := data.NewDB(bc.Data, nil, logger)
+	d, cleanup, err := data.NewData(db, logger)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	// A dry run only reads the database
+	var pub domain.SymbolEventPublisher
+	if !dryRun {
+		brokerPub, closePub := data.NewPublisher(bc.Data, nil, db, nil, logger, platform_logger.NewWatermillLogger(logger))
+		defer closePub()
+		pub = mq.NewEventPublisher(brokerPub, logger)
+	}
+
+	uc := usecase.NewReplayUseCase(repo.NewSymbolRepo(db, data.NewTransaction(d), logger), pub, logger)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	replayed, err := uc.ReplaySymbols(ctx, domain.ReplayOptions{
+		Filter:     filter,
+		RoutingKey: routingKey,
+		DryRun:     dryRun,
+		Rate:       rate,
+		BatchSize:  batchSize,
+		OnSymbol: func(s *domain.Symbol) {
+			if dryRun {
+				fmt.Printf("%d\t%d\t%s\t%s\n", s.ID, s.Project, s.UID, s.Label)
+			}
+		},
+	})
+	if dryRun {
+		fmt.Printf("%d symbols would be replayed\n", replayed)
+	} else {
+		fmt.Printf("replayed %d symbols\n", replayed)
+	}
+
+	return err
+}
+
+// replayFilter builds the symbol filter from the flags.
+func replayFilter() (domain.ReplayFilter, error) {
+	filter := domain.ReplayFilter{ProjectID: projectID, MinID: minID, MaxID: maxID}
+
+	var err error
+	if updatedAfter != "" {
+		if filter.UpdatedAfter, err = time.Parse(time.RFC3339, updatedAfter); err != nil {
+			return filter, fmt.Errorf("invalid -updated-after: %w", err)
+		}
+	}
+	if updatedBefore != "" {
+		if filter.UpdatedBefore, err = time.Parse(time.RFC3339, updatedBefore); err != nil {
+			return filter, fmt.Errorf("invalid -updated-before: %w", err)
+		}
+	}
+	if filter.IsZero() {
+		return filter, errors.New("select the symbols with -project, -min-id/-max-id or -updated-after/-updated-before")
+	}
+
+	return filter, nil
+}
+
+// loadConfig reads and validates the service config.
+func loadConfig() (*conf.Bootstrap, error) {
+	c := config.New(
+		config.WithSource(
+			env.NewSource(),
+			file.NewSource(configFile),
+		),
+		config.WithResolveActualTypes(true),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		return nil, err
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		return nil, err
+	}
+	if err := bc.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &bc, nil
+}
@@ -54,6 +54,7 @@ func main() {
 			env.NewSource(),
 			file.NewSource(configFile),
 		),
+		config.WithResolveActualTypes(true),
 	)
 	defer func(c config.Config) {
 		err := c.Close()
@@ -78,7 +79,7 @@ func main() {
 
 	logger := p.NewLogger(bc.Log.Level, id, Name, Version)
 
-	app, cleanup, err := wireApp(buildInfo, bc.Server, bc.Data, bc.Log, bc.Metrics, logger)
+	app, cleanup, err := wireApp(buildInfo, bc.Server, bc.Data, bc.Log, bc.Metrics, bc.Tracing, logger)
 	if err != nil {
 		panic(err)
 	}
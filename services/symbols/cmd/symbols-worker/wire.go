@@ -23,13 +23,14 @@ import (
 )
 
 // wireApp init kratos application.
-func wireApp(*platform_build_info.ServiceBuildInfo, *conf.Server, *conf.Data, *conf.LogConfig, *conf.Metrics, log.Logger) (*kratos.App, func(), error) {
+func wireApp(*platform_build_info.ServiceBuildInfo, *conf.Server, *conf.Data, *conf.LogConfig, *conf.Metrics, *conf.Tracing, log.Logger) (*kratos.App, func(), error) {
 	panic(wire.Build(
 		platform_logger.ProviderSet,
 		server.ProviderSet,
 		worker.ProviderSet,
 		handlers.ProviderSet,
 		data.ProviderSet,
+		data.ConsumerProviderSet,
 		biz.ProviderSet,
 		newApp,
 	))
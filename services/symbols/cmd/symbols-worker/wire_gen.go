@@ -27,27 +27,85 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(serviceBuildInfo *build.ServiceBuildInfo, confServer *conf.Server, confData *conf.Data, logConfig *conf.LogConfig, metrics *conf.Metrics, logLogger log.Logger) (*kratos.App, func(), error) {
-	db := data.NewDB(confData, logLogger)
-	dataData, cleanup, err := data.NewData(db, logLogger)
+func wireApp(serviceBuildInfo *build.ServiceBuildInfo, confServer *conf.Server, confData *conf.Data, logConfig *conf.LogConfig, metrics *conf.Metrics, tracing *conf.Tracing, logLogger log.Logger) (*kratos.App, func(), error) {
+	tracerProvider, cleanup, err := server.NewTracerProvider(tracing, serviceBuildInfo, logLogger)
 	if err != nil {
 		return nil, nil, err
 	}
+	db := data.NewDB(confData, tracerProvider, logLogger)
+	dataData, cleanup2, err := data.NewData(db, logLogger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
 	transaction := data.NewTransaction(dataData)
 	symbolRepo := repo.NewSymbolRepo(db, transaction, logLogger)
+	auditRepo := repo.NewAuditRepo(db, logLogger)
+	symbolRevisionRepo := repo.NewSymbolRevisionRepo(db, logLogger)
+	symbolLockRepo := repo.NewSymbolLockRepo(db, logLogger)
+	projectStatsRepo := data.NewProjectStatsRepo(db, confData, logLogger)
 	validate := usecase.NewValidator()
 	watermillLogger := logger.NewWatermillLogger(logLogger)
-	publisher := data.NewAMQPPublisher(confData, logLogger, watermillLogger)
+	goChannel, cleanup3 := data.NewGoChannel(confData, logLogger, watermillLogger)
 	registry := server.NewMetricsRegistry(metrics, serviceBuildInfo)
+	publisher, cleanup4 := data.NewPublisher(confData, goChannel, db, registry, logLogger, watermillLogger)
 	symbolEventPublisher := data.NewEventPublisherWithMetrics(publisher, metrics, registry, logLogger)
-	symbolUseCase := usecase.NewUseCase(symbolRepo, validate, transaction, symbolEventPublisher, logLogger)
+	symbolUseCase := usecase.NewUseCase(symbolRepo, auditRepo, symbolRevisionRepo, symbolLockRepo, projectStatsRepo, validate, transaction, symbolEventPublisher, logLogger)
 	lifecycleEventHandler := handlers.NewLifecycleEventHandler(symbolUseCase, logLogger)
-	subscriber := data.NewAMQPSubscriber(confData, logLogger, watermillLogger)
+	fallbackFunc := handlers.NewFallbackHandler(confData, logLogger)
+	subscriber := data.NewSubscriber(confData, goChannel, db, logLogger, watermillLogger)
 	eventsSubscriber := data.NewEventSubscriberWithMetrics(subscriber, metrics, registry, logLogger)
-	router := worker.NewRouter(confData, lifecycleEventHandler, eventsSubscriber, watermillLogger)
-	workerWorker := worker.NewWorker(router, logLogger)
+	deadLetterPublisher, cleanup5, err := data.NewDeadLetterPublisher(confData, goChannel, db, logLogger, watermillLogger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	retryPublisher, cleanup6, err := data.NewRetryPublisher(confData, logLogger, watermillLogger)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	store := data.NewInboxStore(confData, db, transaction, logLogger)
+	router := worker.NewRouter(confData, lifecycleEventHandler, fallbackFunc, eventsSubscriber, deadLetterPublisher, retryPublisher, store, tracerProvider, watermillLogger)
+	locker := repo.NewSchedulerLocker(db, logLogger)
+	maintenanceRepo := repo.NewMaintenanceRepo(db, logLogger)
+	maintenanceUseCase := usecase.NewMaintenanceUseCase(maintenanceRepo, projectStatsRepo, auditRepo, logLogger)
+	idempotencyStore := data.NewIdempotencyStore(confServer, db, logLogger)
+	v, err := worker.NewJobs(confData, maintenanceUseCase, idempotencyStore, logLogger)
+	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	scheduler, err := worker.NewScheduler(confData, locker, v, registry, logLogger)
+	if err != nil {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	workerWorker := worker.NewWorker(router, scheduler, logLogger)
 	app := newApp(workerWorker, logLogger)
 	return app, func() {
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
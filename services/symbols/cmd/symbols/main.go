@@ -1,11 +1,15 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"os"
 	"platform/build"
+	"platform/health"
 	p "platform/logger"
 	"symbols/internal/conf/gen"
+	"symbols/internal/data/mq"
+	"symbols/internal/worker"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -33,9 +37,8 @@ func init() {
 	flag.StringVar(&configFile, "conf", "configs/config.yaml", "config path, eg: --conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
-
-	return kratos.New(
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, hc *health.Health, dc *conf.Data, w worker.Worker) *kratos.App {
+	opts := []kratos.Option{
 		kratos.ID(id),
 		kratos.Name(Name),
 		kratos.Version(Version),
@@ -45,7 +48,19 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 			gs,
 			hs,
 		),
-	)
+		// Fail the readiness first, so load balancers stop routing to the draining servers
+		kratos.BeforeStop(func(context.Context) error {
+			hc.Shutdown()
+			return nil
+		}),
+	}
+
+	// With the in-process broker, nothing outside this process receives the events: run the worker handlers here
+	if dc.GetBroker() == mq.BrokerGoChannel {
+		opts = append(opts, kratos.BeforeStart(w.Start()), kratos.AfterStop(w.Stop()))
+	}
+
+	return kratos.New(opts...)
 }
 
 var buildInfo = build.NewBuildInfo(Name, Version)
@@ -58,6 +73,8 @@ func main() {
 			env.NewSource(),
 			file.NewSource(configFile),
 		),
+		// Placeholders such as ${RATE_LIMIT_ENABLED:true} must resolve to booleans and numbers
+		config.WithResolveActualTypes(true),
 	)
 	defer func(c config.Config) {
 		err := c.Close()
@@ -82,7 +99,7 @@ func main() {
 
 	logger := p.NewLogger(bc.Log.Level, id, Name, Version)
 
-	app, cleanup, err := wireApp(buildInfo, bc.Server, bc.Data, bc.Log, bc.Metrics, logger)
+	app, cleanup, err := wireApp(buildInfo, bc.Server, bc.Data, bc.Log, bc.Metrics, bc.Tracing, logger)
 	if err != nil {
 		panic(err)
 	}
@@ -13,8 +13,10 @@ import (
 	"symbols/internal/biz"
 	"symbols/internal/conf/gen"
 	"symbols/internal/data"
+	"symbols/internal/handlers"
 	"symbols/internal/server"
 	"symbols/internal/service"
+	"symbols/internal/worker"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
@@ -22,6 +24,16 @@ import (
 )
 
 // wireApp init kratos application.
-func wireApp(*platform_build_info.ServiceBuildInfo, *conf.Server, *conf.Data, *conf.LogConfig, *conf.Metrics, log.Logger) (*kratos.App, func(), error) {
-	panic(wire.Build(platform_logger.ProviderSet, server.ProviderSet, data.ProviderSet, biz.ProviderSet, service.ProviderSet, newApp))
+func wireApp(*platform_build_info.ServiceBuildInfo, *conf.Server, *conf.Data, *conf.LogConfig, *conf.Metrics, *conf.Tracing, log.Logger) (*kratos.App, func(), error) {
+	panic(wire.Build(
+		platform_logger.ProviderSet,
+		server.ProviderSet,
+		data.ProviderSet,
+		data.EmbeddedConsumerProviderSet,
+		biz.ProviderSet,
+		service.ProviderSet,
+		handlers.ProviderSet,
+		worker.ProviderSet,
+		newApp,
+	))
 }
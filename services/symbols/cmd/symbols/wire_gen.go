@@ -15,8 +15,10 @@ import (
 	"symbols/internal/conf/gen"
 	"symbols/internal/data"
 	"symbols/internal/data/repo"
+	"symbols/internal/handlers"
 	"symbols/internal/server"
 	"symbols/internal/service"
+	"symbols/internal/worker"
 )
 
 import (
@@ -26,25 +28,84 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(serviceBuildInfo *build.ServiceBuildInfo, confServer *conf.Server, confData *conf.Data, logConfig *conf.LogConfig, metrics *conf.Metrics, logLogger log.Logger) (*kratos.App, func(), error) {
+func wireApp(serviceBuildInfo *build.ServiceBuildInfo, confServer *conf.Server, confData *conf.Data, logConfig *conf.LogConfig, metrics *conf.Metrics, tracing *conf.Tracing, logLogger log.Logger) (*kratos.App, func(), error) {
 	registry := server.NewMetricsRegistry(metrics, serviceBuildInfo)
-	db := data.NewDB(confData, logLogger)
-	dataData, cleanup, err := data.NewData(db, logLogger)
+	trustedProxies, err := server.NewTrustedProxies(confServer)
 	if err != nil {
 		return nil, nil, err
 	}
+	tracerProvider, cleanup, err := server.NewTracerProvider(tracing, serviceBuildInfo, logLogger)
+	if err != nil {
+		return nil, nil, err
+	}
+	db := data.NewDB(confData, tracerProvider, logLogger)
+	store := data.NewRateLimitStore(confServer, db, logLogger)
+	limiter := server.NewRateLimiter(confServer, store, registry, logLogger)
+	idempotencyStore := data.NewIdempotencyStore(confServer, db, logLogger)
+	handler := server.NewIdempotencyHandler(confServer, idempotencyStore, registry, logLogger)
+	watermillLogger := logger.NewWatermillLogger(logLogger)
+	goChannel, cleanup2 := data.NewGoChannel(confData, logLogger, watermillLogger)
+	publisher, cleanup3 := data.NewPublisher(confData, goChannel, db, registry, logLogger, watermillLogger)
+	checks := data.NewHealthChecks(db, publisher, logLogger)
+	health, err := server.NewHealth(confServer, checks, registry, logLogger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	dataData, cleanup4, err := data.NewData(db, logLogger)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	transaction := data.NewTransaction(dataData)
 	symbolRepo := repo.NewSymbolRepo(db, transaction, logLogger)
+	auditRepo := repo.NewAuditRepo(db, logLogger)
+	symbolRevisionRepo := repo.NewSymbolRevisionRepo(db, logLogger)
+	symbolLockRepo := repo.NewSymbolLockRepo(db, logLogger)
+	projectStatsRepo := data.NewProjectStatsRepo(db, confData, logLogger)
 	validate := usecase.NewValidator()
-	watermillLogger := logger.NewWatermillLogger(logLogger)
-	publisher := data.NewAMQPPublisher(confData, logLogger, watermillLogger)
 	symbolEventPublisher := data.NewEventPublisherWithMetrics(publisher, metrics, registry, logLogger)
-	symbolUseCase := usecase.NewUseCase(symbolRepo, validate, transaction, symbolEventPublisher, logLogger)
+	symbolUseCase := usecase.NewUseCase(symbolRepo, auditRepo, symbolRevisionRepo, symbolLockRepo, projectStatsRepo, validate, transaction, symbolEventPublisher, logLogger)
 	symbolService := service.NewSymbolService(symbolUseCase)
-	grpcServer := server.NewGRPCServer(confServer, metrics, registry, symbolService, logLogger)
-	httpServer := server.NewHTTPServer(confServer, metrics, registry, symbolService, logLogger)
-	app := newApp(logLogger, grpcServer, httpServer)
+	grpcServer := server.NewGRPCServer(confServer, metrics, registry, trustedProxies, limiter, handler, health, symbolService, tracerProvider, logLogger)
+	httpServer := server.NewHTTPServer(confServer, metrics, registry, trustedProxies, limiter, handler, health, symbolService, tracerProvider, logLogger)
+	lifecycleEventHandler := handlers.NewLifecycleEventHandler(symbolUseCase, logLogger)
+	fallbackFunc := handlers.NewFallbackHandler(confData, logLogger)
+	subscriber := data.NewEmbeddedSubscriber(goChannel)
+	eventsSubscriber := data.NewEventSubscriberWithMetrics(subscriber, metrics, registry, logLogger)
+	deadLetterPublisher := data.NewEmbeddedDeadLetterPublisher(confData, goChannel)
+	retryPublisher := data.NewEmbeddedRetryPublisher()
+	inboxStore := data.NewInboxStore(confData, db, transaction, logLogger)
+	router := worker.NewRouter(confData, lifecycleEventHandler, fallbackFunc, eventsSubscriber, deadLetterPublisher, retryPublisher, inboxStore, tracerProvider, watermillLogger)
+	locker := repo.NewSchedulerLocker(db, logLogger)
+	maintenanceRepo := repo.NewMaintenanceRepo(db, logLogger)
+	maintenanceUseCase := usecase.NewMaintenanceUseCase(maintenanceRepo, projectStatsRepo, auditRepo, logLogger)
+	v, err := worker.NewJobs(confData, maintenanceUseCase, idempotencyStore, logLogger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	scheduler, err := worker.NewScheduler(confData, locker, v, registry, logLogger)
+	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	workerWorker := worker.NewWorker(router, scheduler, logLogger)
+	app := newApp(logLogger, grpcServer, httpServer, health, confData, workerWorker)
 	return app, func() {
+		cleanup4()
+		cleanup3()
+		cleanup2()
 		cleanup()
 	}, nil
 }
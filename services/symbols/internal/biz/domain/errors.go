@@ -20,6 +20,28 @@ var (
 
 	// ErrDatabaseOperation is returned when a database operation fails.
 	ErrDatabaseOperation = errors.New("database operation failed")
+
+	// ErrSymbolHasDependents is returned when deleting a symbol that other symbols still reference,
+	// or changing its UID or project.
+	ErrSymbolHasDependents = errors.New("symbol is referenced by other symbols")
+
+	// ErrInvalidReference is returned when a symbol references itself or a symbol missing from its project.
+	ErrInvalidReference = errors.New("invalid symbol reference")
+
+	// ErrReferenceCycle is returned when the references of a symbol would form a cycle.
+	ErrReferenceCycle = errors.New("symbol references form a cycle")
+
+	// ErrSymbolLocked is returned when another user session holds a live lease on the symbol.
+	ErrSymbolLocked = errors.New("symbol is locked by another editing session")
+
+	// ErrLockNotHeld is returned when renewing or releasing a lease the caller does not hold.
+	ErrLockNotHeld = errors.New("symbol lock is not held by the caller")
+
+	// ErrLockForbidden is returned when the caller may not take or break a lease.
+	ErrLockForbidden = errors.New("symbol lock operation is not allowed")
+
+	// ErrRevisionNotFound is returned when a symbol revision does not exist.
+	ErrRevisionNotFound = errors.New("symbol revision not found")
 )
 
 // Data layer errors (returned by repository implementations)
@@ -4,6 +4,7 @@ package domain
 import (
 	"context"
 	"platform/pagination"
+	"time"
 )
 
 // SymbolRepo represents the data access layer for Symbols.
@@ -18,11 +19,38 @@ type SymbolRepo interface {
 	// FindByID returns the Symbol with the given ID from the repository.
 	FindByID(context.Context, uint64) (*Symbol, error)
 
+	// FindByIDForUpdate is FindByID locking the Symbol row until the transaction of the context ends,
+	// so that no reference to it is validated and no lease on it acquired meanwhile.
+	FindByIDForUpdate(context.Context, uint64) (*Symbol, error)
+
 	// ListSymbols returns a list of Symbols from the repository with pagination metadata.
 	ListSymbols(ctx context.Context, opts ListSymbolsOptions) ([]*Symbol, *pagination.Meta, error)
 
 	// Delete removes a Symbol from the repository by its ID. Returns an error if the operation fails.
 	Delete(context.Context, uint64) error
+
+	// FindByUIDs returns the Symbols of a project with the given UIDs, including their references.
+	// UIDs without a matching Symbol are skipped.
+	FindByUIDs(ctx context.Context, projectID uint64, uids []string) ([]*Symbol, error)
+
+	// FindByUIDsForShare is FindByUIDs holding a shared lock on the Symbol rows until the transaction of
+	// the context ends, so that they are not deleted while references to them are stored.
+	FindByUIDsForShare(ctx context.Context, projectID uint64, uids []string) ([]*Symbol, error)
+
+	// ListDependents returns the Symbols of a project that reference the given UID.
+	ListDependents(ctx context.Context, projectID uint64, uid string) ([]*Symbol, error)
+
+	// DeleteReferencesTo removes all references to the given UID within a project.
+	DeleteReferencesTo(ctx context.Context, projectID uint64, uid string) (int64, error)
+
+	// TagKeyFacets returns the tag keys of a project with the number of Symbols carrying each, ordered by key.
+	TagKeyFacets(ctx context.Context, projectID uint64) ([]*TagFacet, error)
+
+	// TagValueFacets returns the values of a tag key with the number of Symbols carrying each, ordered by value.
+	TagValueFacets(ctx context.Context, projectID uint64, key string) ([]*TagFacet, error)
+
+	// ListForReplay returns up to limit Symbols matching the filter with an ID above afterID, ordered by ID.
+	ListForReplay(ctx context.Context, filter ReplayFilter, afterID uint64, limit int) ([]*Symbol, error)
 }
 
 // SymbolUseCase defines the use cases supported by the Symbols service.
@@ -34,13 +62,146 @@ type SymbolUseCase interface {
 	CreateSymbol(ctx context.Context, g *Symbol) (*Symbol, error)
 
 	// UpdateSymbol updates an existing Symbol and returns the updated Symbol.
+	// Fails with ErrSymbolHasDependents when changing its UID or project while other symbols reference it.
 	UpdateSymbol(ctx context.Context, g *Symbol) (*Symbol, error)
 
 	// DeleteSymbol deletes a Symbol by its ID.
-	DeleteSymbol(ctx context.Context, id uint64) error
+	// Fails with ErrSymbolHasDependents while other symbols reference it, unless cascade is set,
+	// in which case those references are removed.
+	DeleteSymbol(ctx context.Context, id uint64, cascade bool) error
+
+	// ListSymbolDependents lists the Symbols that reference the given Symbol.
+	ListSymbolDependents(ctx context.Context, id uint64) ([]*Symbol, error)
+
+	// ListSymbolDependencies lists the Symbols referenced by the given Symbol.
+	ListSymbolDependencies(ctx context.Context, id uint64) ([]*Symbol, error)
 
 	// ListSymbols lists Symbols based on the provided options and returns pagination metadata.
 	ListSymbols(ctx context.Context, opts ListSymbolsOptions) ([]*Symbol, *pagination.Meta, error)
+
+	// ListSymbolRevisions lists the revisions of a Symbol, oldest first, without their data.
+	ListSymbolRevisions(ctx context.Context, id uint64) ([]*SymbolRevision, error)
+
+	// DiffSymbol compares a Symbol, or one of its revisions when baseRevision is set, with the target state.
+	DiffSymbol(ctx context.Context, id uint64, baseRevision uint32, target DiffTarget) (*SymbolDiff, error)
+
+	// AcquireSymbolLock takes an editing lease on a Symbol for the calling user session.
+	// A ttl of zero uses DefaultLockTTL.
+	AcquireSymbolLock(ctx context.Context, id uint64, ttl time.Duration) (*SymbolLock, error)
+
+	// RenewSymbolLock extends the lease held by the calling user session.
+	RenewSymbolLock(ctx context.Context, id uint64, ttl time.Duration) (*SymbolLock, error)
+
+	// ReleaseSymbolLock ends the lease held by the calling user session.
+	// With force, an admin may break a lease held by someone else.
+	ReleaseSymbolLock(ctx context.Context, id uint64, force bool) error
+
+	// ListTagKeys returns the tag keys used in a project with their symbol counts.
+	ListTagKeys(ctx context.Context, projectID uint64) ([]*TagFacet, error)
+
+	// ListTagValues returns the values of a tag key in a project with their symbol counts.
+	ListTagValues(ctx context.Context, projectID uint64, key string) ([]*TagFacet, error)
+
+	// GetProjectSymbolStats returns aggregate statistics about the Symbols of a project.
+	GetProjectSymbolStats(ctx context.Context, projectID uint64) (*ProjectSymbolStats, error)
+
+	// RefreshProjectSymbolStats recomputes the materialized statistics of a project.
+	RefreshProjectSymbolStats(ctx context.Context, projectID uint64) error
+
+	// ListAuditEvents lists the audit trail of a project, newest first.
+	ListAuditEvents(ctx context.Context, opts ListAuditEventsOptions) ([]*AuditEvent, *pagination.Meta, error)
+}
+
+// SymbolReplayUseCase re-publishes Symbol events for consumers that lost their state.
+type SymbolReplayUseCase interface {
+	// ReplaySymbols publishes a SymbolCreated snapshot of every Symbol matching the options, marked
+	// as a replay, and returns how many Symbols matched.
+	ReplaySymbols(ctx context.Context, opts ReplayOptions) (int, error)
+}
+
+// MaintenanceUseCase runs the periodic cleanups of the Symbols data.
+type MaintenanceUseCase interface {
+	// PurgeDeletedSymbols permanently removes the Symbols soft-deleted before the given time and
+	// returns the number removed.
+	PurgeDeletedSymbols(ctx context.Context, before time.Time) (int64, error)
+
+	// CollectOrphanSymbolData removes the symbol data left without Symbol and returns the number removed.
+	CollectOrphanSymbolData(ctx context.Context) (int64, error)
+
+	// RefreshStaleProjectStats recomputes the materialized statistics computed before the given time
+	// and returns the number of projects refreshed.
+	RefreshStaleProjectStats(ctx context.Context, before time.Time) (int, error)
+
+	// PurgeAuditEvents deletes audit events created before the given time and returns the number removed.
+	PurgeAuditEvents(ctx context.Context, before time.Time) (int64, error)
+}
+
+// MaintenanceRepo represents the cleanup queries of the maintenance jobs. Each call handles at most
+// limit rows, so that long cleanups are split into short transactions.
+type MaintenanceRepo interface {
+	// PurgeDeletedSymbols permanently removes Symbols soft-deleted before the given time with their
+	// data, references, tags and lease. Revisions and audit events are kept.
+	PurgeDeletedSymbols(ctx context.Context, before time.Time, limit int) (int64, error)
+
+	// DeleteOrphanSymbolData removes symbol data whose Symbol no longer exists.
+	DeleteOrphanSymbolData(ctx context.Context, limit int) (int64, error)
+
+	// ListStaleStatsProjects returns the projects after afterProjectID whose materialized statistics
+	// were computed before the given time, in project order.
+	ListStaleStatsProjects(ctx context.Context, before time.Time, afterProjectID uint64, limit int) ([]uint64, error)
+}
+
+// SymbolRevisionRepo represents the storage of symbol snapshots.
+type SymbolRevisionRepo interface {
+	// Append stores a snapshot of the Symbol as its next revision and returns the revision number.
+	Append(ctx context.Context, symbol *Symbol) (uint32, error)
+
+	// Find returns a revision of a Symbol. Returns ErrDataNotFound when it does not exist.
+	Find(ctx context.Context, symbolID uint64, revision uint32) (*SymbolRevision, error)
+
+	// List returns the revisions of a Symbol, oldest first, without their data.
+	List(ctx context.Context, symbolID uint64) ([]*SymbolRevision, error)
+}
+
+// SymbolLockRepo represents the storage of symbol editing leases.
+type SymbolLockRepo interface {
+	// Acquire stores the lease unless another user session holds a live one.
+	// It returns the lease in effect and whether it is the given one.
+	Acquire(ctx context.Context, lock *SymbolLock, now time.Time) (*SymbolLock, bool, error)
+
+	// Renew moves the expiry of a live lease held by the given user session.
+	// Returns ErrDataNotFound when the session holds no live lease.
+	Renew(ctx context.Context, lock *SymbolLock, now time.Time) error
+
+	// Find returns the lease of a Symbol, live or expired.
+	Find(ctx context.Context, symbolID uint64) (*SymbolLock, error)
+
+	// Delete removes the given lease of a Symbol as long as the same user session still holds it.
+	// Returns ErrDataNotFound when the lease was released or taken over meanwhile.
+	Delete(ctx context.Context, lock *SymbolLock) error
+}
+
+// ProjectStatsRepo computes project symbol statistics and keeps their materialized copy.
+type ProjectStatsRepo interface {
+	// Get returns the statistics of a project. The materialized copy is used when enabled and
+	// fresh enough; otherwise the statistics are computed with aggregate queries.
+	Get(ctx context.Context, projectID uint64, now time.Time) (*ProjectSymbolStats, error)
+
+	// Refresh recomputes and stores the materialized statistics of a project.
+	// It does nothing when materialization is disabled.
+	Refresh(ctx context.Context, projectID uint64, now time.Time) error
+}
+
+// AuditRepo represents the append-only storage of the audit trail.
+type AuditRepo interface {
+	// Append stores a new audit event.
+	Append(context.Context, *AuditEvent) error
+
+	// List returns audit events matching the options, newest first, with pagination metadata.
+	List(ctx context.Context, opts ListAuditEventsOptions) ([]*AuditEvent, *pagination.Meta, error)
+
+	// DeleteBefore removes audit events created before the given time (retention).
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
 }
 
 // SymbolEventPublisher defines the event publishing interface for Symbols.
@@ -51,4 +212,11 @@ type SymbolEventPublisher interface {
 	PublishSymbolUpdated(ctx context.Context, symbol *Symbol) error
 	// PublishSymbolDeleted publishes a SymbolDeleted event.
 	PublishSymbolDeleted(ctx context.Context, symbol *Symbol) error
+	// PublishSymbolLocked publishes a SymbolLocked event.
+	PublishSymbolLocked(ctx context.Context, symbol *Symbol, lock *SymbolLock) error
+	// PublishSymbolUnlocked publishes a SymbolUnlocked event. forced is set when releasedBy broke someone else's lease.
+	PublishSymbolUnlocked(ctx context.Context, symbol *Symbol, lock *SymbolLock, releasedBy string, forced bool) error
+	// ReplaySymbolCreated re-publishes a SymbolCreated snapshot flagged as a replay.
+	// A non-empty routingKey replaces the SymbolCreated topic.
+	ReplaySymbolCreated(ctx context.Context, symbol *Symbol, routingKey string) error
 }
@@ -1,7 +1,11 @@
 // Package domain contains the business domain models and interfaces.
 package domain
 
-import "platform/pagination"
+import (
+	"encoding/json"
+	"platform/pagination"
+	"time"
+)
 
 // SortDirection represents the direction of sorting.
 type SortDirection string
@@ -51,9 +55,12 @@ type SymbolData struct {
 // SymbolFilter contains optional filter criteria for listing symbols.
 // All optional fields are pointers to distinguish between "not set" and "empty value".
 type SymbolFilter struct {
-	ProjectID       uint64  // Required, always set
-	Label           *string // Optional: exact match on label
-	ComponentTarget *string // Optional: exact match on component_target
+	ProjectID       uint64              // Required, always set
+	Label           *string             // Optional: exact match on label
+	ComponentTarget *string             // Optional: exact match on component_target
+	Tags            map[string]string   `validate:"omitempty,dive,keys,min=1,max=64,endkeys"`       // Optional: tag key equals value
+	TagKeys         []string            `validate:"omitempty,dive,min=1,max=64"`                    // Optional: tag key is set
+	TagIn           map[string][]string `validate:"omitempty,dive,keys,min=1,max=64,endkeys,min=1"` // Optional: tag key is one of the values
 }
 
 // ListSymbolsOptions contains parameters for listing symbols.
@@ -63,14 +70,196 @@ type ListSymbolsOptions struct {
 	Sort       SortOption                        // Sorting options (defaults to ID ASC if empty)
 }
 
+// ReplayFilter selects the Symbols whose events are replayed. Zero fields are not applied,
+// but at least one must be set.
+type ReplayFilter struct {
+	ProjectID     uint64
+	MinID         uint64    // Inclusive
+	MaxID         uint64    // Inclusive
+	UpdatedAfter  time.Time // Inclusive
+	UpdatedBefore time.Time // Exclusive
+}
+
+// IsZero reports whether no criterion is set.
+func (f ReplayFilter) IsZero() bool {
+	return f.ProjectID == 0 && f.MinID == 0 && f.MaxID == 0 && f.UpdatedAfter.IsZero() && f.UpdatedBefore.IsZero()
+}
+
+// ReplayOptions controls a replay of SymbolCreated snapshots.
+type ReplayOptions struct {
+	Filter     ReplayFilter
+	RoutingKey string  // Publishes with this routing key instead of the SymbolCreated topic
+	DryRun     bool    // Lists the matching Symbols without publishing
+	Rate       float64 // Maximum events per second; zero is unlimited
+	BatchSize  int     // Symbols loaded per query; zero uses DefaultReplayBatchSize
+	// OnSymbol, when set, is called for every replayed Symbol, after it was published.
+	OnSymbol func(*Symbol)
+}
+
+// DefaultReplayBatchSize is the number of Symbols a replay loads per query.
+const DefaultReplayBatchSize = 500
+
+// MaintenanceBatchSize is the number of rows a maintenance job handles per query.
+const MaintenanceBatchSize = 500
+
 // Symbol represents a symbol business entity.
 type Symbol struct {
-	ID              uint64      `validate:"omitempty,gte=0"`
-	Project         uint64      `validate:"required,gt=0"`
-	UID             string      `validate:"required,uuid4"`
-	Label           string      `validate:"required,min=1,max=255"`
-	ClassName       string      `validate:"required,min=1,max=255"`
-	ComponentTarget string      `validate:"required,min=1,max=100"`
-	Version         uint32      `validate:"required"`
-	Data            *SymbolData `validate:"required"`
+	ID              uint64            `validate:"omitempty,gte=0"`
+	Project         uint64            `validate:"required,gt=0"`
+	UID             string            `validate:"required,uuid4"`
+	Label           string            `validate:"required,min=1,max=255"`
+	ClassName       string            `validate:"required,min=1,max=255"`
+	ComponentTarget string            `validate:"required,min=1,max=100"`
+	Version         uint32            `validate:"required"`
+	Data            *SymbolData       `validate:"required"`
+	References      []string          `validate:"omitempty,unique,dive,uuid4"` // UIDs of embedded symbols in the same project
+	Tags            map[string]string `validate:"omitempty,max=50,dive,keys,min=1,max=64,endkeys,max=255"`
+}
+
+// TagFacet is a tag key or value with the number of symbols carrying it.
+type TagFacet struct {
+	Value string
+	Count uint64
+}
+
+// ProjectSymbolStats summarizes the live Symbols of a project.
+// Recently changed counts are relative to ComputedAt.
+type ProjectSymbolStats struct {
+	ProjectID        uint64
+	TotalSymbols     uint64
+	ComponentTargets map[string]uint64 // Number of symbols per component target
+	TotalDataBytes   uint64
+	ChangedLastDay   uint64
+	ChangedLastWeek  uint64
+	ComputedAt       time.Time
+	Materialized     bool // Read from the materialized stats table
+}
+
+// AverageDataBytes returns the mean data size per symbol.
+func (s *ProjectSymbolStats) AverageDataBytes() float64 {
+	if s.TotalSymbols == 0 {
+		return 0
+	}
+	return float64(s.TotalDataBytes) / float64(s.TotalSymbols)
+}
+
+// Editing lease durations.
+const (
+	DefaultLockTTL = 5 * time.Minute
+	MaxLockTTL     = time.Hour
+)
+
+// SymbolLock is an editing lease on a symbol held by a user session.
+type SymbolLock struct {
+	SymbolID   uint64
+	ProjectID  uint64
+	Holder     string
+	SessionID  string
+	AcquiredAt time.Time
+	ExpiresAt  time.Time
+}
+
+// Live reports whether the lease is still in effect at the given time.
+func (l *SymbolLock) Live(now time.Time) bool {
+	return now.Before(l.ExpiresAt)
+}
+
+// HeldBy reports whether the lease belongs to the given user session.
+func (l *SymbolLock) HeldBy(holder, sessionID string) bool {
+	return l.Holder == holder && l.SessionID == sessionID
+}
+
+// AuditOperation identifies the kind of mutation recorded in the audit trail.
+type AuditOperation string
+
+const (
+	AuditOperationCreate AuditOperation = "create"
+	AuditOperationUpdate AuditOperation = "update"
+	AuditOperationDelete AuditOperation = "delete"
+)
+
+// SystemActor is recorded for mutations that do not originate from an API request.
+const SystemActor = "system"
+
+// FieldChange describes the change of a single symbol field.
+type FieldChange struct {
+	Field    string `json:"field"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+}
+
+// SymbolRevision is a snapshot of a symbol as written by a create or an update.
+// References detached by a cascading delete do not produce a revision.
+type SymbolRevision struct {
+	Revision  uint32 // 1 for the creation, incremented by every update
+	Symbol    *Symbol
+	CreatedAt time.Time
+}
+
+// DiffTarget selects the state a symbol is compared against. Exactly one field is set.
+type DiffTarget struct {
+	Revision uint32  // Another revision of the same symbol
+	SymbolID uint64  // Another symbol
+	Proposed *Symbol // An unsaved state; Project and UID are those of the base symbol
+}
+
+// SymbolDiff describes the differences between two states of a symbol.
+type SymbolDiff struct {
+	Changes []FieldChange // Metadata changes; data is described by Data
+	Data    DataDiff
+}
+
+// DataDiff describes the change of symbol data.
+// Patch is used when both sides are JSON, Bytes otherwise.
+type DataDiff struct {
+	Changed bool
+	Patch   []JSONPatchOperation
+	Bytes   *ByteSummary
+}
+
+// JSONPatchOperation is an RFC 6902 operation.
+type JSONPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// ByteSummary describes a change of data that is not JSON.
+type ByteSummary struct {
+	OldSize      int
+	NewSize      int
+	OldSHA256    string
+	NewSHA256    string
+	CommonPrefix int // Leading bytes both sides share
+	CommonSuffix int // Trailing bytes both sides share, not overlapping the prefix
+}
+
+// AuditEvent is an append-only record of a symbol mutation.
+type AuditEvent struct {
+	ID        uint64
+	ProjectID uint64
+	SymbolID  uint64
+	Operation AuditOperation
+	Actor     string
+	RequestID string
+	ClientIP  string
+	Changes   []FieldChange
+	CreatedAt time.Time
+}
+
+// AuditFilter contains filter criteria for listing audit events.
+// All optional fields are pointers to distinguish between "not set" and "empty value".
+type AuditFilter struct {
+	ProjectID uint64          `validate:"required,gt=0"`
+	SymbolID  *uint64         // Optional: events of a single symbol
+	Actor     *string         // Optional: exact match on actor
+	Operation *AuditOperation // Optional: exact match on operation
+	Since     *time.Time      // Optional: created at or after
+	Until     *time.Time      // Optional: created before
+}
+
+// ListAuditEventsOptions contains parameters for listing audit events.
+type ListAuditEventsOptions struct {
+	Filter     AuditFilter                       `validate:"required"`
+	Pagination pagination.OffsetPaginationParams `validate:"required"`
 }
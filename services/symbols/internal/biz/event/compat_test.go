@@ -0,0 +1,257 @@
+// Package event provides contract compatibility tests for the symbol events.
+package event
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	eventsv1 "contracts/gen/events/symbols/v1"
+	"symbols/internal/biz/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// update rewrites the fixtures of the latest release and the schema snapshot:
+//
+//	go test ./internal/biz/event -run TestEventContract -update
+var update = flag.Bool("update", false, "update golden event fixtures and the schema snapshot")
+
+const (
+	goldenDir    = "testdata/golden"
+	schemaGolden = "testdata/golden/schema.json"
+)
+
+// release lists the event types published by a released version of the events contract.
+// Fixtures of older releases are frozen: -update only writes the latest release and
+// fixtures that do not exist yet.
+type release struct {
+	version string
+	events  []string
+}
+
+// releases must be kept in order; add a new entry when a contract change ships.
+var releases = []release{
+	{
+		version: "v1.0.0",
+		events:  []string{"SymbolCreated", "SymbolUpdated", "SymbolDeleted"},
+	},
+	{
+		version: "v1.1.0",
+		events:  []string{"SymbolCreated", "SymbolUpdated", "SymbolDeleted", "SymbolLocked", "SymbolUnlocked"},
+	},
+}
+
+// fieldSpec is the wire-relevant part of a field descriptor kept in the schema snapshot.
+type fieldSpec struct {
+	Number      int32  `json:"number"`
+	Kind        string `json:"kind"`
+	Cardinality string `json:"cardinality"`
+}
+
+// goldenEvents builds every event through its mapper from fixed inputs with no zero values.
+func goldenEvents() map[string]proto.Message {
+	at := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	s := &domain.Symbol{
+		ID:              123,
+		Project:         456,
+		UID:             "550e8400-e29b-41d4-a716-446655440000",
+		Label:           "Golden Symbol",
+		ClassName:       "GoldenClass",
+		ComponentTarget: "web",
+		Version:         7,
+	}
+	l := &domain.SymbolLock{
+		Holder:     "editor@example.com",
+		SessionID:  "session-42",
+		AcquiredAt: at,
+		ExpiresAt:  at.Add(5 * time.Minute),
+	}
+
+	return map[string]proto.Message{
+		"SymbolCreated":  ToSymbolCreatedEvent(s, at),
+		"SymbolUpdated":  ToSymbolUpdatedEvent(s, at.Add(time.Minute)),
+		"SymbolDeleted":  ToSymbolDeletedEvent(s, at.Add(2*time.Minute)),
+		"SymbolLocked":   ToSymbolLockedEvent(s, l),
+		"SymbolUnlocked": ToSymbolUnlockedEvent(s, l, "admin@example.com", true, at.Add(3*time.Minute)),
+	}
+}
+
+// newEvent returns an empty message of the current contract for a short event name.
+func newEvent(t *testing.T, name string) proto.Message {
+	t.Helper()
+	md := eventsv1.File_events_symbols_v1_events_proto.Messages().ByName(protoreflect.Name(name))
+	require.NotNil(t, md, "event %s is no longer defined in the contract", name)
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName())
+	require.NoError(t, err)
+	return mt.New().Interface()
+}
+
+func fixturePath(version, name, ext string) string {
+	return filepath.Join(goldenDir, version, name+ext)
+}
+
+func writeFixtures(t *testing.T, version, name string, msg proto.Message) {
+	t.Helper()
+	bin, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	require.NoError(t, err)
+	js, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", UseProtoNames: true}.Marshal(msg)
+	require.NoError(t, err)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(goldenDir, version), 0o755))
+	require.NoError(t, os.WriteFile(fixturePath(version, name, ".bin"), bin, 0o644))
+	require.NoError(t, os.WriteFile(fixturePath(version, name, ".json"), append(js, '\n'), 0o644))
+}
+
+// assertPopulated fails for every field the message leaves at its zero value.
+func assertPopulated(t *testing.T, m protoreflect.Message) {
+	t.Helper()
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		assert.True(t, m.Has(fd), "%s is not populated", fd.FullName())
+	}
+}
+
+func TestEventContract_MappersPopulateEveryField(t *testing.T) {
+	events := goldenEvents()
+	messages := eventsv1.File_events_symbols_v1_events_proto.Messages()
+	for i := 0; i < messages.Len(); i++ {
+		name := string(messages.Get(i).Name())
+		t.Run(name, func(t *testing.T) {
+			msg, ok := events[name]
+			require.True(t, ok, "no mapper output for %s; add it to goldenEvents", name)
+			require.NotNil(t, msg)
+			assertPopulated(t, msg.ProtoReflect())
+		})
+	}
+}
+
+func TestEventContract_LatestReleaseCoversEveryEvent(t *testing.T) {
+	latest := releases[len(releases)-1]
+	messages := eventsv1.File_events_symbols_v1_events_proto.Messages()
+	for i := 0; i < messages.Len(); i++ {
+		assert.Contains(t, latest.events, string(messages.Get(i).Name()),
+			"event is missing from release %s", latest.version)
+	}
+}
+
+func TestEventContract_GoldenFixtures(t *testing.T) {
+	events := goldenEvents()
+	for i, rel := range releases {
+		latest := i == len(releases)-1
+		for _, name := range rel.events {
+			t.Run(rel.version+"/"+name, func(t *testing.T) {
+				if *update {
+					_, err := os.Stat(fixturePath(rel.version, name, ".bin"))
+					if latest || os.IsNotExist(err) {
+						writeFixtures(t, rel.version, name, events[name])
+					}
+				}
+
+				bin, err := os.ReadFile(fixturePath(rel.version, name, ".bin"))
+				require.NoError(t, err, "missing fixture; run the test with -update")
+				js, err := os.ReadFile(fixturePath(rel.version, name, ".json"))
+				require.NoError(t, err, "missing fixture; run the test with -update")
+
+				fromBin := newEvent(t, name)
+				require.NoError(t, proto.Unmarshal(bin, fromBin))
+				assert.Empty(t, fromBin.ProtoReflect().GetUnknown(),
+					"binary payload has fields the current contract no longer knows")
+
+				fromJSON := newEvent(t, name)
+				require.NoError(t, protojson.Unmarshal(js, fromJSON),
+					"JSON payload uses fields the current contract no longer knows")
+
+				assert.True(t, proto.Equal(fromBin, fromJSON),
+					"binary and JSON fixtures decode differently:\n%v\n%v", fromBin, fromJSON)
+				if latest {
+					assert.True(t, proto.Equal(events[name], fromBin),
+						"mapper output differs from the fixture; run the test with -update")
+				}
+			})
+		}
+	}
+}
+
+func TestEventContract_FieldsNotRemovedOrRenumbered(t *testing.T) {
+	current := schemaSnapshot(eventsv1.File_events_symbols_v1_events_proto)
+
+	golden := map[string]map[string]fieldSpec{}
+	data, err := os.ReadFile(schemaGolden)
+	if err == nil {
+		require.NoError(t, json.Unmarshal(data, &golden))
+	} else if !*update || !os.IsNotExist(err) {
+		require.NoError(t, err, "missing schema snapshot; run the test with -update")
+	}
+
+	messages := eventsv1.File_events_symbols_v1_events_proto.Messages()
+	for msgName, fields := range golden {
+		md := messages.ByName(protoreflect.FullName(msgName).Name())
+		if !assert.NotNil(t, md, "event %s was removed", msgName) {
+			continue
+		}
+		for fieldName, want := range fields {
+			got, ok := current[msgName][fieldName]
+			if !ok {
+				// Removing a field is only safe once its name and number are reserved.
+				assert.True(t,
+					md.ReservedNames().Has(protoreflect.Name(fieldName)) &&
+						md.ReservedRanges().Has(protoreflect.FieldNumber(want.Number)),
+					"%s.%s (field %d) was removed without reserving its name and number",
+					msgName, fieldName, want.Number)
+				continue
+			}
+			assert.Equal(t, want, got, "%s.%s changed its number, type or cardinality", msgName, fieldName)
+		}
+	}
+
+	if !*update || t.Failed() {
+		return
+	}
+	// Only add new messages and fields: removed ones stay in the snapshot for good.
+	for msgName, fields := range current {
+		if golden[msgName] == nil {
+			golden[msgName] = map[string]fieldSpec{}
+		}
+		for fieldName, spec := range fields {
+			golden[msgName][fieldName] = spec
+		}
+	}
+	out, err := json.MarshalIndent(golden, "", "  ")
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(goldenDir, 0o755))
+	require.NoError(t, os.WriteFile(schemaGolden, append(out, '\n'), 0o644))
+}
+
+func schemaSnapshot(fd protoreflect.FileDescriptor) map[string]map[string]fieldSpec {
+	out := map[string]map[string]fieldSpec{}
+	messages := fd.Messages()
+	for i := 0; i < messages.Len(); i++ {
+		md := messages.Get(i)
+		fields := map[string]fieldSpec{}
+		for j := 0; j < md.Fields().Len(); j++ {
+			f := md.Fields().Get(j)
+			kind := f.Kind().String()
+			if f.Message() != nil {
+				kind = fmt.Sprintf("%s %s", kind, f.Message().FullName())
+			}
+			fields[string(f.Name())] = fieldSpec{
+				Number:      int32(f.Number()),
+				Kind:        kind,
+				Cardinality: f.Cardinality().String(),
+			}
+		}
+		out[string(md.FullName())] = fields
+	}
+	return out
+}
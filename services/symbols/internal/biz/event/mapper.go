@@ -56,3 +56,35 @@ func ToSymbolDeletedEvent(s *domain.Symbol, deletedAt time.Time) *eventsv1.Symbo
 		DeletedAt: timestamppb.New(deletedAt),
 	}
 }
+
+// ToSymbolLockedEvent creates a SymbolLocked event from a domain.Symbol and its lease.
+func ToSymbolLockedEvent(s *domain.Symbol, l *domain.SymbolLock) *eventsv1.SymbolLocked {
+	if s == nil || l == nil {
+		return nil
+	}
+	return &eventsv1.SymbolLocked{
+		Id:        s.ID,
+		ProjectId: s.Project,
+		Uid:       s.UID,
+		Holder:    l.Holder,
+		SessionId: l.SessionID,
+		ExpiresAt: timestamppb.New(l.ExpiresAt),
+		LockedAt:  timestamppb.New(l.AcquiredAt),
+	}
+}
+
+// ToSymbolUnlockedEvent creates a SymbolUnlocked event from a domain.Symbol and its released lease.
+func ToSymbolUnlockedEvent(s *domain.Symbol, l *domain.SymbolLock, releasedBy string, forced bool, unlockedAt time.Time) *eventsv1.SymbolUnlocked {
+	if s == nil || l == nil {
+		return nil
+	}
+	return &eventsv1.SymbolUnlocked{
+		Id:         s.ID,
+		ProjectId:  s.Project,
+		Uid:        s.UID,
+		Holder:     l.Holder,
+		ReleasedBy: releasedBy,
+		Forced:     forced,
+		UnlockedAt: timestamppb.New(unlockedAt),
+	}
+}
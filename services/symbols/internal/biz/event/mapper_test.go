@@ -250,3 +250,47 @@ func TestToSymbolDeletedEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestToSymbolLockEvents(t *testing.T) {
+	at := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	symbol := &domain.Symbol{ID: 123, Project: 456, UID: "550e8400-e29b-41d4-a716-446655440000"}
+	lock := &domain.SymbolLock{
+		SymbolID:   123,
+		ProjectID:  456,
+		Holder:     "user-1",
+		SessionID:  "sess-1",
+		AcquiredAt: at,
+		ExpiresAt:  at.Add(5 * time.Minute),
+	}
+
+	t.Run("locked", func(t *testing.T) {
+		expected := &eventsv1.SymbolLocked{
+			Id:        123,
+			ProjectId: 456,
+			Uid:       "550e8400-e29b-41d4-a716-446655440000",
+			Holder:    "user-1",
+			SessionId: "sess-1",
+			ExpiresAt: timestamppb.New(at.Add(5 * time.Minute)),
+			LockedAt:  timestamppb.New(at),
+		}
+
+		assert.Equal(t, expected, ToSymbolLockedEvent(symbol, lock))
+		assert.Nil(t, ToSymbolLockedEvent(nil, lock))
+		assert.Nil(t, ToSymbolLockedEvent(symbol, nil))
+	})
+
+	t.Run("unlocked", func(t *testing.T) {
+		expected := &eventsv1.SymbolUnlocked{
+			Id:         123,
+			ProjectId:  456,
+			Uid:        "550e8400-e29b-41d4-a716-446655440000",
+			Holder:     "user-1",
+			ReleasedBy: "admin-1",
+			Forced:     true,
+			UnlockedAt: timestamppb.New(at),
+		}
+
+		assert.Equal(t, expected, ToSymbolUnlockedEvent(symbol, lock, "admin-1", true, at))
+		assert.Nil(t, ToSymbolUnlockedEvent(nil, lock, "admin-1", true, at))
+	})
+}
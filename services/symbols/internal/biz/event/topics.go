@@ -2,7 +2,9 @@
 package event
 
 const (
-	SymbolCreatedTopic = "symbol.created"
-	SymbolUpdatedTopic = "symbol.updated"
-	SymbolDeletedTopic = "symbol.deleted"
+	SymbolCreatedTopic  = "symbol.created"
+	SymbolUpdatedTopic  = "symbol.updated"
+	SymbolDeletedTopic  = "symbol.deleted"
+	SymbolLockedTopic   = "symbol.locked"
+	SymbolUnlockedTopic = "symbol.unlocked"
 )
@@ -8,4 +8,4 @@ import (
 )
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(usecase.NewValidator, usecase.NewUseCase)
+var ProviderSet = wire.NewSet(usecase.NewValidator, usecase.NewUseCase, usecase.NewMaintenanceUseCase)
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"platform/middleware"
+	"platform/pagination"
+	"slices"
+	"strconv"
+	"strings"
+	"symbols/internal/biz/domain"
+)
+
+// ListAuditEvents lists the audit trail of a project, newest first.
+func (uc *useCase) ListAuditEvents(ctx context.Context, opts domain.ListAuditEventsOptions) ([]*domain.AuditEvent, *pagination.Meta, error) {
+	// Validate options
+	if err := uc.validator.Struct(opts); err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", domain.ErrValidationFailed, err)
+	}
+
+	events, meta, err := uc.audit.List(ctx, opts)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to list audit events: %v", err)
+		return nil, nil, toDomainError(err)
+	}
+
+	return events, meta, nil
+}
+
+// recordAudit appends an audit event for a symbol mutation.
+// before is nil for creations and after is nil for deletions.
+// Must be called inside the mutation's transaction so that the trail and the change commit together.
+func (uc *useCase) recordAudit(ctx context.Context, op domain.AuditOperation, before, after *domain.Symbol) error {
+	subject := after
+	if subject == nil {
+		subject = before
+	}
+
+	event := &domain.AuditEvent{
+		ProjectID: subject.Project,
+		SymbolID:  subject.ID,
+		Operation: op,
+		Actor:     domain.SystemActor,
+		Changes:   diffSymbols(before, after),
+	}
+
+	if caller, ok := middleware.CallerFromContext(ctx); ok {
+		event.Actor = caller.Actor
+		event.RequestID = caller.RequestID
+		event.ClientIP = caller.ClientIP
+	} else {
+		event.RequestID = middleware.RequestIDFromContext(ctx)
+	}
+
+	return uc.audit.Append(ctx, event)
+}
+
+// diffSymbols returns the field-level changes between two versions of a symbol.
+// A nil side is treated as an empty symbol. Symbol data is compared by its SHA-256 digest.
+func diffSymbols(before, after *domain.Symbol) []domain.FieldChange {
+	b, a := symbolFields(before), symbolFields(after)
+
+	changes := make([]domain.FieldChange, 0, len(symbolFieldNames))
+	for _, name := range symbolFieldNames {
+		if b[name] != a[name] {
+			changes = append(changes, domain.FieldChange{Field: name, OldValue: b[name], NewValue: a[name]})
+		}
+	}
+
+	return changes
+}
+
+// symbolFieldNames lists the audited symbol fields in a stable order.
+var symbolFieldNames = []string{"project_id", "uid", "label", "class_name", "component_target", "version", "data", "references", "tags"}
+
+// symbolFields flattens the audited fields of a symbol into strings.
+func symbolFields(s *domain.Symbol) map[string]string {
+	if s == nil {
+		return map[string]string{}
+	}
+
+	fields := map[string]string{
+		"project_id":       strconv.FormatUint(s.Project, 10),
+		"uid":              s.UID,
+		"label":            s.Label,
+		"class_name":       s.ClassName,
+		"component_target": s.ComponentTarget,
+		"version":          strconv.FormatUint(uint64(s.Version), 10),
+	}
+
+	if len(s.References) > 0 {
+		refs := slices.Clone(s.References)
+		slices.Sort(refs)
+		fields["references"] = strings.Join(refs, ",")
+	}
+
+	if len(s.Tags) > 0 {
+		tags := make([]string, 0, len(s.Tags))
+		for k, v := range s.Tags {
+			tags = append(tags, k+"="+v)
+		}
+		slices.Sort(tags)
+		fields["tags"] = strings.Join(tags, ",")
+	}
+
+	if s.Data != nil && s.Data.Data != nil {
+		sum := sha256.Sum256(*s.Data.Data)
+		fields["data"] = "sha256:" + hex.EncodeToString(sum[:])
+	}
+
+	return fields
+}
@@ -0,0 +1,230 @@
+package usecase
+
+import (
+	"context"
+	"platform/middleware"
+	"platform/pagination"
+	"symbols/internal/biz/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffSymbols(t *testing.T) {
+	tests := []struct {
+		name       string
+		before     func() *domain.Symbol
+		after      func() *domain.Symbol
+		wantFields []string
+	}{
+		{
+			name:       "create records every field",
+			before:     func() *domain.Symbol { return nil },
+			after:      validSymbol,
+			wantFields: []string{"project_id", "uid", "label", "class_name", "component_target", "version", "data"},
+		},
+		{
+			name:       "delete records every field",
+			before:     validSymbol,
+			after:      func() *domain.Symbol { return nil },
+			wantFields: []string{"project_id", "uid", "label", "class_name", "component_target", "version", "data"},
+		},
+		{
+			name:   "update records changed fields only",
+			before: validSymbol,
+			after: func() *domain.Symbol {
+				s := validSymbol()
+				s.Label = "Renamed"
+				s.Version = 2
+				return s
+			},
+			wantFields: []string{"label", "version"},
+		},
+		{
+			name:   "data changes are detected by digest",
+			before: validSymbol,
+			after: func() *domain.Symbol {
+				s := validSymbol()
+				data := []byte(`{"key": "other"}`)
+				s.Data.Data = &data
+				return s
+			},
+			wantFields: []string{"data"},
+		},
+		{
+			name:   "tag changes are detected",
+			before: validSymbol,
+			after: func() *domain.Symbol {
+				s := validSymbol()
+				s.Tags = map[string]string{"theme": "dark"}
+				return s
+			},
+			wantFields: []string{"tags"},
+		},
+		{
+			name:       "no changes",
+			before:     validSymbol,
+			after:      validSymbol,
+			wantFields: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := diffSymbols(tt.before(), tt.after())
+
+			fields := make([]string, 0, len(changes))
+			for _, c := range changes {
+				fields = append(fields, c.Field)
+			}
+			assert.Equal(t, tt.wantFields, fields)
+		})
+	}
+
+	t.Run("values", func(t *testing.T) {
+		after := validSymbol()
+		after.Label = "Renamed"
+
+		changes := diffSymbols(validSymbol(), after)
+
+		require.Len(t, changes, 1)
+		assert.Equal(t, domain.FieldChange{Field: "label", OldValue: "Test Symbol", NewValue: "Renamed"}, changes[0])
+	})
+
+	t.Run("data is stored as a digest", func(t *testing.T) {
+		changes := diffSymbols(nil, validSymbol())
+
+		last := changes[len(changes)-1]
+		assert.Equal(t, "data", last.Field)
+		assert.Equal(t, "", last.OldValue)
+		assert.Contains(t, last.NewValue, "sha256:")
+		assert.NotContains(t, last.NewValue, "key")
+	})
+}
+
+func TestMutations_RecordAuditEvent(t *testing.T) {
+	caller := middleware.Caller{Actor: "user-42", RequestID: "req-1", ClientIP: "203.0.113.5"}
+
+	t.Run("create records caller and operation", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := middleware.WithCaller(context.Background(), caller)
+
+		created := validSymbol()
+		created.ID = 10
+		deps.repo.On("Create", ctx, mock.AnythingOfType("*domain.Symbol")).Return(created, nil)
+		deps.pub.On("PublishSymbolCreated", ctx, created).Return(nil)
+
+		var recorded *domain.AuditEvent
+		deps.audit.ExpectedCalls = nil
+		deps.audit.On("Append", ctx, mock.AnythingOfType("*domain.AuditEvent")).
+			Run(func(args mock.Arguments) { recorded = args.Get(1).(*domain.AuditEvent) }).
+			Return(nil)
+
+		_, err := deps.uc.CreateSymbol(ctx, validSymbol())
+		require.NoError(t, err)
+
+		require.NotNil(t, recorded)
+		assert.Equal(t, domain.AuditOperationCreate, recorded.Operation)
+		assert.Equal(t, uint64(10), recorded.SymbolID)
+		assert.Equal(t, uint64(1), recorded.ProjectID)
+		assert.Equal(t, "user-42", recorded.Actor)
+		assert.Equal(t, "req-1", recorded.RequestID)
+		assert.Equal(t, "203.0.113.5", recorded.ClientIP)
+		assert.Len(t, recorded.Changes, 7)
+	})
+
+	t.Run("update records the diff against the stored symbol", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		current := validSymbol()
+		current.ID = 1
+		updated := validSymbol()
+		updated.ID = 1
+		updated.Label = "Renamed"
+
+		deps.repo.On("FindByIDForUpdate", ctx, uint64(1)).Return(current, nil)
+		deps.repo.On("Update", ctx, mock.AnythingOfType("*domain.Symbol")).Return(updated, nil)
+		deps.pub.On("PublishSymbolUpdated", ctx, updated).Return(nil)
+
+		var recorded *domain.AuditEvent
+		deps.audit.ExpectedCalls = nil
+		deps.audit.On("Append", ctx, mock.AnythingOfType("*domain.AuditEvent")).
+			Run(func(args mock.Arguments) { recorded = args.Get(1).(*domain.AuditEvent) }).
+			Return(nil)
+
+		_, err := deps.uc.UpdateSymbol(ctx, updated)
+		require.NoError(t, err)
+
+		require.NotNil(t, recorded)
+		assert.Equal(t, domain.AuditOperationUpdate, recorded.Operation)
+		assert.Equal(t, domain.SystemActor, recorded.Actor)
+		assert.Equal(t, []domain.FieldChange{{Field: "label", OldValue: "Test Symbol", NewValue: "Renamed"}}, recorded.Changes)
+	})
+
+	t.Run("delete fails when the audit event cannot be stored", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		symbol := validSymbol()
+		symbol.ID = 1
+		deps.repo.On("FindByIDForUpdate", ctx, uint64(1)).Return(symbol, nil)
+		deps.repo.On("ListDependents", ctx, symbol.Project, symbol.UID).Return([]*domain.Symbol{}, nil)
+		deps.repo.On("Delete", ctx, uint64(1)).Return(nil)
+
+		deps.audit.ExpectedCalls = nil
+		deps.audit.On("Append", ctx, mock.AnythingOfType("*domain.AuditEvent")).Return(domain.ErrDataDatabase)
+
+		err := deps.uc.DeleteSymbol(ctx, 1, false)
+
+		assert.ErrorIs(t, err, domain.ErrDatabaseOperation)
+		deps.pub.AssertNotCalled(t, "PublishSymbolDeleted", mock.Anything, mock.Anything)
+	})
+}
+
+func TestListAuditEvents(t *testing.T) {
+	validOpts := domain.ListAuditEventsOptions{
+		Filter:     domain.AuditFilter{ProjectID: 1},
+		Pagination: pagination.OffsetPaginationParams{Offset: 0, Limit: 20},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		events := []*domain.AuditEvent{{ID: 1, ProjectID: 1, Operation: domain.AuditOperationCreate}}
+		meta := &pagination.Meta{TotalCount: 1, Limit: 20}
+		deps.audit.On("List", ctx, validOpts).Return(events, meta, nil)
+
+		result, resultMeta, err := deps.uc.ListAuditEvents(ctx, validOpts)
+
+		require.NoError(t, err)
+		assert.Equal(t, events, result)
+		assert.Equal(t, meta, resultMeta)
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+
+		opts := validOpts
+		opts.Filter.ProjectID = 0
+
+		_, _, err := deps.uc.ListAuditEvents(context.Background(), opts)
+
+		assert.ErrorIs(t, err, domain.ErrValidationFailed)
+		deps.audit.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		deps.audit.On("List", ctx, validOpts).Return(nil, nil, domain.ErrDataDatabase)
+
+		_, _, err := deps.uc.ListAuditEvents(ctx, validOpts)
+
+		assert.ErrorIs(t, err, domain.ErrDatabaseOperation)
+	})
+}
@@ -0,0 +1,257 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"symbols/internal/biz/domain"
+)
+
+// ListSymbolRevisions lists the revisions of a Symbol, oldest first, without their data.
+func (uc *useCase) ListSymbolRevisions(ctx context.Context, id uint64) ([]*domain.SymbolRevision, error) {
+	if id <= 0 {
+		return nil, domain.ErrInvalidID
+	}
+
+	if _, err := uc.repo.FindByID(ctx, id); err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to get symbol: %v", err)
+		return nil, toDomainError(err)
+	}
+
+	revisions, err := uc.revisions.List(ctx, id)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to list symbol revisions: %v", err)
+		return nil, toDomainError(err)
+	}
+
+	return revisions, nil
+}
+
+// DiffSymbol compares a Symbol, or one of its revisions when baseRevision is set, with the target state.
+func (uc *useCase) DiffSymbol(ctx context.Context, id uint64, baseRevision uint32, target domain.DiffTarget) (*domain.SymbolDiff, error) {
+	if id <= 0 {
+		return nil, domain.ErrInvalidID
+	}
+
+	set := 0
+	for _, ok := range []bool{target.Revision > 0, target.SymbolID > 0, target.Proposed != nil} {
+		if ok {
+			set++
+		}
+	}
+	if set != 1 {
+		return nil, fmt.Errorf("%w: exactly one diff target is required", domain.ErrValidationFailed)
+	}
+
+	base, err := uc.symbolState(ctx, id, baseRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	var other *domain.Symbol
+	switch {
+	case target.Revision > 0:
+		other, err = uc.symbolState(ctx, id, target.Revision)
+		if err != nil {
+			return nil, err
+		}
+	case target.SymbolID > 0:
+		other, err = uc.symbolState(ctx, target.SymbolID, 0)
+		if err != nil {
+			return nil, err
+		}
+		if other.Project != base.Project {
+			return nil, fmt.Errorf("%w: symbol %d belongs to another project", domain.ErrValidationFailed, target.SymbolID)
+		}
+	default:
+		proposed := *target.Proposed
+		proposed.Project = base.Project
+		proposed.UID = base.UID
+		other = &proposed
+	}
+
+	return diffSymbolStates(base, other), nil
+}
+
+// symbolState returns the current Symbol, or the given revision of it.
+func (uc *useCase) symbolState(ctx context.Context, id uint64, revision uint32) (*domain.Symbol, error) {
+	if revision == 0 {
+		symbol, err := uc.repo.FindByID(ctx, id)
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("Failed to get symbol: %v", err)
+			return nil, toDomainError(err)
+		}
+		return symbol, nil
+	}
+
+	rev, err := uc.revisions.Find(ctx, id, revision)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, fmt.Errorf("%w: symbol %d has no revision %d", domain.ErrRevisionNotFound, id, revision)
+		}
+		uc.log.WithContext(ctx).Errorf("Failed to get symbol revision: %v", err)
+		return nil, toDomainError(err)
+	}
+
+	return rev.Symbol, nil
+}
+
+// diffSymbolStates compares the metadata of two symbols field by field and their data structurally.
+func diffSymbolStates(before, after *domain.Symbol) *domain.SymbolDiff {
+	changes := slices.DeleteFunc(diffSymbols(before, after), func(c domain.FieldChange) bool {
+		return c.Field == "data"
+	})
+
+	return &domain.SymbolDiff{
+		Changes: changes,
+		Data:    diffData(symbolDataBytes(before), symbolDataBytes(after)),
+	}
+}
+
+func symbolDataBytes(s *domain.Symbol) []byte {
+	if s == nil || s.Data == nil || s.Data.Data == nil {
+		return nil
+	}
+	return *s.Data.Data
+}
+
+// diffData describes the change between two data payloads: a JSON Patch when both are JSON,
+// a byte-level summary otherwise.
+func diffData(before, after []byte) domain.DataDiff {
+	oldDoc, oldErr := decodeJSON(before)
+	newDoc, newErr := decodeJSON(after)
+	if oldErr != nil || newErr != nil {
+		return domain.DataDiff{
+			Changed: !bytes.Equal(before, after),
+			Bytes:   summarizeBytes(before, after),
+		}
+	}
+
+	patch := jsonPatch("", oldDoc, newDoc, nil)
+
+	return domain.DataDiff{Changed: len(patch) > 0, Patch: patch}
+}
+
+// decodeJSON decodes a single JSON document, keeping numbers in their textual form.
+func decodeJSON(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, errors.New("trailing data after JSON document")
+	}
+
+	return doc, nil
+}
+
+// jsonPatch appends the RFC 6902 operations turning before into after at the given JSON Pointer.
+// Objects are compared key by key and arrays index by index; anything else is replaced whole.
+func jsonPatch(path string, before, after any, ops []domain.JSONPatchOperation) []domain.JSONPatchOperation {
+	switch b := before.(type) {
+	case map[string]any:
+		a, ok := after.(map[string]any)
+		if !ok {
+			break
+		}
+
+		keys := slices.Collect(maps.Keys(b))
+		for key := range a {
+			if _, ok := b[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			child := path + "/" + escapePointer(key)
+			oldVal, inOld := b[key]
+			newVal, inNew := a[key]
+			switch {
+			case !inNew:
+				ops = append(ops, domain.JSONPatchOperation{Op: "remove", Path: child})
+			case !inOld:
+				ops = append(ops, domain.JSONPatchOperation{Op: "add", Path: child, Value: mustMarshal(newVal)})
+			default:
+				ops = jsonPatch(child, oldVal, newVal, ops)
+			}
+		}
+		return ops
+
+	case []any:
+		a, ok := after.([]any)
+		if !ok {
+			break
+		}
+
+		common := min(len(b), len(a))
+		for i := 0; i < common; i++ {
+			ops = jsonPatch(path+"/"+strconv.Itoa(i), b[i], a[i], ops)
+		}
+		// Remove from the end so that the remaining indexes stay valid
+		for i := len(b) - 1; i >= common; i-- {
+			ops = append(ops, domain.JSONPatchOperation{Op: "remove", Path: path + "/" + strconv.Itoa(i)})
+		}
+		for i := common; i < len(a); i++ {
+			ops = append(ops, domain.JSONPatchOperation{Op: "add", Path: path + "/" + strconv.Itoa(i), Value: mustMarshal(a[i])})
+		}
+		return ops
+	}
+
+	if reflect.DeepEqual(before, after) {
+		return ops
+	}
+
+	return append(ops, domain.JSONPatchOperation{Op: "replace", Path: path, Value: mustMarshal(after)})
+}
+
+// escapePointer escapes a key for use as a JSON Pointer reference token (RFC 6901).
+func escapePointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+// mustMarshal encodes a value produced by decodeJSON, which cannot fail.
+func mustMarshal(v any) json.RawMessage {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf("marshal decoded JSON: %v", err))
+	}
+	return raw
+}
+
+// summarizeBytes describes a change of opaque data by sizes, digests and the shared prefix and suffix.
+func summarizeBytes(before, after []byte) *domain.ByteSummary {
+	oldSum, newSum := sha256.Sum256(before), sha256.Sum256(after)
+
+	prefix := 0
+	for prefix < len(before) && prefix < len(after) && before[prefix] == after[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(before)-prefix && suffix < len(after)-prefix &&
+		before[len(before)-1-suffix] == after[len(after)-1-suffix] {
+		suffix++
+	}
+
+	return &domain.ByteSummary{
+		OldSize:      len(before),
+		NewSize:      len(after),
+		OldSHA256:    hex.EncodeToString(oldSum[:]),
+		NewSHA256:    hex.EncodeToString(newSum[:]),
+		CommonPrefix: prefix,
+		CommonSuffix: suffix,
+	}
+}
@@ -0,0 +1,329 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"symbols/internal/biz/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func symbolWithData(data string) *domain.Symbol {
+	s := validSymbol()
+	s.ID = 1
+	b := []byte(data)
+	s.Data = &domain.SymbolData{Project: 1, Data: &b}
+	return s
+}
+
+func TestDiffData_JSONPatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		before string
+		after  string
+		want   []domain.JSONPatchOperation
+	}{
+		{
+			name:   "unchanged",
+			before: `{"a": 1, "b": [1, 2]}`,
+			after:  `{"b":[1,2],"a":1}`,
+			want:   nil,
+		},
+		{
+			name:   "object members",
+			before: `{"keep": 1, "drop": true, "change": "x"}`,
+			after:  `{"keep": 1, "change": "y", "new": {"n": 1}}`,
+			want: []domain.JSONPatchOperation{
+				{Op: "replace", Path: "/change", Value: json.RawMessage(`"y"`)},
+				{Op: "remove", Path: "/drop"},
+				{Op: "add", Path: "/new", Value: json.RawMessage(`{"n":1}`)},
+			},
+		},
+		{
+			name:   "nested paths are escaped",
+			before: `{"a/b": {"c~d": 1}}`,
+			after:  `{"a/b": {"c~d": 2}}`,
+			want: []domain.JSONPatchOperation{
+				{Op: "replace", Path: "/a~1b/c~0d", Value: json.RawMessage(`2`)},
+			},
+		},
+		{
+			name:   "array grows",
+			before: `{"items": [1, 2]}`,
+			after:  `{"items": [1, 3, 4, 5]}`,
+			want: []domain.JSONPatchOperation{
+				{Op: "replace", Path: "/items/1", Value: json.RawMessage(`3`)},
+				{Op: "add", Path: "/items/2", Value: json.RawMessage(`4`)},
+				{Op: "add", Path: "/items/3", Value: json.RawMessage(`5`)},
+			},
+		},
+		{
+			name:   "array shrinks from the end",
+			before: `[1, 2, 3, 4]`,
+			after:  `[1]`,
+			want: []domain.JSONPatchOperation{
+				{Op: "remove", Path: "/3"},
+				{Op: "remove", Path: "/2"},
+				{Op: "remove", Path: "/1"},
+			},
+		},
+		{
+			name:   "type change replaces the value",
+			before: `{"v": [1]}`,
+			after:  `{"v": {"x": 1}}`,
+			want: []domain.JSONPatchOperation{
+				{Op: "replace", Path: "/v", Value: json.RawMessage(`{"x":1}`)},
+			},
+		},
+		{
+			name:   "root replaced",
+			before: `[1]`,
+			after:  `"text"`,
+			want: []domain.JSONPatchOperation{
+				{Op: "replace", Path: "", Value: json.RawMessage(`"text"`)},
+			},
+		},
+		{
+			name:   "numbers keep their precision",
+			before: `{"id": 12345678901234567890}`,
+			after:  `{"id": 12345678901234567891}`,
+			want: []domain.JSONPatchOperation{
+				{Op: "replace", Path: "/id", Value: json.RawMessage(`12345678901234567891`)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := diffData([]byte(tt.before), []byte(tt.after))
+
+			assert.Equal(t, len(tt.want) > 0, diff.Changed)
+			assert.Equal(t, tt.want, diff.Patch)
+			assert.Nil(t, diff.Bytes)
+		})
+	}
+}
+
+func TestDiffData_ByteSummary(t *testing.T) {
+	tests := []struct {
+		name        string
+		before      []byte
+		after       []byte
+		wantChanged bool
+		wantPrefix  int
+		wantSuffix  int
+	}{
+		{name: "binary change", before: []byte{0x00, 0x01, 0x02, 0x03}, after: []byte{0x00, 0xff, 0x03}, wantChanged: true, wantPrefix: 1, wantSuffix: 1},
+		{name: "one side is json", before: []byte(`{"a":1}`), after: []byte(`{"a":1`), wantChanged: true, wantPrefix: 6},
+		{name: "trailing data is not json", before: []byte(`{} {}`), after: []byte(`{} {}`), wantPrefix: 5},
+		{name: "prefix and suffix do not overlap", before: []byte("aaa"), after: []byte("aaaa"), wantChanged: true, wantPrefix: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := diffData(tt.before, tt.after)
+
+			require.NotNil(t, diff.Bytes)
+			assert.Nil(t, diff.Patch)
+			assert.Equal(t, tt.wantChanged, diff.Changed)
+			assert.Equal(t, len(tt.before), diff.Bytes.OldSize)
+			assert.Equal(t, len(tt.after), diff.Bytes.NewSize)
+			assert.Len(t, diff.Bytes.OldSHA256, 64)
+			assert.Equal(t, tt.wantChanged, diff.Bytes.OldSHA256 != diff.Bytes.NewSHA256)
+			assert.Equal(t, tt.wantPrefix, diff.Bytes.CommonPrefix)
+			assert.Equal(t, tt.wantSuffix, diff.Bytes.CommonSuffix)
+		})
+	}
+}
+
+func TestDiffSymbol(t *testing.T) {
+	t.Run("against another revision", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		old := symbolWithData(`{"title": "old"}`)
+		old.Label = "Old"
+		deps.repo.On("FindByID", ctx, uint64(1)).Return(symbolWithData(`{"title": "new"}`), nil)
+		deps.revisions.On("Find", ctx, uint64(1), uint32(1)).
+			Return(&domain.SymbolRevision{Revision: 1, Symbol: old, CreatedAt: time.Now()}, nil)
+
+		diff, err := deps.uc.DiffSymbol(ctx, 1, 0, domain.DiffTarget{Revision: 1})
+
+		require.NoError(t, err)
+		assert.Equal(t, []domain.FieldChange{{Field: "label", OldValue: "Test Symbol", NewValue: "Old"}}, diff.Changes)
+		assert.Equal(t, []domain.JSONPatchOperation{
+			{Op: "replace", Path: "/title", Value: json.RawMessage(`"old"`)},
+		}, diff.Data.Patch)
+	})
+
+	t.Run("against another symbol", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		other := symbolWithData(`{"title": "x"}`)
+		other.ID = 2
+		other.UID = refUID1
+		deps.revisions.On("Find", ctx, uint64(1), uint32(2)).
+			Return(&domain.SymbolRevision{Revision: 2, Symbol: symbolWithData(`{"title": "x"}`)}, nil)
+		deps.repo.On("FindByID", ctx, uint64(2)).Return(other, nil)
+
+		diff, err := deps.uc.DiffSymbol(ctx, 1, 2, domain.DiffTarget{SymbolID: 2})
+
+		require.NoError(t, err)
+		assert.Equal(t, []domain.FieldChange{{Field: "uid", OldValue: validSymbol().UID, NewValue: refUID1}}, diff.Changes)
+		assert.False(t, diff.Data.Changed)
+	})
+
+	t.Run("against a symbol of another project", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		other := symbolWithData(`{"title": "x"}`)
+		other.ID = 2
+		other.Project = 2
+		deps.repo.On("FindByID", ctx, uint64(1)).Return(symbolWithData(`{"title": "x"}`), nil)
+		deps.repo.On("FindByID", ctx, uint64(2)).Return(other, nil)
+
+		_, err := deps.uc.DiffSymbol(ctx, 1, 0, domain.DiffTarget{SymbolID: 2})
+
+		assert.ErrorIs(t, err, domain.ErrValidationFailed)
+	})
+
+	t.Run("against a proposed payload", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		deps.repo.On("FindByID", ctx, uint64(1)).Return(symbolWithData("binary\x00"), nil)
+		proposed := symbolWithData("binary\x01")
+		proposed.Project = 0
+		proposed.UID = ""
+		proposed.Version = 2
+
+		diff, err := deps.uc.DiffSymbol(ctx, 1, 0, domain.DiffTarget{Proposed: proposed})
+
+		require.NoError(t, err)
+		assert.Equal(t, []domain.FieldChange{{Field: "version", OldValue: "1", NewValue: "2"}}, diff.Changes,
+			"project and uid are taken from the base symbol")
+		assert.True(t, diff.Data.Changed)
+		require.NotNil(t, diff.Data.Bytes)
+		assert.Equal(t, 6, diff.Data.Bytes.CommonPrefix)
+	})
+
+	t.Run("revision not found", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		deps.repo.On("FindByID", ctx, uint64(1)).Return(symbolWithData(`{}`), nil)
+		deps.revisions.On("Find", ctx, uint64(1), uint32(9)).Return(nil, domain.ErrDataNotFound)
+
+		_, err := deps.uc.DiffSymbol(ctx, 1, 0, domain.DiffTarget{Revision: 9})
+
+		assert.ErrorIs(t, err, domain.ErrRevisionNotFound)
+	})
+
+	t.Run("symbol not found", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		deps.repo.On("FindByID", ctx, uint64(1)).Return(nil, domain.ErrDataNotFound)
+
+		_, err := deps.uc.DiffSymbol(ctx, 1, 0, domain.DiffTarget{SymbolID: 2})
+
+		assert.ErrorIs(t, err, domain.ErrSymbolNotFound)
+	})
+
+	t.Run("target required", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+
+		_, err := deps.uc.DiffSymbol(context.Background(), 1, 0, domain.DiffTarget{})
+
+		assert.ErrorIs(t, err, domain.ErrValidationFailed)
+	})
+
+	t.Run("single target", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+
+		_, err := deps.uc.DiffSymbol(context.Background(), 1, 0, domain.DiffTarget{Revision: 1, SymbolID: 2})
+
+		assert.ErrorIs(t, err, domain.ErrValidationFailed)
+	})
+}
+
+func TestListSymbolRevisions(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		revisions := []*domain.SymbolRevision{{Revision: 1, Symbol: validSymbol()}}
+		deps.repo.On("FindByID", ctx, uint64(1)).Return(symbolWithData(`{}`), nil)
+		deps.revisions.On("List", ctx, uint64(1)).Return(revisions, nil)
+
+		result, err := deps.uc.ListSymbolRevisions(ctx, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, revisions, result)
+	})
+
+	t.Run("symbol not found", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		deps.repo.On("FindByID", ctx, uint64(1)).Return(nil, domain.ErrDataNotFound)
+
+		_, err := deps.uc.ListSymbolRevisions(ctx, 1)
+
+		assert.ErrorIs(t, err, domain.ErrSymbolNotFound)
+		deps.revisions.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+	})
+}
+
+func TestWritesRecordRevisions(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		created := symbolWithData(`{}`)
+		deps.repo.On("Create", ctx, mock.AnythingOfType("*domain.Symbol")).Return(created, nil)
+		deps.pub.On("PublishSymbolCreated", ctx, created).Return(nil)
+
+		_, err := deps.uc.CreateSymbol(ctx, validSymbol())
+
+		require.NoError(t, err)
+		deps.revisions.AssertCalled(t, "Append", ctx, created)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		symbol := symbolWithData(`{}`)
+		deps.repo.On("FindByIDForUpdate", ctx, uint64(1)).Return(symbol, nil)
+		deps.repo.On("Update", ctx, symbol).Return(symbol, nil)
+		deps.pub.On("PublishSymbolUpdated", ctx, symbol).Return(nil)
+
+		_, err := deps.uc.UpdateSymbol(ctx, symbol)
+
+		require.NoError(t, err)
+		deps.revisions.AssertCalled(t, "Append", ctx, symbol)
+	})
+
+	t.Run("failed snapshot rolls back the update", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		symbol := symbolWithData(`{}`)
+		deps.revisions.ExpectedCalls = nil
+		deps.revisions.On("Append", ctx, symbol).Return(uint32(0), domain.ErrDataDatabase)
+		deps.repo.On("FindByIDForUpdate", ctx, uint64(1)).Return(symbol, nil)
+		deps.repo.On("Update", ctx, symbol).Return(symbol, nil)
+
+		_, err := deps.uc.UpdateSymbol(ctx, symbol)
+
+		assert.ErrorIs(t, err, domain.ErrDatabaseOperation)
+		deps.pub.AssertNotCalled(t, "PublishSymbolUpdated", mock.Anything, mock.Anything)
+	})
+}
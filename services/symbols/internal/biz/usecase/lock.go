@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"platform/middleware"
+	"symbols/internal/biz/domain"
+	"time"
+)
+
+// AcquireSymbolLock takes an editing lease on a Symbol for the calling user session.
+// Re-acquiring a lease the session already holds refreshes it.
+func (uc *useCase) AcquireSymbolLock(ctx context.Context, id uint64, ttl time.Duration) (*domain.SymbolLock, error) {
+	if id <= 0 {
+		return nil, domain.ErrInvalidID
+	}
+
+	caller, err := lockCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl, err = lockTTL(ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	var lock *domain.SymbolLock
+	err = uc.tm.InTx(ctx, func(ctx context.Context) error {
+		// The symbol row lock serialises the lease with the writes checking it in UpdateSymbol
+		symbol, err := uc.repo.FindByIDForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		lock = &domain.SymbolLock{
+			SymbolID:   symbol.ID,
+			ProjectID:  symbol.Project,
+			Holder:     caller.Actor,
+			SessionID:  caller.SessionID,
+			AcquiredAt: now,
+			ExpiresAt:  now.Add(ttl),
+		}
+
+		current, acquired, err := uc.locks.Acquire(ctx, lock, now)
+		if err != nil {
+			return err
+		}
+		if !acquired {
+			return lockedError(current)
+		}
+
+		return uc.pub.PublishSymbolLocked(ctx, symbol, lock)
+	})
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to acquire symbol lock: %v", err)
+		return nil, toDomainError(err)
+	}
+
+	return lock, nil
+}
+
+// RenewSymbolLock extends the lease held by the calling user session.
+func (uc *useCase) RenewSymbolLock(ctx context.Context, id uint64, ttl time.Duration) (*domain.SymbolLock, error) {
+	if id <= 0 {
+		return nil, domain.ErrInvalidID
+	}
+
+	caller, err := lockCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl, err = lockTTL(ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := uc.locks.Find(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, domain.ErrLockNotHeld
+		}
+		uc.log.WithContext(ctx).Errorf("Failed to find symbol lock: %v", err)
+		return nil, toDomainError(err)
+	}
+
+	now := time.Now()
+	if !lock.Live(now) || !lock.HeldBy(caller.Actor, caller.SessionID) {
+		return nil, domain.ErrLockNotHeld
+	}
+
+	lock.ExpiresAt = now.Add(ttl)
+	if err := uc.locks.Renew(ctx, lock, now); err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil, domain.ErrLockNotHeld
+		}
+		uc.log.WithContext(ctx).Errorf("Failed to renew symbol lock: %v", err)
+		return nil, toDomainError(err)
+	}
+
+	return lock, nil
+}
+
+// ReleaseSymbolLock ends the lease held by the calling user session.
+// With force, an admin may break a lease held by someone else. The admin role comes from the
+// caller headers, which only the gateway in front of the service may set.
+func (uc *useCase) ReleaseSymbolLock(ctx context.Context, id uint64, force bool) error {
+	if id <= 0 {
+		return domain.ErrInvalidID
+	}
+
+	caller, err := lockCaller(ctx)
+	if err != nil {
+		return err
+	}
+
+	if force && !caller.HasRole(middleware.AdminRole) {
+		return fmt.Errorf("%w: only admins can break a lock", domain.ErrLockForbidden)
+	}
+
+	lock, err := uc.locks.Find(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return domain.ErrLockNotHeld
+		}
+		uc.log.WithContext(ctx).Errorf("Failed to find symbol lock: %v", err)
+		return toDomainError(err)
+	}
+
+	held := lock.HeldBy(caller.Actor, caller.SessionID)
+	if !held && !force {
+		return domain.ErrLockNotHeld
+	}
+
+	symbol, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to get symbol: %v", err)
+		return toDomainError(err)
+	}
+
+	err = uc.tm.InTx(ctx, func(ctx context.Context) error {
+		// Only the lease checked above is removed, not one acquired since
+		if err := uc.locks.Delete(ctx, lock); err != nil {
+			if errors.Is(err, domain.ErrDataNotFound) {
+				return domain.ErrLockNotHeld
+			}
+			return err
+		}
+
+		return uc.pub.PublishSymbolUnlocked(ctx, symbol, lock, caller.Actor, !held)
+	})
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to release symbol lock: %v", err)
+		return toDomainError(err)
+	}
+
+	if !held {
+		uc.log.WithContext(ctx).Infof("Symbol %d lock of %s broken by %s", id, lock.Holder, caller.Actor)
+	}
+
+	return nil
+}
+
+// ensureLockHolder fails with ErrSymbolLocked when another user session holds a live lease on the Symbol.
+// Symbols without a live lease can be written by anyone. The caller must hold the symbol row lock, which
+// AcquireSymbolLock takes as well, so no lease is acquired between the check and the write.
+func (uc *useCase) ensureLockHolder(ctx context.Context, id uint64) error {
+	lock, err := uc.locks.Find(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrDataNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if !lock.Live(time.Now()) {
+		return nil
+	}
+
+	caller, _ := middleware.CallerFromContext(ctx)
+	if lock.HeldBy(caller.Actor, caller.SessionID) {
+		return nil
+	}
+
+	return lockedError(lock)
+}
+
+// lockCaller returns the caller of a lock operation; leases are only granted to identified users.
+func lockCaller(ctx context.Context) (middleware.Caller, error) {
+	caller, ok := middleware.CallerFromContext(ctx)
+	if !ok || caller.Actor == "" || caller.Actor == middleware.AnonymousActor {
+		return middleware.Caller{}, fmt.Errorf("%w: an identified user is required", domain.ErrLockForbidden)
+	}
+	return caller, nil
+}
+
+// lockTTL applies the default lease duration and enforces the maximum.
+func lockTTL(ttl time.Duration) (time.Duration, error) {
+	switch {
+	case ttl == 0:
+		return domain.DefaultLockTTL, nil
+	case ttl < 0 || ttl > domain.MaxLockTTL:
+		return 0, fmt.Errorf("%w: lock ttl must be between 0 and %s", domain.ErrValidationFailed, domain.MaxLockTTL)
+	default:
+		return ttl, nil
+	}
+}
+
+func lockedError(lock *domain.SymbolLock) error {
+	return fmt.Errorf("%w: held by %s until %s", domain.ErrSymbolLocked, lock.Holder, lock.ExpiresAt.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,281 @@
+package usecase
+
+import (
+	"context"
+	"platform/middleware"
+	"symbols/internal/biz/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	editor = middleware.Caller{Actor: "user-1", SessionID: "sess-1"}
+	other  = middleware.Caller{Actor: "user-2", SessionID: "sess-2"}
+	admin  = middleware.Caller{Actor: "user-3", SessionID: "sess-3", Roles: []string{middleware.AdminRole}}
+)
+
+func lockedSymbol() *domain.Symbol {
+	s := validSymbol()
+	s.ID = 1
+	return s
+}
+
+func liveLock(holder middleware.Caller) *domain.SymbolLock {
+	now := time.Now()
+	return &domain.SymbolLock{
+		SymbolID:   1,
+		ProjectID:  1,
+		Holder:     holder.Actor,
+		SessionID:  holder.SessionID,
+		AcquiredAt: now,
+		ExpiresAt:  now.Add(time.Minute),
+	}
+}
+
+func TestAcquireSymbolLock(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := middleware.WithCaller(context.Background(), editor)
+
+		deps.repo.On("FindByIDForUpdate", ctx, uint64(1)).Return(lockedSymbol(), nil)
+		deps.locks.On("Acquire", ctx, mock.AnythingOfType("*domain.SymbolLock"), mock.AnythingOfType("time.Time")).
+			Return(liveLock(editor), true, nil)
+		deps.pub.On("PublishSymbolLocked", ctx, mock.AnythingOfType("*domain.Symbol"), mock.AnythingOfType("*domain.SymbolLock")).Return(nil)
+
+		lock, err := deps.uc.AcquireSymbolLock(ctx, 1, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", lock.Holder)
+		assert.Equal(t, "sess-1", lock.SessionID)
+		assert.WithinDuration(t, lock.AcquiredAt.Add(domain.DefaultLockTTL), lock.ExpiresAt, time.Millisecond)
+		deps.pub.AssertExpectations(t)
+	})
+
+	t.Run("held by another session", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := middleware.WithCaller(context.Background(), editor)
+
+		deps.repo.On("FindByIDForUpdate", ctx, uint64(1)).Return(lockedSymbol(), nil)
+		deps.locks.On("Acquire", ctx, mock.AnythingOfType("*domain.SymbolLock"), mock.AnythingOfType("time.Time")).
+			Return(liveLock(other), false, nil)
+
+		_, err := deps.uc.AcquireSymbolLock(ctx, 1, time.Minute)
+
+		assert.ErrorIs(t, err, domain.ErrSymbolLocked)
+		assert.Contains(t, err.Error(), "user-2")
+		deps.pub.AssertNotCalled(t, "PublishSymbolLocked", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("anonymous caller", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := middleware.WithCaller(context.Background(), middleware.Caller{Actor: middleware.AnonymousActor})
+
+		_, err := deps.uc.AcquireSymbolLock(ctx, 1, 0)
+
+		assert.ErrorIs(t, err, domain.ErrLockForbidden)
+	})
+
+	t.Run("ttl too long", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := middleware.WithCaller(context.Background(), editor)
+
+		_, err := deps.uc.AcquireSymbolLock(ctx, 1, 2*domain.MaxLockTTL)
+
+		assert.ErrorIs(t, err, domain.ErrValidationFailed)
+	})
+
+	t.Run("symbol not found", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := middleware.WithCaller(context.Background(), editor)
+
+		deps.repo.On("FindByIDForUpdate", ctx, uint64(1)).Return(nil, domain.ErrDataNotFound)
+
+		_, err := deps.uc.AcquireSymbolLock(ctx, 1, 0)
+
+		assert.ErrorIs(t, err, domain.ErrSymbolNotFound)
+	})
+}
+
+func TestRenewSymbolLock(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := middleware.WithCaller(context.Background(), editor)
+
+		deps.locks.ExpectedCalls = nil
+		deps.locks.On("Find", ctx, uint64(1)).Return(liveLock(editor), nil)
+		deps.locks.On("Renew", ctx, mock.AnythingOfType("*domain.SymbolLock"), mock.AnythingOfType("time.Time")).Return(nil)
+
+		lock, err := deps.uc.RenewSymbolLock(ctx, 1, 10*time.Minute)
+
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(10*time.Minute), lock.ExpiresAt, time.Second)
+	})
+
+	t.Run("held by another session", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := middleware.WithCaller(context.Background(), editor)
+
+		deps.locks.ExpectedCalls = nil
+		deps.locks.On("Find", ctx, uint64(1)).Return(liveLock(other), nil)
+
+		_, err := deps.uc.RenewSymbolLock(ctx, 1, 0)
+
+		assert.ErrorIs(t, err, domain.ErrLockNotHeld)
+		deps.locks.AssertNotCalled(t, "Renew", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expired lease", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := middleware.WithCaller(context.Background(), editor)
+
+		expired := liveLock(editor)
+		expired.ExpiresAt = time.Now().Add(-time.Second)
+		deps.locks.ExpectedCalls = nil
+		deps.locks.On("Find", ctx, uint64(1)).Return(expired, nil)
+
+		_, err := deps.uc.RenewSymbolLock(ctx, 1, 0)
+
+		assert.ErrorIs(t, err, domain.ErrLockNotHeld)
+	})
+
+	t.Run("no lease", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := middleware.WithCaller(context.Background(), editor)
+
+		_, err := deps.uc.RenewSymbolLock(ctx, 1, 0)
+
+		assert.ErrorIs(t, err, domain.ErrLockNotHeld)
+	})
+}
+
+func TestReleaseSymbolLock(t *testing.T) {
+	tests := []struct {
+		name       string
+		caller     middleware.Caller
+		holder     middleware.Caller
+		force      bool
+		wantErr    error
+		wantForced bool
+	}{
+		{name: "holder releases", caller: editor, holder: editor},
+		{name: "other session cannot release", caller: other, holder: editor, wantErr: domain.ErrLockNotHeld},
+		{name: "non admin cannot force", caller: other, holder: editor, force: true, wantErr: domain.ErrLockForbidden},
+		{name: "admin breaks the lock", caller: admin, holder: editor, force: true, wantForced: true},
+		{name: "admin releasing own lock is not forced", caller: admin, holder: admin, force: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps := setupSymbolUseCaseWithDeps()
+			ctx := middleware.WithCaller(context.Background(), tt.caller)
+
+			lock := liveLock(tt.holder)
+			deps.locks.ExpectedCalls = nil
+			deps.locks.On("Find", ctx, uint64(1)).Return(lock, nil).Maybe()
+			deps.locks.On("Delete", ctx, lock).Return(nil).Maybe()
+			deps.repo.On("FindByID", ctx, uint64(1)).Return(lockedSymbol(), nil).Maybe()
+			deps.pub.On("PublishSymbolUnlocked", ctx, mock.AnythingOfType("*domain.Symbol"), lock, tt.caller.Actor, tt.wantForced).Return(nil).Maybe()
+
+			err := deps.uc.ReleaseSymbolLock(ctx, 1, tt.force)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				deps.locks.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+				deps.pub.AssertNotCalled(t, "PublishSymbolUnlocked", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			deps.locks.AssertCalled(t, "Delete", ctx, lock)
+			deps.pub.AssertCalled(t, "PublishSymbolUnlocked", ctx, mock.AnythingOfType("*domain.Symbol"), lock, tt.caller.Actor, tt.wantForced)
+		})
+	}
+}
+
+func TestReleaseSymbolLock_TakenOver(t *testing.T) {
+	deps := setupSymbolUseCaseWithDeps()
+	ctx := middleware.WithCaller(context.Background(), admin)
+
+	// The lease changes hands between the check and the delete
+	lock := liveLock(editor)
+	deps.locks.ExpectedCalls = nil
+	deps.locks.On("Find", ctx, uint64(1)).Return(lock, nil)
+	deps.locks.On("Delete", ctx, lock).Return(domain.ErrDataNotFound)
+	deps.repo.On("FindByID", ctx, uint64(1)).Return(lockedSymbol(), nil)
+
+	err := deps.uc.ReleaseSymbolLock(ctx, 1, true)
+
+	assert.ErrorIs(t, err, domain.ErrLockNotHeld)
+	deps.pub.AssertNotCalled(t, "PublishSymbolUnlocked", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateSymbol_RespectsLock(t *testing.T) {
+	tests := []struct {
+		name    string
+		caller  *middleware.Caller
+		lock    func() *domain.SymbolLock
+		wantErr error
+	}{
+		{
+			name:   "holder may write",
+			caller: &editor,
+			lock:   func() *domain.SymbolLock { return liveLock(editor) },
+		},
+		{
+			name:    "other session is rejected",
+			caller:  &other,
+			lock:    func() *domain.SymbolLock { return liveLock(editor) },
+			wantErr: domain.ErrSymbolLocked,
+		},
+		{
+			name:    "same user in another session is rejected",
+			caller:  &middleware.Caller{Actor: editor.Actor, SessionID: "sess-other"},
+			lock:    func() *domain.SymbolLock { return liveLock(editor) },
+			wantErr: domain.ErrSymbolLocked,
+		},
+		{
+			name:    "caller without identity is rejected",
+			lock:    func() *domain.SymbolLock { return liveLock(editor) },
+			wantErr: domain.ErrSymbolLocked,
+		},
+		{
+			name:   "expired lease does not block",
+			caller: &other,
+			lock: func() *domain.SymbolLock {
+				l := liveLock(editor)
+				l.ExpiresAt = time.Now().Add(-time.Second)
+				return l
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps := setupSymbolUseCaseWithDeps()
+			ctx := context.Background()
+			if tt.caller != nil {
+				ctx = middleware.WithCaller(ctx, *tt.caller)
+			}
+
+			symbol := lockedSymbol()
+			deps.repo.On("FindByIDForUpdate", ctx, uint64(1)).Return(symbol, nil)
+			deps.repo.On("Update", ctx, symbol).Return(symbol, nil).Maybe()
+			deps.pub.On("PublishSymbolUpdated", ctx, symbol).Return(nil).Maybe()
+			deps.locks.ExpectedCalls = nil
+			deps.locks.On("Find", ctx, uint64(1)).Return(tt.lock(), nil)
+
+			_, err := deps.uc.UpdateSymbol(ctx, symbol)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				deps.repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"symbols/internal/biz/domain"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewMaintenanceUseCase creates the use case run by the scheduled maintenance jobs of the worker.
+func NewMaintenanceUseCase(repo domain.MaintenanceRepo, stats domain.ProjectStatsRepo, audit domain.AuditRepo, logger log.Logger) domain.MaintenanceUseCase {
+	return &maintenanceUseCase{
+		repo:      repo,
+		stats:     stats,
+		audit:     audit,
+		batchSize: domain.MaintenanceBatchSize,
+		now:       time.Now,
+		log:       log.NewHelper(logger),
+	}
+}
+
+type maintenanceUseCase struct {
+	repo      domain.MaintenanceRepo
+	stats     domain.ProjectStatsRepo
+	audit     domain.AuditRepo
+	batchSize int
+	now       func() time.Time
+	log       *log.Helper
+}
+
+// PurgeDeletedSymbols removes the Symbols soft-deleted before the given time, one batch at a time,
+// until none is left or ctx is done.
+func (uc *maintenanceUseCase) PurgeDeletedSymbols(ctx context.Context, before time.Time) (int64, error) {
+	var purged int64
+	for {
+		n, err := uc.repo.PurgeDeletedSymbols(ctx, before, uc.batchSize)
+		purged += n
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("Failed to purge deleted symbols: %v", err)
+			return purged, toDomainError(err)
+		}
+		if n < int64(uc.batchSize) {
+			return purged, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return purged, err
+		}
+	}
+}
+
+// CollectOrphanSymbolData removes the symbol data left without Symbol, one batch at a time.
+func (uc *maintenanceUseCase) CollectOrphanSymbolData(ctx context.Context) (int64, error) {
+	var collected int64
+	for {
+		n, err := uc.repo.DeleteOrphanSymbolData(ctx, uc.batchSize)
+		collected += n
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("Failed to delete orphan symbol data: %v", err)
+			return collected, toDomainError(err)
+		}
+		if n < int64(uc.batchSize) {
+			return collected, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return collected, err
+		}
+	}
+}
+
+// RefreshStaleProjectStats recomputes the materialized statistics computed before the given time,
+// in project order. It stops at the first failure; the projects refreshed until then are counted.
+func (uc *maintenanceUseCase) RefreshStaleProjectStats(ctx context.Context, before time.Time) (int, error) {
+	refreshed := 0
+	var afterID uint64
+	for {
+		projects, err := uc.repo.ListStaleStatsProjects(ctx, before, afterID, uc.batchSize)
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("Failed to list stale project stats: %v", err)
+			return refreshed, toDomainError(err)
+		}
+
+		for _, projectID := range projects {
+			if err := ctx.Err(); err != nil {
+				return refreshed, err
+			}
+			if err := uc.stats.Refresh(ctx, projectID, uc.now()); err != nil {
+				uc.log.WithContext(ctx).Errorf("Failed to refresh the stats of project %d: %v", projectID, err)
+				return refreshed, toDomainError(err)
+			}
+			refreshed++
+			afterID = projectID
+		}
+
+		if len(projects) < uc.batchSize {
+			return refreshed, nil
+		}
+	}
+}
+
+// PurgeAuditEvents deletes audit events created before the given time.
+func (uc *maintenanceUseCase) PurgeAuditEvents(ctx context.Context, before time.Time) (int64, error) {
+	n, err := uc.audit.DeleteBefore(ctx, before)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to purge audit events: %v", err)
+		return 0, toDomainError(err)
+	}
+
+	return n, nil
+}
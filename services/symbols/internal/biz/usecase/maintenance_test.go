@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"context"
+	"os"
+	"symbols/internal/biz/domain"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockMaintenanceRepo is a mock implementation of MaintenanceRepo for testing
+type MockMaintenanceRepo struct {
+	mock.Mock
+}
+
+func (m *MockMaintenanceRepo) PurgeDeletedSymbols(ctx context.Context, before time.Time, limit int) (int64, error) {
+	args := m.Called(ctx, before, limit)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockMaintenanceRepo) DeleteOrphanSymbolData(ctx context.Context, limit int) (int64, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockMaintenanceRepo) ListStaleStatsProjects(ctx context.Context, before time.Time, afterProjectID uint64, limit int) ([]uint64, error) {
+	args := m.Called(ctx, before, afterProjectID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]uint64), args.Error(1)
+}
+
+func setupMaintenanceUseCase() (*maintenanceUseCase, *MockMaintenanceRepo, *MockStatsRepo, *MockAuditRepo) {
+	repo := &MockMaintenanceRepo{}
+	stats := &MockStatsRepo{}
+	audit := &MockAuditRepo{}
+	uc := NewMaintenanceUseCase(repo, stats, audit, log.NewStdLogger(os.Stdout)).(*maintenanceUseCase)
+	uc.batchSize = 2
+	return uc, repo, stats, audit
+}
+
+func TestPurgeDeletedSymbols(t *testing.T) {
+	before := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		mockSetup func(*MockMaintenanceRepo, context.Context)
+		want      int64
+		wantErr   error
+	}{
+		{
+			name: "purges batches until one is short",
+			mockSetup: func(repo *MockMaintenanceRepo, ctx context.Context) {
+				repo.On("PurgeDeletedSymbols", ctx, before, 2).Return(int64(2), nil).Twice()
+				repo.On("PurgeDeletedSymbols", ctx, before, 2).Return(int64(1), nil).Once()
+			},
+			want: 5,
+		},
+		{
+			name: "nothing to purge",
+			mockSetup: func(repo *MockMaintenanceRepo, ctx context.Context) {
+				repo.On("PurgeDeletedSymbols", ctx, before, 2).Return(int64(0), nil).Once()
+			},
+		},
+		{
+			name: "repository error",
+			mockSetup: func(repo *MockMaintenanceRepo, ctx context.Context) {
+				repo.On("PurgeDeletedSymbols", ctx, before, 2).Return(int64(2), nil).Once()
+				repo.On("PurgeDeletedSymbols", ctx, before, 2).Return(int64(0), domain.ErrDataDatabase).Once()
+			},
+			want:    2,
+			wantErr: domain.ErrDatabaseOperation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, _, _ := setupMaintenanceUseCase()
+			ctx := context.Background()
+			tt.mockSetup(repo, ctx)
+
+			purged, err := uc.PurgeDeletedSymbols(ctx, before)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, purged)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCollectOrphanSymbolData(t *testing.T) {
+	uc, repo, _, _ := setupMaintenanceUseCase()
+	ctx := context.Background()
+	repo.On("DeleteOrphanSymbolData", ctx, 2).Return(int64(2), nil).Once()
+	repo.On("DeleteOrphanSymbolData", ctx, 2).Return(int64(0), nil).Once()
+
+	collected, err := uc.CollectOrphanSymbolData(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), collected)
+	repo.AssertExpectations(t)
+}
+
+func TestRefreshStaleProjectStats(t *testing.T) {
+	before := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		mockSetup func(*MockMaintenanceRepo, *MockStatsRepo, context.Context)
+		want      int
+		wantErr   error
+	}{
+		{
+			name: "refreshes every stale project in order",
+			mockSetup: func(repo *MockMaintenanceRepo, stats *MockStatsRepo, ctx context.Context) {
+				repo.On("ListStaleStatsProjects", ctx, before, uint64(0), 2).Return([]uint64{3, 5}, nil).Once()
+				repo.On("ListStaleStatsProjects", ctx, before, uint64(5), 2).Return([]uint64{8}, nil).Once()
+				for _, projectID := range []uint64{3, 5, 8} {
+					stats.On("Refresh", ctx, projectID, mock.AnythingOfType("time.Time")).Return(nil).Once()
+				}
+			},
+			want: 3,
+		},
+		{
+			name: "stops at the first failure",
+			mockSetup: func(repo *MockMaintenanceRepo, stats *MockStatsRepo, ctx context.Context) {
+				repo.On("ListStaleStatsProjects", ctx, before, uint64(0), 2).Return([]uint64{3, 5}, nil).Once()
+				stats.On("Refresh", ctx, uint64(3), mock.AnythingOfType("time.Time")).Return(nil).Once()
+				stats.On("Refresh", ctx, uint64(5), mock.AnythingOfType("time.Time")).Return(domain.ErrDataDatabase).Once()
+			},
+			want:    1,
+			wantErr: domain.ErrDatabaseOperation,
+		},
+		{
+			name: "list error",
+			mockSetup: func(repo *MockMaintenanceRepo, stats *MockStatsRepo, ctx context.Context) {
+				repo.On("ListStaleStatsProjects", ctx, before, uint64(0), 2).Return(nil, domain.ErrDataDatabase).Once()
+			},
+			wantErr: domain.ErrDatabaseOperation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, stats, _ := setupMaintenanceUseCase()
+			ctx := context.Background()
+			tt.mockSetup(repo, stats, ctx)
+
+			refreshed, err := uc.RefreshStaleProjectStats(ctx, before)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, refreshed)
+			repo.AssertExpectations(t)
+			stats.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPurgeAuditEvents(t *testing.T) {
+	before := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		uc, _, _, audit := setupMaintenanceUseCase()
+		ctx := context.Background()
+
+		audit.On("DeleteBefore", ctx, before).Return(int64(3), nil)
+
+		n, err := uc.PurgeAuditEvents(ctx, before)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), n)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		uc, _, _, audit := setupMaintenanceUseCase()
+		ctx := context.Background()
+
+		audit.On("DeleteBefore", ctx, before).Return(int64(0), domain.ErrDataDatabase)
+
+		_, err := uc.PurgeAuditEvents(ctx, before)
+
+		assert.ErrorIs(t, err, domain.ErrDatabaseOperation)
+	})
+}
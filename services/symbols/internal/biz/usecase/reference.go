@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"symbols/internal/biz/domain"
+)
+
+// ListSymbolDependents lists the Symbols that reference the given Symbol.
+func (uc *useCase) ListSymbolDependents(ctx context.Context, id uint64) ([]*domain.Symbol, error) {
+	if id <= 0 {
+		return nil, domain.ErrInvalidID
+	}
+
+	symbol, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to get symbol: %v", err)
+		return nil, toDomainError(err)
+	}
+
+	dependents, err := uc.repo.ListDependents(ctx, symbol.Project, symbol.UID)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to list symbol dependents: %v", err)
+		return nil, toDomainError(err)
+	}
+
+	return dependents, nil
+}
+
+// ListSymbolDependencies lists the Symbols referenced by the given Symbol.
+func (uc *useCase) ListSymbolDependencies(ctx context.Context, id uint64) ([]*domain.Symbol, error) {
+	if id <= 0 {
+		return nil, domain.ErrInvalidID
+	}
+
+	symbol, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to get symbol: %v", err)
+		return nil, toDomainError(err)
+	}
+
+	if len(symbol.References) == 0 {
+		return []*domain.Symbol{}, nil
+	}
+
+	dependencies, err := uc.repo.FindByUIDs(ctx, symbol.Project, symbol.References)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to list symbol dependencies: %v", err)
+		return nil, toDomainError(err)
+	}
+
+	return dependencies, nil
+}
+
+// validateReferences checks that every reference of s points to another symbol of the same
+// project and that following the references never leads back to s.
+func (uc *useCase) validateReferences(ctx context.Context, s *domain.Symbol) error {
+	if len(s.References) == 0 {
+		return nil
+	}
+
+	if slices.Contains(s.References, s.UID) {
+		return fmt.Errorf("%w: a symbol cannot reference itself", domain.ErrInvalidReference)
+	}
+
+	// The shared lock keeps the targets from being deleted until the references are stored
+	targets, err := uc.repo.FindByUIDsForShare(ctx, s.Project, s.References)
+	if err != nil {
+		return err
+	}
+
+	if len(targets) != len(s.References) {
+		found := make(map[string]struct{}, len(targets))
+		for _, t := range targets {
+			found[t.UID] = struct{}{}
+		}
+		for _, ref := range s.References {
+			if _, ok := found[ref]; !ok {
+				return fmt.Errorf("%w: symbol %s not found in project %d", domain.ErrInvalidReference, ref, s.Project)
+			}
+		}
+	}
+
+	// Walk the dependency graph breadth-first; reaching s again means a cycle
+	visited := make(map[string]struct{})
+	for len(targets) > 0 {
+		var next []string
+		for _, t := range targets {
+			for _, ref := range t.References {
+				if ref == s.UID {
+					return fmt.Errorf("%w: %s references %s", domain.ErrReferenceCycle, t.UID, s.UID)
+				}
+				if _, ok := visited[ref]; !ok {
+					visited[ref] = struct{}{}
+					next = append(next, ref)
+				}
+			}
+		}
+
+		if len(next) == 0 {
+			break
+		}
+
+		if targets, err = uc.repo.FindByUIDs(ctx, s.Project, next); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// detachDependents removes the references to a deleted symbol. Every dependent is changed like
+// by an update: the change is audited, stored as a revision and published.
+func (uc *useCase) detachDependents(ctx context.Context, deleted *domain.Symbol, dependents []*domain.Symbol) error {
+	if len(dependents) == 0 {
+		return nil
+	}
+
+	if _, err := uc.repo.DeleteReferencesTo(ctx, deleted.Project, deleted.UID); err != nil {
+		return err
+	}
+
+	for _, dep := range dependents {
+		detached, err := uc.repo.FindByID(ctx, dep.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.recordAudit(ctx, domain.AuditOperationUpdate, dep, detached); err != nil {
+			return err
+		}
+
+		if _, err := uc.revisions.Append(ctx, detached); err != nil {
+			return err
+		}
+
+		if err := uc.pub.PublishSymbolUpdated(ctx, detached); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package usecase
+
+import (
+	"context"
+	"symbols/internal/biz/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	refUID1 = "6ba7b810-9dad-41d1-80b4-00c04fd430c8"
+	refUID2 = "6ba7b811-9dad-41d1-80b4-00c04fd430c8"
+)
+
+func referencedSymbol(id uint64, uid string, refs ...string) *domain.Symbol {
+	s := validSymbol()
+	s.ID = id
+	s.UID = uid
+	s.References = refs
+	return s
+}
+
+func TestCreateSymbol_ValidatesReferences(t *testing.T) {
+	tests := []struct {
+		name      string
+		refs      []string
+		mockSetup func(*MockSymbolRepo, context.Context)
+		wantErr   error
+	}{
+		{
+			name: "valid references",
+			refs: []string{refUID1},
+			mockSetup: func(repo *MockSymbolRepo, ctx context.Context) {
+				repo.On("FindByUIDsForShare", ctx, uint64(1), []string{refUID1}).
+					Return([]*domain.Symbol{referencedSymbol(2, refUID1, refUID2)}, nil)
+				repo.On("FindByUIDs", ctx, uint64(1), []string{refUID2}).
+					Return([]*domain.Symbol{referencedSymbol(3, refUID2)}, nil)
+				repo.On("Create", ctx, mock.AnythingOfType("*domain.Symbol")).
+					Return(referencedSymbol(10, validSymbol().UID, refUID1), nil)
+			},
+		},
+		{
+			name:      "self reference",
+			refs:      []string{validSymbol().UID},
+			mockSetup: func(repo *MockSymbolRepo, ctx context.Context) {},
+			wantErr:   domain.ErrInvalidReference,
+		},
+		{
+			name: "missing reference",
+			refs: []string{refUID1, refUID2},
+			mockSetup: func(repo *MockSymbolRepo, ctx context.Context) {
+				repo.On("FindByUIDsForShare", ctx, uint64(1), []string{refUID1, refUID2}).
+					Return([]*domain.Symbol{referencedSymbol(2, refUID1)}, nil)
+			},
+			wantErr: domain.ErrInvalidReference,
+		},
+		{
+			name: "cycle",
+			refs: []string{refUID1},
+			mockSetup: func(repo *MockSymbolRepo, ctx context.Context) {
+				repo.On("FindByUIDsForShare", ctx, uint64(1), []string{refUID1}).
+					Return([]*domain.Symbol{referencedSymbol(2, refUID1, refUID2)}, nil)
+				repo.On("FindByUIDs", ctx, uint64(1), []string{refUID2}).
+					Return([]*domain.Symbol{referencedSymbol(3, refUID2, validSymbol().UID)}, nil)
+			},
+			wantErr: domain.ErrReferenceCycle,
+		},
+		{
+			name: "repository error",
+			refs: []string{refUID1},
+			mockSetup: func(repo *MockSymbolRepo, ctx context.Context) {
+				repo.On("FindByUIDsForShare", ctx, uint64(1), []string{refUID1}).Return(nil, domain.ErrDataDatabase)
+			},
+			wantErr: domain.ErrDatabaseOperation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps := setupSymbolUseCaseWithDeps()
+			ctx := context.Background()
+
+			tt.mockSetup(deps.repo, ctx)
+			deps.pub.On("PublishSymbolCreated", ctx, mock.AnythingOfType("*domain.Symbol")).Return(nil).Maybe()
+
+			symbol := validSymbol()
+			symbol.References = tt.refs
+
+			_, err := deps.uc.CreateSymbol(ctx, symbol)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				deps.repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			deps.repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteSymbol_Dependents(t *testing.T) {
+	target := referencedSymbol(1, refUID1)
+	dependent := referencedSymbol(2, refUID2, refUID1)
+
+	t.Run("blocked while dependents exist", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		deps.repo.On("FindByIDForUpdate", ctx, uint64(1)).Return(target, nil)
+		deps.repo.On("ListDependents", ctx, uint64(1), refUID1).Return([]*domain.Symbol{dependent}, nil)
+
+		err := deps.uc.DeleteSymbol(ctx, 1, false)
+
+		assert.ErrorIs(t, err, domain.ErrSymbolHasDependents)
+		deps.repo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+		deps.pub.AssertNotCalled(t, "PublishSymbolDeleted", mock.Anything, mock.Anything)
+	})
+
+	t.Run("cascade detaches dependents", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		deps.repo.On("FindByIDForUpdate", ctx, uint64(1)).Return(target, nil)
+		deps.repo.On("ListDependents", ctx, uint64(1), refUID1).Return([]*domain.Symbol{dependent}, nil)
+		deps.repo.On("Delete", ctx, uint64(1)).Return(nil)
+		deps.repo.On("DeleteReferencesTo", ctx, uint64(1), refUID1).Return(int64(1), nil)
+		detached := referencedSymbol(2, refUID2)
+		deps.repo.On("FindByID", ctx, uint64(2)).Return(detached, nil)
+		deps.pub.On("PublishSymbolUpdated", ctx, detached).Return(nil)
+		deps.pub.On("PublishSymbolDeleted", ctx, target).Return(nil)
+
+		var recorded []*domain.AuditEvent
+		deps.audit.ExpectedCalls = nil
+		deps.audit.On("Append", ctx, mock.AnythingOfType("*domain.AuditEvent")).
+			Run(func(args mock.Arguments) { recorded = append(recorded, args.Get(1).(*domain.AuditEvent)) }).
+			Return(nil)
+
+		err := deps.uc.DeleteSymbol(ctx, 1, true)
+
+		require.NoError(t, err)
+		require.Len(t, recorded, 2)
+		assert.Equal(t, domain.AuditOperationUpdate, recorded[0].Operation)
+		assert.Equal(t, uint64(2), recorded[0].SymbolID)
+		assert.Equal(t, []domain.FieldChange{{Field: "references", OldValue: refUID1, NewValue: ""}}, recorded[0].Changes)
+		assert.Equal(t, domain.AuditOperationDelete, recorded[1].Operation)
+		assert.Equal(t, []string{refUID1}, dependent.References, "dependent must not be mutated")
+		deps.revisions.AssertCalled(t, "Append", ctx, detached)
+		deps.repo.AssertExpectations(t)
+		deps.pub.AssertExpectations(t)
+	})
+
+	t.Run("cascade rolls back when detaching fails", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		deps.repo.On("FindByIDForUpdate", ctx, uint64(1)).Return(target, nil)
+		deps.repo.On("ListDependents", ctx, uint64(1), refUID1).Return([]*domain.Symbol{dependent}, nil)
+		deps.repo.On("Delete", ctx, uint64(1)).Return(nil)
+		deps.repo.On("DeleteReferencesTo", ctx, uint64(1), refUID1).Return(int64(0), domain.ErrDataDatabase)
+
+		err := deps.uc.DeleteSymbol(ctx, 1, true)
+
+		assert.ErrorIs(t, err, domain.ErrDatabaseOperation)
+		deps.pub.AssertNotCalled(t, "PublishSymbolDeleted", mock.Anything, mock.Anything)
+	})
+}
+
+func TestListSymbolDependents(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		dependents := []*domain.Symbol{referencedSymbol(2, refUID2, refUID1)}
+		deps.repo.On("FindByID", ctx, uint64(1)).Return(referencedSymbol(1, refUID1), nil)
+		deps.repo.On("ListDependents", ctx, uint64(1), refUID1).Return(dependents, nil)
+
+		result, err := deps.uc.ListSymbolDependents(ctx, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, dependents, result)
+	})
+
+	t.Run("zero id", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+
+		_, err := deps.uc.ListSymbolDependents(context.Background(), 0)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidID)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		deps.repo.On("FindByID", ctx, uint64(1)).Return(nil, domain.ErrDataNotFound)
+
+		_, err := deps.uc.ListSymbolDependents(ctx, 1)
+
+		assert.ErrorIs(t, err, domain.ErrSymbolNotFound)
+	})
+}
+
+func TestListSymbolDependencies(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		dependencies := []*domain.Symbol{referencedSymbol(1, refUID1)}
+		deps.repo.On("FindByID", ctx, uint64(2)).Return(referencedSymbol(2, refUID2, refUID1), nil)
+		deps.repo.On("FindByUIDs", ctx, uint64(1), []string{refUID1}).Return(dependencies, nil)
+
+		result, err := deps.uc.ListSymbolDependencies(ctx, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, dependencies, result)
+	})
+
+	t.Run("no references", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		deps.repo.On("FindByID", ctx, uint64(1)).Return(referencedSymbol(1, refUID1), nil)
+
+		result, err := deps.uc.ListSymbolDependencies(ctx, 1)
+
+		require.NoError(t, err)
+		assert.Empty(t, result)
+		deps.repo.AssertNotCalled(t, "FindByUIDs", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
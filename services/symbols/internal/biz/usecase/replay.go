@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"symbols/internal/biz/domain"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewReplayUseCase creates the use case re-publishing Symbol events. pub may be nil for dry runs.
+func NewReplayUseCase(repo domain.SymbolRepo, pub domain.SymbolEventPublisher, logger log.Logger) domain.SymbolReplayUseCase {
+	return &replayUseCase{
+		repo: repo,
+		pub:  pub,
+		log:  log.NewHelper(logger),
+	}
+}
+
+type replayUseCase struct {
+	repo domain.SymbolRepo
+	pub  domain.SymbolEventPublisher
+	log  *log.Helper
+}
+
+// ReplaySymbols publishes a SymbolCreated snapshot of every Symbol matching the options, in ID order.
+// It stops at the first publishing failure; the Symbols replayed until then are counted.
+func (uc *replayUseCase) ReplaySymbols(ctx context.Context, opts domain.ReplayOptions) (int, error) {
+	if opts.Filter.IsZero() {
+		return 0, fmt.Errorf("%w: a project, id range or updated_at window is required", domain.ErrValidationFailed)
+	}
+	if opts.Rate < 0 {
+		return 0, fmt.Errorf("%w: rate must not be negative", domain.ErrValidationFailed)
+	}
+	if !opts.DryRun && uc.pub == nil {
+		return 0, fmt.Errorf("%w: no event publisher configured", domain.ErrValidationFailed)
+	}
+
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = domain.DefaultReplayBatchSize
+	}
+
+	var tick <-chan time.Time
+	if opts.Rate > 0 && !opts.DryRun {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / opts.Rate))
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	replayed := 0
+	var afterID uint64
+	for {
+		symbols, err := uc.repo.ListForReplay(ctx, opts.Filter, afterID, batchSize)
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("Failed to list symbols to replay: %v", err)
+			return replayed, toDomainError(err)
+		}
+
+		for _, s := range symbols {
+			if !opts.DryRun {
+				if tick != nil && replayed > 0 {
+					select {
+					case <-tick:
+					case <-ctx.Done():
+						return replayed, ctx.Err()
+					}
+				}
+
+				if err := uc.pub.ReplaySymbolCreated(ctx, s, opts.RoutingKey); err != nil {
+					uc.log.WithContext(ctx).Errorf("Failed to replay symbol %d: %v", s.ID, err)
+					return replayed, err
+				}
+			}
+
+			replayed++
+			if opts.OnSymbol != nil {
+				opts.OnSymbol(s)
+			}
+		}
+
+		if len(symbols) < batchSize {
+			break
+		}
+		afterID = symbols[len(symbols)-1].ID
+	}
+
+	uc.log.WithContext(ctx).Infof("Replayed %d symbols (dry run: %t)", replayed, opts.DryRun)
+
+	return replayed, nil
+}
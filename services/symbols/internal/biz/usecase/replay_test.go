@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"os"
+	"symbols/internal/biz/domain"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func replaySymbols(ids ...uint64) []*domain.Symbol {
+	symbols := make([]*domain.Symbol, 0, len(ids))
+	for _, id := range ids {
+		symbols = append(symbols, &domain.Symbol{ID: id, Project: 1})
+	}
+	return symbols
+}
+
+func TestReplaySymbols(t *testing.T) {
+	ctx := context.Background()
+	filter := domain.ReplayFilter{ProjectID: 1}
+	errPublish := errors.New("broker down")
+
+	tests := []struct {
+		name         string
+		opts         domain.ReplayOptions
+		setup        func(repo *MockSymbolRepo, pub *MockPublisher)
+		wantReplayed int
+		wantErr      error
+	}{
+		{
+			name: "pages through the matching symbols",
+			opts: domain.ReplayOptions{Filter: filter, BatchSize: 2},
+			setup: func(repo *MockSymbolRepo, pub *MockPublisher) {
+				repo.On("ListForReplay", ctx, filter, uint64(0), 2).Return(replaySymbols(1, 2), nil)
+				repo.On("ListForReplay", ctx, filter, uint64(2), 2).Return(replaySymbols(5), nil)
+				pub.On("ReplaySymbolCreated", ctx, mock.Anything, "").Return(nil).Times(3)
+			},
+			wantReplayed: 3,
+		},
+		{
+			name: "routing key override",
+			opts: domain.ReplayOptions{Filter: filter, RoutingKey: "search.reindex"},
+			setup: func(repo *MockSymbolRepo, pub *MockPublisher) {
+				repo.On("ListForReplay", ctx, filter, uint64(0), domain.DefaultReplayBatchSize).Return(replaySymbols(1), nil)
+				pub.On("ReplaySymbolCreated", ctx, mock.Anything, "search.reindex").Return(nil).Once()
+			},
+			wantReplayed: 1,
+		},
+		{
+			name: "dry run does not publish",
+			opts: domain.ReplayOptions{Filter: filter, DryRun: true},
+			setup: func(repo *MockSymbolRepo, pub *MockPublisher) {
+				repo.On("ListForReplay", ctx, filter, uint64(0), domain.DefaultReplayBatchSize).Return(replaySymbols(1, 2), nil)
+			},
+			wantReplayed: 2,
+		},
+		{
+			name: "stops at the first publishing failure",
+			opts: domain.ReplayOptions{Filter: filter},
+			setup: func(repo *MockSymbolRepo, pub *MockPublisher) {
+				repo.On("ListForReplay", ctx, filter, uint64(0), domain.DefaultReplayBatchSize).Return(replaySymbols(1, 2, 3), nil)
+				pub.On("ReplaySymbolCreated", ctx, mock.MatchedBy(func(s *domain.Symbol) bool { return s.ID == 1 }), "").Return(nil).Once()
+				pub.On("ReplaySymbolCreated", ctx, mock.MatchedBy(func(s *domain.Symbol) bool { return s.ID == 2 }), "").Return(errPublish).Once()
+			},
+			wantReplayed: 1,
+			wantErr:      errPublish,
+		},
+		{
+			name:    "filter is required",
+			opts:    domain.ReplayOptions{},
+			wantErr: domain.ErrValidationFailed,
+		},
+		{
+			name:    "negative rate",
+			opts:    domain.ReplayOptions{Filter: filter, Rate: -1},
+			wantErr: domain.ErrValidationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &MockSymbolRepo{}
+			pub := &MockPublisher{}
+			if tt.setup != nil {
+				tt.setup(repo, pub)
+			}
+			uc := NewReplayUseCase(repo, pub, log.NewStdLogger(os.Stdout))
+
+			var seen []uint64
+			tt.opts.OnSymbol = func(s *domain.Symbol) { seen = append(seen, s.ID) }
+
+			replayed, err := uc.ReplaySymbols(ctx, tt.opts)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantReplayed, replayed)
+			assert.Len(t, seen, tt.wantReplayed)
+			repo.AssertExpectations(t)
+			pub.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReplaySymbols_RateLimit(t *testing.T) {
+	ctx := context.Background()
+	filter := domain.ReplayFilter{ProjectID: 1}
+	repo := &MockSymbolRepo{}
+	pub := &MockPublisher{}
+	repo.On("ListForReplay", ctx, filter, uint64(0), domain.DefaultReplayBatchSize).Return(replaySymbols(1, 2, 3), nil)
+	pub.On("ReplaySymbolCreated", ctx, mock.Anything, "").Return(nil)
+	uc := NewReplayUseCase(repo, pub, log.NewStdLogger(os.Stdout))
+
+	start := time.Now()
+	replayed, err := uc.ReplaySymbols(ctx, domain.ReplayOptions{Filter: filter, Rate: 20})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, replayed)
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond, "two waits of 50ms between three events")
+}
+
+func TestReplaySymbols_DryRunWithoutPublisher(t *testing.T) {
+	ctx := context.Background()
+	filter := domain.ReplayFilter{MinID: 1, MaxID: 2}
+	repo := &MockSymbolRepo{}
+	repo.On("ListForReplay", ctx, filter, uint64(0), domain.DefaultReplayBatchSize).Return(replaySymbols(1, 2), nil)
+	uc := NewReplayUseCase(repo, nil, log.NewStdLogger(os.Stdout))
+
+	replayed, err := uc.ReplaySymbols(ctx, domain.ReplayOptions{Filter: filter, DryRun: true})
+	require.NoError(t, err)
+	assert.Equal(t, 2, replayed)
+
+	_, err = uc.ReplaySymbols(ctx, domain.ReplayOptions{Filter: filter})
+	assert.ErrorIs(t, err, domain.ErrValidationFailed)
+}
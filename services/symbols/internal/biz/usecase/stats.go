@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"symbols/internal/biz/domain"
+	"time"
+)
+
+// GetProjectSymbolStats returns aggregate statistics about the Symbols of a project.
+func (uc *useCase) GetProjectSymbolStats(ctx context.Context, projectID uint64) (*domain.ProjectSymbolStats, error) {
+	if projectID == 0 {
+		return nil, fmt.Errorf("%w: project id is required", domain.ErrValidationFailed)
+	}
+
+	stats, err := uc.stats.Get(ctx, projectID, time.Now())
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to get project stats: %v", err)
+		return nil, toDomainError(err)
+	}
+
+	return stats, nil
+}
+
+// RefreshProjectSymbolStats recomputes the materialized statistics of a project.
+func (uc *useCase) RefreshProjectSymbolStats(ctx context.Context, projectID uint64) error {
+	if projectID == 0 {
+		return fmt.Errorf("%w: project id is required", domain.ErrValidationFailed)
+	}
+
+	if err := uc.stats.Refresh(ctx, projectID, time.Now()); err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to refresh project stats: %v", err)
+		return toDomainError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"symbols/internal/biz/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProjectSymbolStats(t *testing.T) {
+	tests := []struct {
+		name      string
+		projectID uint64
+		mockSetup func(*MockStatsRepo, context.Context)
+		wantErr   error
+	}{
+		{
+			name:      "success",
+			projectID: 1,
+			mockSetup: func(repo *MockStatsRepo, ctx context.Context) {
+				repo.On("Get", ctx, uint64(1), mock.AnythingOfType("time.Time")).
+					Return(&domain.ProjectSymbolStats{ProjectID: 1, TotalSymbols: 2}, nil)
+			},
+		},
+		{
+			name:      "zero project id",
+			projectID: 0,
+			mockSetup: func(repo *MockStatsRepo, ctx context.Context) {},
+			wantErr:   domain.ErrValidationFailed,
+		},
+		{
+			name:      "repository error",
+			projectID: 1,
+			mockSetup: func(repo *MockStatsRepo, ctx context.Context) {
+				repo.On("Get", ctx, uint64(1), mock.AnythingOfType("time.Time")).Return(nil, domain.ErrDataDatabase)
+			},
+			wantErr: domain.ErrDatabaseOperation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps := setupSymbolUseCaseWithDeps()
+			ctx := context.Background()
+			tt.mockSetup(deps.stats, ctx)
+
+			stats, err := deps.uc.GetProjectSymbolStats(ctx, tt.projectID)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, stats)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, uint64(2), stats.TotalSymbols)
+			deps.stats.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRefreshProjectSymbolStats(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+		deps.stats.On("Refresh", ctx, uint64(1), mock.AnythingOfType("time.Time")).Return(nil)
+
+		require.NoError(t, deps.uc.RefreshProjectSymbolStats(ctx, 1))
+		deps.stats.AssertExpectations(t)
+	})
+
+	t.Run("zero project id", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+
+		err := deps.uc.RefreshProjectSymbolStats(context.Background(), 0)
+
+		assert.ErrorIs(t, err, domain.ErrValidationFailed)
+		deps.stats.AssertNotCalled(t, "Refresh", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+		deps.stats.On("Refresh", ctx, uint64(1), mock.AnythingOfType("time.Time")).Return(domain.ErrDataDatabase)
+
+		assert.ErrorIs(t, deps.uc.RefreshProjectSymbolStats(ctx, 1), domain.ErrDatabaseOperation)
+	})
+}
+
+func TestProjectSymbolStats_AverageDataBytes(t *testing.T) {
+	assert.Equal(t, 0.0, (&domain.ProjectSymbolStats{}).AverageDataBytes())
+	assert.Equal(t, 2.5, (&domain.ProjectSymbolStats{TotalSymbols: 2, TotalDataBytes: 5}).AverageDataBytes())
+}
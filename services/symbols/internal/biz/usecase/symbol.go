@@ -16,6 +16,10 @@ import (
 // useCase is a Symbol use case implementation.
 type useCase struct {
 	repo      domain.SymbolRepo
+	audit     domain.AuditRepo
+	revisions domain.SymbolRevisionRepo
+	locks     domain.SymbolLockRepo
+	stats     domain.ProjectStatsRepo
 	pub       domain.SymbolEventPublisher
 	log       *log.Helper
 	validator *validator.Validate
@@ -23,8 +27,8 @@ type useCase struct {
 }
 
 // NewUseCase creates a new Symbol use case.
-func NewUseCase(repo domain.SymbolRepo, v *validator.Validate, tm common.Transaction, pub domain.SymbolEventPublisher, logger log.Logger) domain.SymbolUseCase {
-	return &useCase{repo: repo, validator: v, pub: pub, tm: tm, log: log.NewHelper(logger)}
+func NewUseCase(repo domain.SymbolRepo, audit domain.AuditRepo, revisions domain.SymbolRevisionRepo, locks domain.SymbolLockRepo, stats domain.ProjectStatsRepo, v *validator.Validate, tm common.Transaction, pub domain.SymbolEventPublisher, logger log.Logger) domain.SymbolUseCase {
+	return &useCase{repo: repo, audit: audit, revisions: revisions, locks: locks, stats: stats, validator: v, pub: pub, tm: tm, log: log.NewHelper(logger)}
 }
 
 // GetSymbol gets a Symbol by its ID.
@@ -56,6 +60,10 @@ func (uc *useCase) CreateSymbol(ctx context.Context, g *domain.Symbol) (*domain.
 	var symbol *domain.Symbol
 
 	err := uc.tm.InTx(ctx, func(ctx context.Context) error {
+		if err := uc.validateReferences(ctx, g); err != nil {
+			return err
+		}
+
 		var err error
 		symbol, err = uc.repo.Create(ctx, g)
 
@@ -63,6 +71,14 @@ func (uc *useCase) CreateSymbol(ctx context.Context, g *domain.Symbol) (*domain.
 			return err
 		}
 
+		if err := uc.recordAudit(ctx, domain.AuditOperationCreate, nil, symbol); err != nil {
+			return err
+		}
+
+		if _, err := uc.revisions.Append(ctx, symbol); err != nil {
+			return err
+		}
+
 		return uc.pub.PublishSymbolCreated(ctx, symbol)
 	})
 
@@ -89,13 +105,41 @@ func (uc *useCase) UpdateSymbol(ctx context.Context, g *domain.Symbol) (*domain.
 	var updatedSymbol *domain.Symbol
 	// Proceed to update
 	err := uc.tm.InTx(ctx, func(ctx context.Context) error {
-		var err error
+		// Load the current state for the audit diff. The lock serialises the write with the
+		// lease checked below and with references being added to the symbol.
+		current, err := uc.repo.FindByIDForUpdate(ctx, g.ID)
+		if err != nil {
+			return err
+		}
+
+		// Only the holder of a live editing lease may write a locked symbol
+		if err := uc.ensureLockHolder(ctx, g.ID); err != nil {
+			return err
+		}
+
+		// References point to a symbol by its UID within its project, so they would be orphaned
+		if err := uc.ensureNotReferenced(ctx, current, g); err != nil {
+			return err
+		}
+
+		if err := uc.validateReferences(ctx, g); err != nil {
+			return err
+		}
+
 		updatedSymbol, err = uc.repo.Update(ctx, g)
 
 		if err != nil {
 			return err
 		}
 
+		if err := uc.recordAudit(ctx, domain.AuditOperationUpdate, current, updatedSymbol); err != nil {
+			return err
+		}
+
+		if _, err := uc.revisions.Append(ctx, updatedSymbol); err != nil {
+			return err
+		}
+
 		return uc.pub.PublishSymbolUpdated(ctx, updatedSymbol)
 	})
 
@@ -109,24 +153,39 @@ func (uc *useCase) UpdateSymbol(ctx context.Context, g *domain.Symbol) (*domain.
 }
 
 // DeleteSymbol deletes a Symbol by its ID.
-func (uc *useCase) DeleteSymbol(ctx context.Context, id uint64) error {
+// While other symbols reference it the deletion is refused, unless cascade is set,
+// in which case the references to it are removed as well.
+func (uc *useCase) DeleteSymbol(ctx context.Context, id uint64, cascade bool) error {
 	// Validate ID
 	if id <= 0 {
 		return domain.ErrInvalidID
 	}
 
-	symbol, err := uc.repo.FindByID(ctx, id)
-	if err != nil {
-		uc.log.WithContext(ctx).Errorf("Failed to find symbol: %v", err)
-		return toDomainError(err)
-	}
+	err := uc.tm.InTx(ctx, func(ctx context.Context) error {
+		// The lock keeps references to the symbol from being added until it is deleted
+		symbol, err := uc.repo.FindByIDForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
 
-	// Proceed to delete
-	err = uc.tm.InTx(ctx, func(ctx context.Context) error {
+		dependents, err := uc.repo.ListDependents(ctx, symbol.Project, symbol.UID)
+		if err != nil {
+			return err
+		}
 
-		err := uc.repo.Delete(ctx, id)
+		if len(dependents) > 0 && !cascade {
+			return fmt.Errorf("%w: referenced by %d symbol(s)", domain.ErrSymbolHasDependents, len(dependents))
+		}
 
-		if err != nil {
+		if err := uc.repo.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		if err := uc.detachDependents(ctx, symbol, dependents); err != nil {
+			return err
+		}
+
+		if err := uc.recordAudit(ctx, domain.AuditOperationDelete, symbol, nil); err != nil {
 			return err
 		}
 
@@ -142,6 +201,24 @@ func (uc *useCase) DeleteSymbol(ctx context.Context, id uint64) error {
 	return nil
 }
 
+// ensureNotReferenced refuses to change the UID or project of current, the stored state of g,
+// while other symbols reference it.
+func (uc *useCase) ensureNotReferenced(ctx context.Context, current, g *domain.Symbol) error {
+	if current.UID == g.UID && current.Project == g.Project {
+		return nil
+	}
+
+	dependents, err := uc.repo.ListDependents(ctx, current.Project, current.UID)
+	if err != nil {
+		return err
+	}
+	if len(dependents) > 0 {
+		return fmt.Errorf("%w: cannot change the uid or project while referenced by %d symbol(s)", domain.ErrSymbolHasDependents, len(dependents))
+	}
+
+	return nil
+}
+
 // ListSymbols lists Symbols based on the provided options with pagination metadata.
 func (uc *useCase) ListSymbols(ctx context.Context, opts domain.ListSymbolsOptions) ([]*domain.Symbol, *pagination.Meta, error) {
 	// Validate options
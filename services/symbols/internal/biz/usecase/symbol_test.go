@@ -9,6 +9,7 @@ import (
 	"platform/pagination"
 	"symbols/internal/biz/domain"
 	"testing"
+	"time"
 
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/go-kratos/kratos/v2/log"
@@ -79,6 +80,14 @@ func (m *MockSymbolRepo) FindByID(ctx context.Context, id uint64) (*domain.Symbo
 	return args.Get(0).(*domain.Symbol), args.Error(1)
 }
 
+func (m *MockSymbolRepo) FindByIDForUpdate(ctx context.Context, id uint64) (*domain.Symbol, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Symbol), args.Error(1)
+}
+
 func (m *MockSymbolRepo) ListSymbols(ctx context.Context, opts domain.ListSymbolsOptions) ([]*domain.Symbol, *pagination.Meta, error) {
 	args := m.Called(ctx, opts)
 	if args.Get(0) == nil {
@@ -95,6 +104,175 @@ func (m *MockSymbolRepo) Delete(ctx context.Context, id uint64) error {
 	return args.Error(0)
 }
 
+func (m *MockSymbolRepo) FindByUIDs(ctx context.Context, projectID uint64, uids []string) ([]*domain.Symbol, error) {
+	args := m.Called(ctx, projectID, uids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Symbol), args.Error(1)
+}
+
+func (m *MockSymbolRepo) FindByUIDsForShare(ctx context.Context, projectID uint64, uids []string) ([]*domain.Symbol, error) {
+	args := m.Called(ctx, projectID, uids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Symbol), args.Error(1)
+}
+
+func (m *MockSymbolRepo) ListDependents(ctx context.Context, projectID uint64, uid string) ([]*domain.Symbol, error) {
+	args := m.Called(ctx, projectID, uid)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Symbol), args.Error(1)
+}
+
+func (m *MockSymbolRepo) DeleteReferencesTo(ctx context.Context, projectID uint64, uid string) (int64, error) {
+	args := m.Called(ctx, projectID, uid)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockSymbolRepo) TagKeyFacets(ctx context.Context, projectID uint64) ([]*domain.TagFacet, error) {
+	args := m.Called(ctx, projectID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TagFacet), args.Error(1)
+}
+
+func (m *MockSymbolRepo) TagValueFacets(ctx context.Context, projectID uint64, key string) ([]*domain.TagFacet, error) {
+	args := m.Called(ctx, projectID, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TagFacet), args.Error(1)
+}
+
+func (m *MockSymbolRepo) ListForReplay(ctx context.Context, filter domain.ReplayFilter, afterID uint64, limit int) ([]*domain.Symbol, error) {
+	args := m.Called(ctx, filter, afterID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Symbol), args.Error(1)
+}
+
+// MockAuditRepo is a mock implementation of AuditRepo for testing
+type MockAuditRepo struct {
+	mock.Mock
+}
+
+func (m *MockAuditRepo) Append(ctx context.Context, event *domain.AuditEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockAuditRepo) List(ctx context.Context, opts domain.ListAuditEventsOptions) ([]*domain.AuditEvent, *pagination.Meta, error) {
+	args := m.Called(ctx, opts)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	if args.Get(1) == nil {
+		return args.Get(0).([]*domain.AuditEvent), nil, args.Error(2)
+	}
+	return args.Get(0).([]*domain.AuditEvent), args.Get(1).(*pagination.Meta), args.Error(2)
+}
+
+func (m *MockAuditRepo) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	args := m.Called(ctx, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockPublisher) PublishSymbolLocked(ctx context.Context, symbol *domain.Symbol, lock *domain.SymbolLock) error {
+	args := m.Called(ctx, symbol, lock)
+	return args.Error(0)
+}
+
+func (m *MockPublisher) PublishSymbolUnlocked(ctx context.Context, symbol *domain.Symbol, lock *domain.SymbolLock, releasedBy string, forced bool) error {
+	args := m.Called(ctx, symbol, lock, releasedBy, forced)
+	return args.Error(0)
+}
+
+func (m *MockPublisher) ReplaySymbolCreated(ctx context.Context, symbol *domain.Symbol, routingKey string) error {
+	args := m.Called(ctx, symbol, routingKey)
+	return args.Error(0)
+}
+
+// MockLockRepo is a mock implementation of SymbolLockRepo for testing
+type MockLockRepo struct {
+	mock.Mock
+}
+
+func (m *MockLockRepo) Acquire(ctx context.Context, lock *domain.SymbolLock, now time.Time) (*domain.SymbolLock, bool, error) {
+	args := m.Called(ctx, lock, now)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).(*domain.SymbolLock), args.Bool(1), args.Error(2)
+}
+
+func (m *MockLockRepo) Renew(ctx context.Context, lock *domain.SymbolLock, now time.Time) error {
+	args := m.Called(ctx, lock, now)
+	return args.Error(0)
+}
+
+func (m *MockLockRepo) Find(ctx context.Context, symbolID uint64) (*domain.SymbolLock, error) {
+	args := m.Called(ctx, symbolID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SymbolLock), args.Error(1)
+}
+
+func (m *MockLockRepo) Delete(ctx context.Context, lock *domain.SymbolLock) error {
+	args := m.Called(ctx, lock)
+	return args.Error(0)
+}
+
+// MockRevisionRepo is a mock implementation of SymbolRevisionRepo for testing
+type MockRevisionRepo struct {
+	mock.Mock
+}
+
+func (m *MockRevisionRepo) Append(ctx context.Context, symbol *domain.Symbol) (uint32, error) {
+	args := m.Called(ctx, symbol)
+	return args.Get(0).(uint32), args.Error(1)
+}
+
+func (m *MockRevisionRepo) Find(ctx context.Context, symbolID uint64, revision uint32) (*domain.SymbolRevision, error) {
+	args := m.Called(ctx, symbolID, revision)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SymbolRevision), args.Error(1)
+}
+
+func (m *MockRevisionRepo) List(ctx context.Context, symbolID uint64) ([]*domain.SymbolRevision, error) {
+	args := m.Called(ctx, symbolID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.SymbolRevision), args.Error(1)
+}
+
+// MockStatsRepo is a mock implementation of ProjectStatsRepo for testing
+type MockStatsRepo struct {
+	mock.Mock
+}
+
+func (m *MockStatsRepo) Get(ctx context.Context, projectID uint64, now time.Time) (*domain.ProjectSymbolStats, error) {
+	args := m.Called(ctx, projectID, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ProjectSymbolStats), args.Error(1)
+}
+
+func (m *MockStatsRepo) Refresh(ctx context.Context, projectID uint64, now time.Time) error {
+	args := m.Called(ctx, projectID, now)
+	return args.Error(0)
+}
+
 // MockTransaction is a mock implementation of common.Transaction for testing
 type MockTransaction struct {
 	mock.Mock
@@ -111,10 +289,14 @@ func (m *MockTransaction) InTx(ctx context.Context, fn func(ctx context.Context)
 
 // testDeps holds all mock dependencies for testing
 type testDeps struct {
-	repo *MockSymbolRepo
-	pub  *MockPublisher
-	tx   *MockTransaction
-	uc   domain.SymbolUseCase
+	repo      *MockSymbolRepo
+	audit     *MockAuditRepo
+	revisions *MockRevisionRepo
+	locks     *MockLockRepo
+	stats     *MockStatsRepo
+	pub       *MockPublisher
+	tx        *MockTransaction
+	uc        domain.SymbolUseCase
 }
 
 // setupSymbolUseCaseWithDeps creates a test SymbolUseCase and returns all dependencies for assertions
@@ -122,19 +304,33 @@ func setupSymbolUseCaseWithDeps() *testDeps {
 	logger := log.NewStdLogger(os.Stdout)
 	v := NewValidator()
 	mockRepo := new(MockSymbolRepo)
+	mockAudit := new(MockAuditRepo)
+	mockRevisions := new(MockRevisionRepo)
+	mockLocks := new(MockLockRepo)
+	mockStats := new(MockStatsRepo)
 	mockPub := new(MockPublisher)
 	mockTx := new(MockTransaction)
 
 	// Default transaction behavior - executes the callback
 	mockTx.On("InTx", mock.Anything, mock.Anything).Return(nil).Maybe()
+	// Default audit behavior - records succeed
+	mockAudit.On("Append", mock.Anything, mock.Anything).Return(nil).Maybe()
+	// Default revision behavior - snapshots succeed
+	mockRevisions.On("Append", mock.Anything, mock.Anything).Return(uint32(1), nil).Maybe()
+	// Default lock behavior - symbols are not locked
+	mockLocks.On("Find", mock.Anything, mock.Anything).Return(nil, domain.ErrDataNotFound).Maybe()
 
-	uc := NewUseCase(mockRepo, v, mockTx, mockPub, logger)
+	uc := NewUseCase(mockRepo, mockAudit, mockRevisions, mockLocks, mockStats, v, mockTx, mockPub, logger)
 
 	return &testDeps{
-		repo: mockRepo,
-		pub:  mockPub,
-		tx:   mockTx,
-		uc:   uc,
+		repo:      mockRepo,
+		audit:     mockAudit,
+		revisions: mockRevisions,
+		locks:     mockLocks,
+		stats:     mockStats,
+		pub:       mockPub,
+		tx:        mockTx,
+		uc:        uc,
 	}
 }
 
@@ -142,16 +338,23 @@ func setupSymbolUseCaseWithDeps() *testDeps {
 func setupSymbolUseCase(mockRepo *MockSymbolRepo) domain.SymbolUseCase {
 	logger := log.NewStdLogger(os.Stdout)
 	v := NewValidator()
+	mockAudit := new(MockAuditRepo)
+	mockRevisions := new(MockRevisionRepo)
+	mockLocks := new(MockLockRepo)
+	mockStats := new(MockStatsRepo)
 	mockPub := new(MockPublisher)
 	mockTx := new(MockTransaction)
 
-	// Allow any event publishing calls to succeed by default
+	// Allow any audit and event publishing calls to succeed by default
+	mockAudit.On("Append", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockRevisions.On("Append", mock.Anything, mock.Anything).Return(uint32(1), nil).Maybe()
+	mockLocks.On("Find", mock.Anything, mock.Anything).Return(nil, domain.ErrDataNotFound).Maybe()
 	mockPub.On("PublishSymbolCreated", mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockPub.On("PublishSymbolUpdated", mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockPub.On("PublishSymbolDeleted", mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockTx.On("InTx", mock.Anything, mock.Anything).Return(nil).Maybe()
 
-	return NewUseCase(mockRepo, v, mockTx, mockPub, logger)
+	return NewUseCase(mockRepo, mockAudit, mockRevisions, mockLocks, mockStats, v, mockTx, mockPub, logger)
 }
 
 // Helper function to create a valid Symbol for testing
@@ -504,6 +707,7 @@ func TestUpdateSymbol(t *testing.T) {
 		symbol      *domain.Symbol
 		mockSetup   func(*MockSymbolRepo, context.Context, *domain.Symbol)
 		wantErr     bool
+		errIs       error
 		checkResult func(*testing.T, *domain.Symbol)
 	}{
 		{
@@ -514,6 +718,7 @@ func TestUpdateSymbol(t *testing.T) {
 				return s
 			}(),
 			mockSetup: func(repo *MockSymbolRepo, ctx context.Context, symbol *domain.Symbol) {
+				repo.On("FindByIDForUpdate", ctx, symbol.ID).Return(validSymbol(), nil)
 				repo.On("Update", ctx, mock.AnythingOfType("*domain.Symbol")).Return(symbol, nil)
 			},
 			wantErr: false,
@@ -541,7 +746,7 @@ func TestUpdateSymbol(t *testing.T) {
 				return s
 			}(),
 			mockSetup: func(repo *MockSymbolRepo, ctx context.Context, symbol *domain.Symbol) {
-				repo.On("Update", ctx, mock.AnythingOfType("*domain.Symbol")).Return(nil, domain.ErrDataNotFound)
+				repo.On("FindByIDForUpdate", ctx, symbol.ID).Return(nil, domain.ErrDataNotFound)
 			},
 			wantErr: true,
 		},
@@ -553,10 +758,60 @@ func TestUpdateSymbol(t *testing.T) {
 				return s
 			}(),
 			mockSetup: func(repo *MockSymbolRepo, ctx context.Context, symbol *domain.Symbol) {
+				repo.On("FindByIDForUpdate", ctx, symbol.ID).Return(validSymbol(), nil)
 				repo.On("Update", ctx, mock.AnythingOfType("*domain.Symbol")).Return(nil, fmt.Errorf("%w: connection failed", domain.ErrDataDatabase))
 			},
 			wantErr: true,
 		},
+		{
+			name: "uid change of a referenced symbol",
+			symbol: func() *domain.Symbol {
+				s := validSymbol()
+				s.ID = 1
+				s.UID = "6ba7b810-9dad-41d1-80b4-00c04fd430c8"
+				return s
+			}(),
+			mockSetup: func(repo *MockSymbolRepo, ctx context.Context, symbol *domain.Symbol) {
+				current := validSymbol()
+				repo.On("FindByIDForUpdate", ctx, symbol.ID).Return(current, nil)
+				repo.On("ListDependents", ctx, current.Project, current.UID).Return([]*domain.Symbol{{ID: 2}}, nil)
+			},
+			wantErr: true,
+			errIs:   domain.ErrSymbolHasDependents,
+		},
+		{
+			name: "project change of a referenced symbol",
+			symbol: func() *domain.Symbol {
+				s := validSymbol()
+				s.ID = 1
+				s.Project = 2
+				s.Data.Project = 2
+				return s
+			}(),
+			mockSetup: func(repo *MockSymbolRepo, ctx context.Context, symbol *domain.Symbol) {
+				current := validSymbol()
+				repo.On("FindByIDForUpdate", ctx, symbol.ID).Return(current, nil)
+				repo.On("ListDependents", ctx, current.Project, current.UID).Return([]*domain.Symbol{{ID: 2}}, nil)
+			},
+			wantErr: true,
+			errIs:   domain.ErrSymbolHasDependents,
+		},
+		{
+			name: "uid change of an unreferenced symbol",
+			symbol: func() *domain.Symbol {
+				s := validSymbol()
+				s.ID = 1
+				s.UID = "6ba7b810-9dad-41d1-80b4-00c04fd430c8"
+				return s
+			}(),
+			mockSetup: func(repo *MockSymbolRepo, ctx context.Context, symbol *domain.Symbol) {
+				current := validSymbol()
+				repo.On("FindByIDForUpdate", ctx, symbol.ID).Return(current, nil)
+				repo.On("ListDependents", ctx, current.Project, current.UID).Return([]*domain.Symbol{}, nil)
+				repo.On("Update", ctx, symbol).Return(symbol, nil)
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -572,6 +827,9 @@ func TestUpdateSymbol(t *testing.T) {
 			if tt.wantErr {
 				assert.Error(t, err)
 				assert.Nil(t, result)
+				if tt.errIs != nil {
+					assert.ErrorIs(t, err, tt.errIs)
+				}
 			} else {
 				assert.NoError(t, err)
 				if tt.checkResult != nil {
@@ -598,7 +856,8 @@ func TestDeleteSymbol(t *testing.T) {
 			mockSetup: func(repo *MockSymbolRepo, ctx context.Context, id uint64) {
 				symbol := validSymbol()
 				symbol.ID = id
-				repo.On("FindByID", ctx, id).Return(symbol, nil)
+				repo.On("FindByIDForUpdate", ctx, id).Return(symbol, nil)
+				repo.On("ListDependents", ctx, symbol.Project, symbol.UID).Return([]*domain.Symbol{}, nil)
 				repo.On("Delete", ctx, id).Return(nil)
 			},
 			wantErr: false,
@@ -614,7 +873,7 @@ func TestDeleteSymbol(t *testing.T) {
 			name:     "not found error on find",
 			symbolID: 999,
 			mockSetup: func(repo *MockSymbolRepo, ctx context.Context, id uint64) {
-				repo.On("FindByID", ctx, id).Return(nil, domain.ErrDataNotFound)
+				repo.On("FindByIDForUpdate", ctx, id).Return(nil, domain.ErrDataNotFound)
 			},
 			wantErr: true,
 		},
@@ -624,7 +883,8 @@ func TestDeleteSymbol(t *testing.T) {
 			mockSetup: func(repo *MockSymbolRepo, ctx context.Context, id uint64) {
 				symbol := validSymbol()
 				symbol.ID = id
-				repo.On("FindByID", ctx, id).Return(symbol, nil)
+				repo.On("FindByIDForUpdate", ctx, id).Return(symbol, nil)
+				repo.On("ListDependents", ctx, symbol.Project, symbol.UID).Return([]*domain.Symbol{}, nil)
 				repo.On("Delete", ctx, id).Return(fmt.Errorf("%w: connection failed", domain.ErrDataDatabase))
 			},
 			wantErr: true,
@@ -639,7 +899,7 @@ func TestDeleteSymbol(t *testing.T) {
 
 			tt.mockSetup(mockRepo, ctx, tt.symbolID)
 
-			err := uc.DeleteSymbol(ctx, tt.symbolID)
+			err := uc.DeleteSymbol(ctx, tt.symbolID, false)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -1131,6 +1391,7 @@ func TestUpdateSymbol_PublishesEvent(t *testing.T) {
 			ctx := context.Background()
 
 			// Setup repo mock
+			deps.repo.On("FindByIDForUpdate", ctx, tt.symbol.ID).Return(validSymbol(), nil)
 			deps.repo.On("Update", ctx, mock.AnythingOfType("*domain.Symbol")).Return(tt.repoReturn, nil)
 
 			// Setup publisher mock - capture the published symbol
@@ -1211,7 +1472,8 @@ func TestDeleteSymbol_PublishesEvent(t *testing.T) {
 			ctx := context.Background()
 
 			// Setup repo mocks
-			deps.repo.On("FindByID", ctx, tt.symbolID).Return(tt.foundSymbol, nil)
+			deps.repo.On("FindByIDForUpdate", ctx, tt.symbolID).Return(tt.foundSymbol, nil)
+			deps.repo.On("ListDependents", ctx, tt.foundSymbol.Project, tt.foundSymbol.UID).Return([]*domain.Symbol{}, nil)
 			deps.repo.On("Delete", ctx, tt.symbolID).Return(nil)
 
 			// Setup publisher mock - capture the published symbol
@@ -1222,7 +1484,7 @@ func TestDeleteSymbol_PublishesEvent(t *testing.T) {
 				}).
 				Return(tt.publishErr)
 
-			err := deps.uc.DeleteSymbol(ctx, tt.symbolID)
+			err := deps.uc.DeleteSymbol(ctx, tt.symbolID, false)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -1278,7 +1540,7 @@ func TestEventPublishing_NotCalledOnValidationFailure(t *testing.T) {
 		deps := setupSymbolUseCaseWithDeps()
 		ctx := context.Background()
 
-		err := deps.uc.DeleteSymbol(ctx, 0) // Invalid ID
+		err := deps.uc.DeleteSymbol(ctx, 0, false) // Invalid ID
 
 		assert.Error(t, err)
 		deps.pub.AssertNotCalled(t, "PublishSymbolDeleted", mock.Anything, mock.Anything)
@@ -1306,6 +1568,7 @@ func TestEventPublishing_NotCalledOnRepoFailure(t *testing.T) {
 
 		symbol := validSymbol()
 		symbol.ID = 1
+		deps.repo.On("FindByIDForUpdate", ctx, uint64(1)).Return(validSymbol(), nil)
 		deps.repo.On("Update", ctx, mock.AnythingOfType("*domain.Symbol")).
 			Return(nil, errors.New("database error"))
 
@@ -1319,9 +1582,9 @@ func TestEventPublishing_NotCalledOnRepoFailure(t *testing.T) {
 		deps := setupSymbolUseCaseWithDeps()
 		ctx := context.Background()
 
-		deps.repo.On("FindByID", ctx, uint64(1)).Return(nil, domain.ErrDataNotFound)
+		deps.repo.On("FindByIDForUpdate", ctx, uint64(1)).Return(nil, domain.ErrDataNotFound)
 
-		err := deps.uc.DeleteSymbol(ctx, 1)
+		err := deps.uc.DeleteSymbol(ctx, 1, false)
 
 		assert.Error(t, err)
 		deps.pub.AssertNotCalled(t, "PublishSymbolDeleted", mock.Anything, mock.Anything)
@@ -1333,10 +1596,11 @@ func TestEventPublishing_NotCalledOnRepoFailure(t *testing.T) {
 
 		symbol := validSymbol()
 		symbol.ID = 1
-		deps.repo.On("FindByID", ctx, uint64(1)).Return(symbol, nil)
+		deps.repo.On("FindByIDForUpdate", ctx, uint64(1)).Return(symbol, nil)
+		deps.repo.On("ListDependents", ctx, symbol.Project, symbol.UID).Return([]*domain.Symbol{}, nil)
 		deps.repo.On("Delete", ctx, uint64(1)).Return(errors.New("database error"))
 
-		err := deps.uc.DeleteSymbol(ctx, 1)
+		err := deps.uc.DeleteSymbol(ctx, 1, false)
 
 		assert.Error(t, err)
 		deps.pub.AssertNotCalled(t, "PublishSymbolDeleted", mock.Anything, mock.Anything)
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"symbols/internal/biz/domain"
+)
+
+// ListTagKeys returns the tag keys used in a project with their symbol counts.
+func (uc *useCase) ListTagKeys(ctx context.Context, projectID uint64) ([]*domain.TagFacet, error) {
+	if projectID == 0 {
+		return nil, fmt.Errorf("%w: project id is required", domain.ErrValidationFailed)
+	}
+
+	facets, err := uc.repo.TagKeyFacets(ctx, projectID)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to list tag keys: %v", err)
+		return nil, toDomainError(err)
+	}
+
+	return facets, nil
+}
+
+// ListTagValues returns the values of a tag key in a project with their symbol counts.
+func (uc *useCase) ListTagValues(ctx context.Context, projectID uint64, key string) ([]*domain.TagFacet, error) {
+	if projectID == 0 {
+		return nil, fmt.Errorf("%w: project id is required", domain.ErrValidationFailed)
+	}
+	if key == "" {
+		return nil, fmt.Errorf("%w: tag key is required", domain.ErrValidationFailed)
+	}
+
+	facets, err := uc.repo.TagValueFacets(ctx, projectID, key)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("Failed to list tag values: %v", err)
+		return nil, toDomainError(err)
+	}
+
+	return facets, nil
+}
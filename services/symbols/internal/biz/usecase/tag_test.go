@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"symbols/internal/biz/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTagKeys(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		facets := []*domain.TagFacet{{Value: "theme", Count: 2}}
+		deps.repo.On("TagKeyFacets", ctx, uint64(1)).Return(facets, nil)
+
+		result, err := deps.uc.ListTagKeys(ctx, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, facets, result)
+	})
+
+	t.Run("missing project", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+
+		_, err := deps.uc.ListTagKeys(context.Background(), 0)
+
+		assert.ErrorIs(t, err, domain.ErrValidationFailed)
+		deps.repo.AssertNotCalled(t, "TagKeyFacets", mock.Anything, mock.Anything)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		deps.repo.On("TagKeyFacets", ctx, uint64(1)).Return(nil, domain.ErrDataDatabase)
+
+		_, err := deps.uc.ListTagKeys(ctx, 1)
+
+		assert.ErrorIs(t, err, domain.ErrDatabaseOperation)
+	})
+}
+
+func TestListTagValues(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+		ctx := context.Background()
+
+		facets := []*domain.TagFacet{{Value: "dark", Count: 2}, {Value: "light", Count: 1}}
+		deps.repo.On("TagValueFacets", ctx, uint64(1), "theme").Return(facets, nil)
+
+		result, err := deps.uc.ListTagValues(ctx, 1, "theme")
+
+		require.NoError(t, err)
+		assert.Equal(t, facets, result)
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		deps := setupSymbolUseCaseWithDeps()
+
+		_, err := deps.uc.ListTagValues(context.Background(), 1, "")
+
+		assert.ErrorIs(t, err, domain.ErrValidationFailed)
+		deps.repo.AssertNotCalled(t, "TagValueFacets", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestSymbolTagsValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		tags    map[string]string
+		wantErr bool
+	}{
+		{name: "valid", tags: map[string]string{"theme": "dark", "author": ""}},
+		{name: "empty key", tags: map[string]string{"": "dark"}, wantErr: true},
+		{name: "key too long", tags: map[string]string{string(make([]byte, 65)): "x"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := validSymbol()
+			s.Tags = tt.tags
+
+			err := NewValidator().Struct(s)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
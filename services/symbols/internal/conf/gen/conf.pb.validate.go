@@ -173,6 +173,35 @@ func (m *Bootstrap) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetTracing()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Tracing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BootstrapValidationError{
+					field:  "Tracing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTracing()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BootstrapValidationError{
+				field:  "Tracing",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return BootstrapMultiError(errors)
 	}
@@ -329,6 +358,109 @@ func (m *Server) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetRateLimit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServerValidationError{
+					field:  "RateLimit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServerValidationError{
+					field:  "RateLimit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRateLimit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServerValidationError{
+				field:  "RateLimit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetIdempotency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServerValidationError{
+					field:  "Idempotency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServerValidationError{
+					field:  "Idempotency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIdempotency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServerValidationError{
+				field:  "Idempotency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetHealth()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServerValidationError{
+					field:  "Health",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServerValidationError{
+					field:  "Health",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHealth()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServerValidationError{
+				field:  "Health",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTrustedProxies() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := ServerValidationError{
+				field:  fmt.Sprintf("TrustedProxies[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ServerMultiError(errors)
 	}
@@ -485,108 +617,68 @@ func (m *Data) validate(all bool) error {
 		}
 	}
 
-	if len(errors) > 0 {
-		return DataMultiError(errors)
-	}
-
-	return nil
-}
-
-// DataMultiError is an error wrapping multiple validation errors returned by
-// Data.ValidateAll() if the designated constraints aren't met.
-type DataMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m DataMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m DataMultiError) AllErrors() []error { return m }
-
-// DataValidationError is the validation error returned by Data.Validate if the
-// designated constraints aren't met.
-type DataValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e DataValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e DataValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e DataValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e DataValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e DataValidationError) ErrorName() string { return "DataValidationError" }
-
-// Error satisfies the builtin error interface
-func (e DataValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
+	if all {
+		switch v := interface{}(m.GetAudit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Audit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Audit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAudit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "Audit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	return fmt.Sprintf(
-		"invalid %sData.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = DataValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = DataValidationError{}
-
-// Validate checks the field values on CORS with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *CORS) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on CORS with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in CORSMultiError, or nil if none found.
-func (m *CORS) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *CORS) validate(all bool) error {
-	if m == nil {
-		return nil
+	if all {
+		switch v := interface{}(m.GetStats()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "Stats",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
-	var errors []error
-
-	if len(m.GetAllowedOrigins()) < 1 {
-		err := CORSValidationError{
-			field:  "AllowedOrigins",
-			reason: "value must contain at least 1 item(s)",
+	if _, ok := _Data_Broker_InLookup[m.GetBroker()]; !ok {
+		err := DataValidationError{
+			field:  "Broker",
+			reason: "value must be in list [ amqp gochannel sql nats kafka]",
 		}
 		if !all {
 			return err
@@ -594,153 +686,135 @@ func (m *CORS) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetAllowedOrigins() {
-		_, _ = idx, item
-
-		if utf8.RuneCountInString(item) < 1 {
-			err := CORSValidationError{
-				field:  fmt.Sprintf("AllowedOrigins[%v]", idx),
-				reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetSqlPubsub()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "SqlPubsub",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "SqlPubsub",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if len(m.GetAllowedMethods()) < 1 {
-		err := CORSValidationError{
-			field:  "AllowedMethods",
-			reason: "value must contain at least 1 item(s)",
-		}
-		if !all {
-			return err
 		}
-		errors = append(errors, err)
-	}
-
-	for idx, item := range m.GetAllowedMethods() {
-		_, _ = idx, item
-
-		if utf8.RuneCountInString(item) < 1 {
-			err := CORSValidationError{
-				field:  fmt.Sprintf("AllowedMethods[%v]", idx),
-				reason: "value length must be at least 1 runes",
-			}
-			if !all {
-				return err
+	} else if v, ok := interface{}(m.GetSqlPubsub()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "SqlPubsub",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
-
 	}
 
-	for idx, item := range m.GetAllowedHeaders() {
-		_, _ = idx, item
-
-		if utf8.RuneCountInString(item) < 1 {
-			err := CORSValidationError{
-				field:  fmt.Sprintf("AllowedHeaders[%v]", idx),
-				reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetNats()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Nats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Nats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			errors = append(errors, err)
 		}
-
-	}
-
-	for idx, item := range m.GetExposedHeaders() {
-		_, _ = idx, item
-
-		if utf8.RuneCountInString(item) < 1 {
-			err := CORSValidationError{
-				field:  fmt.Sprintf("ExposedHeaders[%v]", idx),
-				reason: "value length must be at least 1 runes",
-			}
-			if !all {
-				return err
+	} else if v, ok := interface{}(m.GetNats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "Nats",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
-
 	}
 
 	if all {
-		switch v := interface{}(m.GetAllowCredentials()).(type) {
+		switch v := interface{}(m.GetScheduler()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CORSValidationError{
-					field:  "AllowCredentials",
+				errors = append(errors, DataValidationError{
+					field:  "Scheduler",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CORSValidationError{
-					field:  "AllowCredentials",
+				errors = append(errors, DataValidationError{
+					field:  "Scheduler",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetAllowCredentials()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetScheduler()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CORSValidationError{
-				field:  "AllowCredentials",
+			return DataValidationError{
+				field:  "Scheduler",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if d := m.GetMaxAge(); d != nil {
-		dur, err := d.AsDuration(), d.CheckValid()
-		if err != nil {
-			err = CORSValidationError{
-				field:  "MaxAge",
-				reason: "value is not a valid duration",
-				cause:  err,
+	if all {
+		switch v := interface{}(m.GetKafka()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Kafka",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DataValidationError{
+					field:  "Kafka",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			errors = append(errors, err)
-		} else {
-
-			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
-
-			if dur <= gt {
-				err := CORSValidationError{
-					field:  "MaxAge",
-					reason: "value must be greater than 0s",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
+		}
+	} else if v, ok := interface{}(m.GetKafka()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataValidationError{
+				field:  "Kafka",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-
 		}
 	}
 
 	if len(errors) > 0 {
-		return CORSMultiError(errors)
+		return DataMultiError(errors)
 	}
 
 	return nil
 }
 
-// CORSMultiError is an error wrapping multiple validation errors returned by
-// CORS.ValidateAll() if the designated constraints aren't met.
-type CORSMultiError []error
+// DataMultiError is an error wrapping multiple validation errors returned by
+// Data.ValidateAll() if the designated constraints aren't met.
+type DataMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CORSMultiError) Error() string {
+func (m DataMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -749,11 +823,11 @@ func (m CORSMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CORSMultiError) AllErrors() []error { return m }
+func (m DataMultiError) AllErrors() []error { return m }
 
-// CORSValidationError is the validation error returned by CORS.Validate if the
+// DataValidationError is the validation error returned by Data.Validate if the
 // designated constraints aren't met.
-type CORSValidationError struct {
+type DataValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -761,22 +835,22 @@ type CORSValidationError struct {
 }
 
 // Field function returns field value.
-func (e CORSValidationError) Field() string { return e.field }
+func (e DataValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CORSValidationError) Reason() string { return e.reason }
+func (e DataValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CORSValidationError) Cause() error { return e.cause }
+func (e DataValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CORSValidationError) Key() bool { return e.key }
+func (e DataValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CORSValidationError) ErrorName() string { return "CORSValidationError" }
+func (e DataValidationError) ErrorName() string { return "DataValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CORSValidationError) Error() string {
+func (e DataValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -788,14 +862,14 @@ func (e CORSValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCORS.%s: %s%s",
+		"invalid %sData.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CORSValidationError{}
+var _ error = DataValidationError{}
 
 var _ interface {
 	Field() string
@@ -803,57 +877,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CORSValidationError{}
+} = DataValidationError{}
 
-// Validate checks the field values on HTTPServer with the rules defined in the
+var _Data_Broker_InLookup = map[string]struct{}{
+	"":          {},
+	"amqp":      {},
+	"gochannel": {},
+	"sql":       {},
+	"nats":      {},
+	"kafka":     {},
+}
+
+// Validate checks the field values on Scheduler with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *HTTPServer) Validate() error {
+func (m *Scheduler) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on HTTPServer with the rules defined in
+// ValidateAll checks the field values on Scheduler with the rules defined in
 // the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in HTTPServerMultiError, or
-// nil if none found.
-func (m *HTTPServer) ValidateAll() error {
+// result is a list of violation errors wrapped in SchedulerMultiError, or nil
+// if none found.
+func (m *Scheduler) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *HTTPServer) validate(all bool) error {
+func (m *Scheduler) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if _, ok := _HTTPServer_Network_InLookup[m.GetNetwork()]; !ok {
-		err := HTTPServerValidationError{
-			field:  "Network",
-			reason: "value must be in list [tcp tcp4 tcp6]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetAddr()) < 1 {
-		err := HTTPServerValidationError{
-			field:  "Addr",
-			reason: "value length must be at least 1 runes",
+	if all {
+		switch v := interface{}(m.GetEnabled()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SchedulerValidationError{
+					field:  "Enabled",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SchedulerValidationError{
+					field:  "Enabled",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetEnabled()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SchedulerValidationError{
+				field:  "Enabled",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	if d := m.GetTimeout(); d != nil {
+	if d := m.GetLockTtl(); d != nil {
 		dur, err := d.AsDuration(), d.CheckValid()
 		if err != nil {
-			err = HTTPServerValidationError{
-				field:  "Timeout",
+			err = SchedulerValidationError{
+				field:  "LockTtl",
 				reason: "value is not a valid duration",
 				cause:  err,
 			}
@@ -866,42 +956,3776 @@ func (m *HTTPServer) validate(all bool) error {
 			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
 
 			if dur <= gt {
-				err := HTTPServerValidationError{
-					field:  "Timeout",
+				err := SchedulerValidationError{
+					field:  "LockTtl",
 					reason: "value must be greater than 0s",
 				}
-				if !all {
-					return err
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetJobs()))
+		i := 0
+		for key := range m.GetJobs() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetJobs()[key]
+			_ = val
+
+			// no validation rules for Jobs[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, SchedulerValidationError{
+							field:  fmt.Sprintf("Jobs[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, SchedulerValidationError{
+							field:  fmt.Sprintf("Jobs[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return SchedulerValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return SchedulerMultiError(errors)
+	}
+
+	return nil
+}
+
+// SchedulerMultiError is an error wrapping multiple validation errors returned
+// by Scheduler.ValidateAll() if the designated constraints aren't met.
+type SchedulerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SchedulerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SchedulerMultiError) AllErrors() []error { return m }
+
+// SchedulerValidationError is the validation error returned by
+// Scheduler.Validate if the designated constraints aren't met.
+type SchedulerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SchedulerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SchedulerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SchedulerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SchedulerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SchedulerValidationError) ErrorName() string { return "SchedulerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SchedulerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduler.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SchedulerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SchedulerValidationError{}
+
+// Validate checks the field values on NATS with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *NATS) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NATS with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in NATSMultiError, or nil if none found.
+func (m *NATS) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NATS) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Url
+
+	// no validation rules for Stream
+
+	// no validation rules for SubjectPrefix
+
+	// no validation rules for Subjects
+
+	// no validation rules for Consumer
+
+	if _, ok := _NATS_OrderingKey_InLookup[m.GetOrderingKey()]; !ok {
+		err := NATSValidationError{
+			field:  "OrderingKey",
+			reason: "value must be in list [ project_id symbol_id]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetAckWait(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = NATSValidationError{
+				field:  "AckWait",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := NATSValidationError{
+					field:  "AckWait",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return NATSMultiError(errors)
+	}
+
+	return nil
+}
+
+// NATSMultiError is an error wrapping multiple validation errors returned by
+// NATS.ValidateAll() if the designated constraints aren't met.
+type NATSMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NATSMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NATSMultiError) AllErrors() []error { return m }
+
+// NATSValidationError is the validation error returned by NATS.Validate if the
+// designated constraints aren't met.
+type NATSValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NATSValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NATSValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NATSValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NATSValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NATSValidationError) ErrorName() string { return "NATSValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NATSValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNATS.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NATSValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NATSValidationError{}
+
+var _NATS_OrderingKey_InLookup = map[string]struct{}{
+	"":           {},
+	"project_id": {},
+	"symbol_id":  {},
+}
+
+// Validate checks the field values on Kafka with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Kafka) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Kafka with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in KafkaMultiError, or nil if none found.
+func (m *Kafka) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Kafka) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBrokers() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := KafkaValidationError{
+				field:  fmt.Sprintf("Brokers[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Topic
+
+	// no validation rules for Topics
+
+	// no validation rules for ConsumerGroup
+
+	if _, ok := _Kafka_OrderingKey_InLookup[m.GetOrderingKey()]; !ok {
+		err := KafkaValidationError{
+			field:  "OrderingKey",
+			reason: "value must be in list [ project_id symbol_id]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return KafkaMultiError(errors)
+	}
+
+	return nil
+}
+
+// KafkaMultiError is an error wrapping multiple validation errors returned by
+// Kafka.ValidateAll() if the designated constraints aren't met.
+type KafkaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KafkaMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KafkaMultiError) AllErrors() []error { return m }
+
+// KafkaValidationError is the validation error returned by Kafka.Validate if
+// the designated constraints aren't met.
+type KafkaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KafkaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KafkaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KafkaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KafkaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KafkaValidationError) ErrorName() string { return "KafkaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KafkaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKafka.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KafkaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KafkaValidationError{}
+
+var _Kafka_OrderingKey_InLookup = map[string]struct{}{
+	"":           {},
+	"project_id": {},
+	"symbol_id":  {},
+}
+
+// Validate checks the field values on SQLPubSub with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SQLPubSub) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SQLPubSub with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SQLPubSubMultiError, or nil
+// if none found.
+func (m *SQLPubSub) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SQLPubSub) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if d := m.GetPollInterval(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = SQLPubSubValidationError{
+				field:  "PollInterval",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := SQLPubSubValidationError{
+					field:  "PollInterval",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return SQLPubSubMultiError(errors)
+	}
+
+	return nil
+}
+
+// SQLPubSubMultiError is an error wrapping multiple validation errors returned
+// by SQLPubSub.ValidateAll() if the designated constraints aren't met.
+type SQLPubSubMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SQLPubSubMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SQLPubSubMultiError) AllErrors() []error { return m }
+
+// SQLPubSubValidationError is the validation error returned by
+// SQLPubSub.Validate if the designated constraints aren't met.
+type SQLPubSubValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SQLPubSubValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SQLPubSubValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SQLPubSubValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SQLPubSubValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SQLPubSubValidationError) ErrorName() string { return "SQLPubSubValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SQLPubSubValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSQLPubSub.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SQLPubSubValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SQLPubSubValidationError{}
+
+// Validate checks the field values on Stats with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Stats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Stats with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in StatsMultiError, or nil if none found.
+func (m *Stats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Stats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMaterialized()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatsValidationError{
+					field:  "Materialized",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatsValidationError{
+					field:  "Materialized",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaterialized()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatsValidationError{
+				field:  "Materialized",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if d := m.GetMaxStaleness(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = StatsValidationError{
+				field:  "MaxStaleness",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := StatsValidationError{
+					field:  "MaxStaleness",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return StatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatsMultiError is an error wrapping multiple validation errors returned by
+// Stats.ValidateAll() if the designated constraints aren't met.
+type StatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatsMultiError) AllErrors() []error { return m }
+
+// StatsValidationError is the validation error returned by Stats.Validate if
+// the designated constraints aren't met.
+type StatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatsValidationError) ErrorName() string { return "StatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatsValidationError{}
+
+// Validate checks the field values on Audit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Audit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Audit with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AuditMultiError, or nil if none found.
+func (m *Audit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Audit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if d := m.GetRetention(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = AuditValidationError{
+				field:  "Retention",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := AuditValidationError{
+					field:  "Retention",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuditMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditMultiError is an error wrapping multiple validation errors returned by
+// Audit.ValidateAll() if the designated constraints aren't met.
+type AuditMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditMultiError) AllErrors() []error { return m }
+
+// AuditValidationError is the validation error returned by Audit.Validate if
+// the designated constraints aren't met.
+type AuditValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditValidationError) ErrorName() string { return "AuditValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAudit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditValidationError{}
+
+// Validate checks the field values on CORS with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *CORS) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CORS with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CORSMultiError, or nil if none found.
+func (m *CORS) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CORS) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetAllowedOrigins()) < 1 {
+		err := CORSValidationError{
+			field:  "AllowedOrigins",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetAllowedOrigins() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := CORSValidationError{
+				field:  fmt.Sprintf("AllowedOrigins[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetAllowedMethods()) < 1 {
+		err := CORSValidationError{
+			field:  "AllowedMethods",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetAllowedMethods() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := CORSValidationError{
+				field:  fmt.Sprintf("AllowedMethods[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	for idx, item := range m.GetAllowedHeaders() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := CORSValidationError{
+				field:  fmt.Sprintf("AllowedHeaders[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	for idx, item := range m.GetExposedHeaders() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := CORSValidationError{
+				field:  fmt.Sprintf("ExposedHeaders[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetAllowCredentials()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CORSValidationError{
+					field:  "AllowCredentials",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CORSValidationError{
+					field:  "AllowCredentials",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAllowCredentials()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CORSValidationError{
+				field:  "AllowCredentials",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if d := m.GetMaxAge(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = CORSValidationError{
+				field:  "MaxAge",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := CORSValidationError{
+					field:  "MaxAge",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return CORSMultiError(errors)
+	}
+
+	return nil
+}
+
+// CORSMultiError is an error wrapping multiple validation errors returned by
+// CORS.ValidateAll() if the designated constraints aren't met.
+type CORSMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CORSMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CORSMultiError) AllErrors() []error { return m }
+
+// CORSValidationError is the validation error returned by CORS.Validate if the
+// designated constraints aren't met.
+type CORSValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CORSValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CORSValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CORSValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CORSValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CORSValidationError) ErrorName() string { return "CORSValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CORSValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCORS.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CORSValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CORSValidationError{}
+
+// Validate checks the field values on HTTPServer with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HTTPServer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HTTPServer with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HTTPServerMultiError, or
+// nil if none found.
+func (m *HTTPServer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HTTPServer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _HTTPServer_Network_InLookup[m.GetNetwork()]; !ok {
+		err := HTTPServerValidationError{
+			field:  "Network",
+			reason: "value must be in list [tcp tcp4 tcp6]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAddr()) < 1 {
+		err := HTTPServerValidationError{
+			field:  "Addr",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = HTTPServerValidationError{
+				field:  "Timeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := HTTPServerValidationError{
+					field:  "Timeout",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCors()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HTTPServerValidationError{
+					field:  "Cors",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HTTPServerValidationError{
+					field:  "Cors",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCors()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HTTPServerValidationError{
+				field:  "Cors",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return HTTPServerMultiError(errors)
+	}
+
+	return nil
+}
+
+// HTTPServerMultiError is an error wrapping multiple validation errors
+// returned by HTTPServer.ValidateAll() if the designated constraints aren't met.
+type HTTPServerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HTTPServerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HTTPServerMultiError) AllErrors() []error { return m }
+
+// HTTPServerValidationError is the validation error returned by
+// HTTPServer.Validate if the designated constraints aren't met.
+type HTTPServerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HTTPServerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HTTPServerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HTTPServerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HTTPServerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HTTPServerValidationError) ErrorName() string { return "HTTPServerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HTTPServerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHTTPServer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HTTPServerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HTTPServerValidationError{}
+
+var _HTTPServer_Network_InLookup = map[string]struct{}{
+	"tcp":  {},
+	"tcp4": {},
+	"tcp6": {},
+}
+
+// Validate checks the field values on GRPCServer with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GRPCServer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GRPCServer with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GRPCServerMultiError, or
+// nil if none found.
+func (m *GRPCServer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GRPCServer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _GRPCServer_Network_InLookup[m.GetNetwork()]; !ok {
+		err := GRPCServerValidationError{
+			field:  "Network",
+			reason: "value must be in list [tcp tcp4 tcp6]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAddr()) < 1 {
+		err := GRPCServerValidationError{
+			field:  "Addr",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = GRPCServerValidationError{
+				field:  "Timeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := GRPCServerValidationError{
+					field:  "Timeout",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return GRPCServerMultiError(errors)
+	}
+
+	return nil
+}
+
+// GRPCServerMultiError is an error wrapping multiple validation errors
+// returned by GRPCServer.ValidateAll() if the designated constraints aren't met.
+type GRPCServerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GRPCServerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GRPCServerMultiError) AllErrors() []error { return m }
+
+// GRPCServerValidationError is the validation error returned by
+// GRPCServer.Validate if the designated constraints aren't met.
+type GRPCServerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GRPCServerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GRPCServerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GRPCServerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GRPCServerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GRPCServerValidationError) ErrorName() string { return "GRPCServerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GRPCServerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGRPCServer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GRPCServerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GRPCServerValidationError{}
+
+var _GRPCServer_Network_InLookup = map[string]struct{}{
+	"tcp":  {},
+	"tcp4": {},
+	"tcp6": {},
+}
+
+// Validate checks the field values on RateLimit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RateLimit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RateLimit with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RateLimitMultiError, or nil
+// if none found.
+func (m *RateLimit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RateLimit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEnabled()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RateLimitValidationError{
+					field:  "Enabled",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RateLimitValidationError{
+					field:  "Enabled",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnabled()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RateLimitValidationError{
+				field:  "Enabled",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RateLimitValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RateLimitValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RateLimitValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if d := m.GetIdleTtl(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = RateLimitValidationError{
+				field:  "IdleTtl",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := RateLimitValidationError{
+					field:  "IdleTtl",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if _, ok := _RateLimit_Backend_InLookup[m.GetBackend()]; !ok {
+		err := RateLimitValidationError{
+			field:  "Backend",
+			reason: "value must be in list [ memory sql]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RateLimitMultiError(errors)
+	}
+
+	return nil
+}
+
+// RateLimitMultiError is an error wrapping multiple validation errors returned
+// by RateLimit.ValidateAll() if the designated constraints aren't met.
+type RateLimitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RateLimitMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RateLimitMultiError) AllErrors() []error { return m }
+
+// RateLimitValidationError is the validation error returned by
+// RateLimit.Validate if the designated constraints aren't met.
+type RateLimitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RateLimitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RateLimitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RateLimitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RateLimitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RateLimitValidationError) ErrorName() string { return "RateLimitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RateLimitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRateLimit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RateLimitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RateLimitValidationError{}
+
+var _RateLimit_Backend_InLookup = map[string]struct{}{
+	"":       {},
+	"memory": {},
+	"sql":    {},
+}
+
+// Validate checks the field values on RateLimitRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RateLimitRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RateLimitRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RateLimitRuleMultiError, or
+// nil if none found.
+func (m *RateLimitRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RateLimitRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOperation()) < 1 {
+		err := RateLimitRuleValidationError{
+			field:  "Operation",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _RateLimitRule_Key_InLookup[m.GetKey()]; !ok {
+		err := RateLimitRuleValidationError{
+			field:  "Key",
+			reason: "value must be in list [api_key user project ip]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRequests() <= 0 {
+		err := RateLimitRuleValidationError{
+			field:  "Requests",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPeriod() == nil {
+		err := RateLimitRuleValidationError{
+			field:  "Period",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetPeriod(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = RateLimitRuleValidationError{
+				field:  "Period",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := RateLimitRuleValidationError{
+					field:  "Period",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	// no validation rules for Burst
+
+	if len(errors) > 0 {
+		return RateLimitRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// RateLimitRuleMultiError is an error wrapping multiple validation errors
+// returned by RateLimitRule.ValidateAll() if the designated constraints
+// aren't met.
+type RateLimitRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RateLimitRuleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RateLimitRuleMultiError) AllErrors() []error { return m }
+
+// RateLimitRuleValidationError is the validation error returned by
+// RateLimitRule.Validate if the designated constraints aren't met.
+type RateLimitRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RateLimitRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RateLimitRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RateLimitRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RateLimitRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RateLimitRuleValidationError) ErrorName() string { return "RateLimitRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RateLimitRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRateLimitRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RateLimitRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RateLimitRuleValidationError{}
+
+var _RateLimitRule_Key_InLookup = map[string]struct{}{
+	"api_key": {},
+	"user":    {},
+	"project": {},
+	"ip":      {},
+}
+
+// Validate checks the field values on Health with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Health) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Health with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in HealthMultiError, or nil if none found.
+func (m *Health) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Health) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if d := m.GetTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = HealthValidationError{
+				field:  "Timeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := HealthValidationError{
+					field:  "Timeout",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetCacheTtl(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = HealthValidationError{
+				field:  "CacheTtl",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := HealthValidationError{
+					field:  "CacheTtl",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return HealthMultiError(errors)
+	}
+
+	return nil
+}
+
+// HealthMultiError is an error wrapping multiple validation errors returned by
+// Health.ValidateAll() if the designated constraints aren't met.
+type HealthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HealthMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HealthMultiError) AllErrors() []error { return m }
+
+// HealthValidationError is the validation error returned by Health.Validate if
+// the designated constraints aren't met.
+type HealthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HealthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HealthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HealthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HealthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HealthValidationError) ErrorName() string { return "HealthValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HealthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHealth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HealthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HealthValidationError{}
+
+// Validate checks the field values on Idempotency with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Idempotency) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Idempotency with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IdempotencyMultiError, or
+// nil if none found.
+func (m *Idempotency) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Idempotency) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEnabled()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IdempotencyValidationError{
+					field:  "Enabled",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IdempotencyValidationError{
+					field:  "Enabled",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnabled()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IdempotencyValidationError{
+				field:  "Enabled",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if d := m.GetTtl(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = IdempotencyValidationError{
+				field:  "Ttl",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := IdempotencyValidationError{
+					field:  "Ttl",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	for idx, item := range m.GetOperations() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := IdempotencyValidationError{
+				field:  fmt.Sprintf("Operations[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := _Idempotency_Backend_InLookup[m.GetBackend()]; !ok {
+		err := IdempotencyValidationError{
+			field:  "Backend",
+			reason: "value must be in list [ memory sql]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return IdempotencyMultiError(errors)
+	}
+
+	return nil
+}
+
+// IdempotencyMultiError is an error wrapping multiple validation errors
+// returned by Idempotency.ValidateAll() if the designated constraints aren't met.
+type IdempotencyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IdempotencyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IdempotencyMultiError) AllErrors() []error { return m }
+
+// IdempotencyValidationError is the validation error returned by
+// Idempotency.Validate if the designated constraints aren't met.
+type IdempotencyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IdempotencyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IdempotencyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IdempotencyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IdempotencyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IdempotencyValidationError) ErrorName() string { return "IdempotencyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IdempotencyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIdempotency.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IdempotencyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IdempotencyValidationError{}
+
+var _Idempotency_Backend_InLookup = map[string]struct{}{
+	"":       {},
+	"memory": {},
+	"sql":    {},
+}
+
+// Validate checks the field values on Database with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Database) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Database with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DatabaseMultiError, or nil
+// if none found.
+func (m *Database) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Database) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDriver()) < 1 {
+		err := DatabaseValidationError{
+			field:  "Driver",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSource()) < 1 {
+		err := DatabaseValidationError{
+			field:  "Source",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetRunMigrations()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DatabaseValidationError{
+					field:  "RunMigrations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DatabaseValidationError{
+					field:  "RunMigrations",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRunMigrations()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DatabaseValidationError{
+				field:  "RunMigrations",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetMaxIdleConns() < 0 {
+		err := DatabaseValidationError{
+			field:  "MaxIdleConns",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxOpenConns() <= 0 {
+		err := DatabaseValidationError{
+			field:  "MaxOpenConns",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetConnMaxLifetime(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = DatabaseValidationError{
+				field:  "ConnMaxLifetime",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := DatabaseValidationError{
+					field:  "ConnMaxLifetime",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return DatabaseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DatabaseMultiError is an error wrapping multiple validation errors returned
+// by Database.ValidateAll() if the designated constraints aren't met.
+type DatabaseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DatabaseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DatabaseMultiError) AllErrors() []error { return m }
+
+// DatabaseValidationError is the validation error returned by
+// Database.Validate if the designated constraints aren't met.
+type DatabaseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DatabaseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DatabaseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DatabaseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DatabaseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DatabaseValidationError) ErrorName() string { return "DatabaseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DatabaseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDatabase.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DatabaseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DatabaseValidationError{}
+
+// Validate checks the field values on RabbitMQServer with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RabbitMQServer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RabbitMQServer with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RabbitMQServerMultiError,
+// or nil if none found.
+func (m *RabbitMQServer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RabbitMQServer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAddr()) < 1 {
+		err := RabbitMQServerValidationError{
+			field:  "Addr",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RabbitMQServer_Addr_Pattern.MatchString(m.GetAddr()) {
+		err := RabbitMQServerValidationError{
+			field:  "Addr",
+			reason: "value does not match regex pattern \"^amqps?://.*\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetDialTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = RabbitMQServerValidationError{
+				field:  "DialTimeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := RabbitMQServerValidationError{
+					field:  "DialTimeout",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExchange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RabbitMQServerValidationError{
+					field:  "Exchange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RabbitMQServerValidationError{
+					field:  "Exchange",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExchange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RabbitMQServerValidationError{
+				field:  "Exchange",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetQueue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RabbitMQServerValidationError{
+					field:  "Queue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RabbitMQServerValidationError{
+					field:  "Queue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQueue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RabbitMQServerValidationError{
+				field:  "Queue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeadLetter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RabbitMQServerValidationError{
+					field:  "DeadLetter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RabbitMQServerValidationError{
+					field:  "DeadLetter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeadLetter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RabbitMQServerValidationError{
+				field:  "DeadLetter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetInbox()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RabbitMQServerValidationError{
+					field:  "Inbox",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RabbitMQServerValidationError{
+					field:  "Inbox",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInbox()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RabbitMQServerValidationError{
+				field:  "Inbox",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPublisher()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RabbitMQServerValidationError{
+					field:  "Publisher",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RabbitMQServerValidationError{
+					field:  "Publisher",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPublisher()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RabbitMQServerValidationError{
+				field:  "Publisher",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReconnect()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RabbitMQServerValidationError{
+					field:  "Reconnect",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RabbitMQServerValidationError{
+					field:  "Reconnect",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReconnect()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RabbitMQServerValidationError{
+				field:  "Reconnect",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RabbitMQServerMultiError(errors)
+	}
+
+	return nil
+}
+
+// RabbitMQServerMultiError is an error wrapping multiple validation errors
+// returned by RabbitMQServer.ValidateAll() if the designated constraints
+// aren't met.
+type RabbitMQServerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RabbitMQServerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RabbitMQServerMultiError) AllErrors() []error { return m }
+
+// RabbitMQServerValidationError is the validation error returned by
+// RabbitMQServer.Validate if the designated constraints aren't met.
+type RabbitMQServerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RabbitMQServerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RabbitMQServerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RabbitMQServerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RabbitMQServerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RabbitMQServerValidationError) ErrorName() string { return "RabbitMQServerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RabbitMQServerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRabbitMQServer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RabbitMQServerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RabbitMQServerValidationError{}
+
+var _RabbitMQServer_Addr_Pattern = regexp.MustCompile("^amqps?://.*")
+
+// Validate checks the field values on LogConfig with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LogConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogConfig with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LogConfigMultiError, or nil
+// if none found.
+func (m *LogConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _LogConfig_Level_InLookup[m.GetLevel()]; !ok {
+		err := LogConfigValidationError{
+			field:  "Level",
+			reason: "value must be in list [debug info warn error]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return LogConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogConfigMultiError is an error wrapping multiple validation errors returned
+// by LogConfig.ValidateAll() if the designated constraints aren't met.
+type LogConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogConfigMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogConfigMultiError) AllErrors() []error { return m }
+
+// LogConfigValidationError is the validation error returned by
+// LogConfig.Validate if the designated constraints aren't met.
+type LogConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogConfigValidationError) ErrorName() string { return "LogConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogConfigValidationError{}
+
+var _LogConfig_Level_InLookup = map[string]struct{}{
+	"debug": {},
+	"info":  {},
+	"warn":  {},
+	"error": {},
+}
+
+// Validate checks the field values on Metrics with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Metrics) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Metrics with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MetricsMultiError, or nil if none found.
+func (m *Metrics) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Metrics) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEnabled()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsValidationError{
+					field:  "Enabled",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsValidationError{
+					field:  "Enabled",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnabled()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsValidationError{
+				field:  "Enabled",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ServiceName
+
+	// no validation rules for Path
+
+	if all {
+		switch v := interface{}(m.GetIncludeRuntime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsValidationError{
+					field:  "IncludeRuntime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsValidationError{
+					field:  "IncludeRuntime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIncludeRuntime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsValidationError{
+				field:  "IncludeRuntime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MetricsMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsMultiError is an error wrapping multiple validation errors returned
+// by Metrics.ValidateAll() if the designated constraints aren't met.
+type MetricsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsMultiError) AllErrors() []error { return m }
+
+// MetricsValidationError is the validation error returned by Metrics.Validate
+// if the designated constraints aren't met.
+type MetricsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsValidationError) ErrorName() string { return "MetricsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetricsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetrics.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsValidationError{}
+
+// Validate checks the field values on Tracing with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Tracing) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tracing with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TracingMultiError, or nil if none found.
+func (m *Tracing) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tracing) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _Tracing_Exporter_InLookup[m.GetExporter()]; !ok {
+		err := TracingValidationError{
+			field:  "Exporter",
+			reason: "value must be in list [ none stdout otlp]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Endpoint
+
+	if _, ok := _Tracing_Protocol_InLookup[m.GetProtocol()]; !ok {
+		err := TracingValidationError{
+			field:  "Protocol",
+			reason: "value must be in list [ grpc http]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetInsecure()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TracingValidationError{
+					field:  "Insecure",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TracingValidationError{
+					field:  "Insecure",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInsecure()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TracingValidationError{
+				field:  "Insecure",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Headers
+
+	if wrapper := m.GetSampleRatio(); wrapper != nil {
+
+		if val := wrapper.GetValue(); val < 0 || val > 1 {
+			err := TracingValidationError{
+				field:  "SampleRatio",
+				reason: "value must be inside range [0, 1]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TracingMultiError(errors)
+	}
+
+	return nil
+}
+
+// TracingMultiError is an error wrapping multiple validation errors returned
+// by Tracing.ValidateAll() if the designated constraints aren't met.
+type TracingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TracingMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TracingMultiError) AllErrors() []error { return m }
+
+// TracingValidationError is the validation error returned by Tracing.Validate
+// if the designated constraints aren't met.
+type TracingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TracingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TracingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TracingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TracingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TracingValidationError) ErrorName() string { return "TracingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TracingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTracing.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TracingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TracingValidationError{}
+
+var _Tracing_Exporter_InLookup = map[string]struct{}{
+	"":       {},
+	"none":   {},
+	"stdout": {},
+	"otlp":   {},
+}
+
+var _Tracing_Protocol_InLookup = map[string]struct{}{
+	"":     {},
+	"grpc": {},
+	"http": {},
+}
+
+// Validate checks the field values on Scheduler_Job with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Scheduler_Job) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Scheduler_Job with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Scheduler_JobMultiError, or
+// nil if none found.
+func (m *Scheduler_Job) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Scheduler_Job) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEnabled()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Scheduler_JobValidationError{
+					field:  "Enabled",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Scheduler_JobValidationError{
+					field:  "Enabled",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnabled()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Scheduler_JobValidationError{
+				field:  "Enabled",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetSchedule()) < 1 {
+		err := Scheduler_JobValidationError{
+			field:  "Schedule",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = Scheduler_JobValidationError{
+				field:  "Timeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := Scheduler_JobValidationError{
+					field:  "Timeout",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetRetention(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = Scheduler_JobValidationError{
+				field:  "Retention",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := Scheduler_JobValidationError{
+					field:  "Retention",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return Scheduler_JobMultiError(errors)
+	}
+
+	return nil
+}
+
+// Scheduler_JobMultiError is an error wrapping multiple validation errors
+// returned by Scheduler_Job.ValidateAll() if the designated constraints
+// aren't met.
+type Scheduler_JobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Scheduler_JobMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Scheduler_JobMultiError) AllErrors() []error { return m }
+
+// Scheduler_JobValidationError is the validation error returned by
+// Scheduler_Job.Validate if the designated constraints aren't met.
+type Scheduler_JobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Scheduler_JobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Scheduler_JobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Scheduler_JobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Scheduler_JobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Scheduler_JobValidationError) ErrorName() string { return "Scheduler_JobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Scheduler_JobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduler_Job.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Scheduler_JobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Scheduler_JobValidationError{}
+
+// Validate checks the field values on RabbitMQServer_Exchange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RabbitMQServer_Exchange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RabbitMQServer_Exchange with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RabbitMQServer_ExchangeMultiError, or nil if none found.
+func (m *RabbitMQServer_Exchange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RabbitMQServer_Exchange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := RabbitMQServer_ExchangeValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _RabbitMQServer_Exchange_Type_InLookup[m.GetType()]; !ok {
+		err := RabbitMQServer_ExchangeValidationError{
+			field:  "Type",
+			reason: "value must be in list [direct topic fanout headers]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDurable()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RabbitMQServer_ExchangeValidationError{
+					field:  "Durable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RabbitMQServer_ExchangeValidationError{
+					field:  "Durable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDurable()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RabbitMQServer_ExchangeValidationError{
+				field:  "Durable",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAutoDelete()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RabbitMQServer_ExchangeValidationError{
+					field:  "AutoDelete",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RabbitMQServer_ExchangeValidationError{
+					field:  "AutoDelete",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAutoDelete()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RabbitMQServer_ExchangeValidationError{
+				field:  "AutoDelete",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RabbitMQServer_ExchangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// RabbitMQServer_ExchangeMultiError is an error wrapping multiple validation
+// errors returned by RabbitMQServer_Exchange.ValidateAll() if the designated
+// constraints aren't met.
+type RabbitMQServer_ExchangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RabbitMQServer_ExchangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RabbitMQServer_ExchangeMultiError) AllErrors() []error { return m }
+
+// RabbitMQServer_ExchangeValidationError is the validation error returned by
+// RabbitMQServer_Exchange.Validate if the designated constraints aren't met.
+type RabbitMQServer_ExchangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RabbitMQServer_ExchangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RabbitMQServer_ExchangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RabbitMQServer_ExchangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RabbitMQServer_ExchangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RabbitMQServer_ExchangeValidationError) ErrorName() string {
+	return "RabbitMQServer_ExchangeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RabbitMQServer_ExchangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRabbitMQServer_Exchange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RabbitMQServer_ExchangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RabbitMQServer_ExchangeValidationError{}
+
+var _RabbitMQServer_Exchange_Type_InLookup = map[string]struct{}{
+	"direct":  {},
+	"topic":   {},
+	"fanout":  {},
+	"headers": {},
+}
+
+// Validate checks the field values on RabbitMQServer_Queue with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RabbitMQServer_Queue) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RabbitMQServer_Queue with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RabbitMQServer_QueueMultiError, or nil if none found.
+func (m *RabbitMQServer_Queue) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RabbitMQServer_Queue) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := RabbitMQServer_QueueValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDurable()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RabbitMQServer_QueueValidationError{
+					field:  "Durable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RabbitMQServer_QueueValidationError{
+					field:  "Durable",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDurable()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RabbitMQServer_QueueValidationError{
+				field:  "Durable",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAutoDelete()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RabbitMQServer_QueueValidationError{
+					field:  "AutoDelete",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RabbitMQServer_QueueValidationError{
+					field:  "AutoDelete",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAutoDelete()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RabbitMQServer_QueueValidationError{
+				field:  "AutoDelete",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExclusive()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RabbitMQServer_QueueValidationError{
+					field:  "Exclusive",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RabbitMQServer_QueueValidationError{
+					field:  "Exclusive",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExclusive()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RabbitMQServer_QueueValidationError{
+				field:  "Exclusive",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for BindingKey
+
+	if m.GetPrefetchCount() <= 0 {
+		err := RabbitMQServer_QueueValidationError{
+			field:  "PrefetchCount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWorkerCount() <= 0 {
+		err := RabbitMQServer_QueueValidationError{
+			field:  "WorkerCount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetHandlers()))
+		i := 0
+		for key := range m.GetHandlers() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetHandlers()[key]
+			_ = val
+
+			// no validation rules for Handlers[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, RabbitMQServer_QueueValidationError{
+							field:  fmt.Sprintf("Handlers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, RabbitMQServer_QueueValidationError{
+							field:  fmt.Sprintf("Handlers[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return RabbitMQServer_QueueValidationError{
+						field:  fmt.Sprintf("Handlers[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
 				}
-				errors = append(errors, err)
 			}
 
 		}
 	}
 
+	if _, ok := _RabbitMQServer_Queue_UnknownEvents_InLookup[m.GetUnknownEvents()]; !ok {
+		err := RabbitMQServer_QueueValidationError{
+			field:  "UnknownEvents",
+			reason: "value must be in list [ ignore dead_letter]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
-		switch v := interface{}(m.GetCors()).(type) {
+		switch v := interface{}(m.GetRetry()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, HTTPServerValidationError{
-					field:  "Cors",
+				errors = append(errors, RabbitMQServer_QueueValidationError{
+					field:  "Retry",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, HTTPServerValidationError{
-					field:  "Cors",
+				errors = append(errors, RabbitMQServer_QueueValidationError{
+					field:  "Retry",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCors()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRetry()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return HTTPServerValidationError{
-				field:  "Cors",
+			return RabbitMQServer_QueueValidationError{
+				field:  "Retry",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -909,18 +4733,19 @@ func (m *HTTPServer) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return HTTPServerMultiError(errors)
+		return RabbitMQServer_QueueMultiError(errors)
 	}
 
 	return nil
 }
 
-// HTTPServerMultiError is an error wrapping multiple validation errors
-// returned by HTTPServer.ValidateAll() if the designated constraints aren't met.
-type HTTPServerMultiError []error
+// RabbitMQServer_QueueMultiError is an error wrapping multiple validation
+// errors returned by RabbitMQServer_Queue.ValidateAll() if the designated
+// constraints aren't met.
+type RabbitMQServer_QueueMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m HTTPServerMultiError) Error() string {
+func (m RabbitMQServer_QueueMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -929,11 +4754,11 @@ func (m HTTPServerMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m HTTPServerMultiError) AllErrors() []error { return m }
+func (m RabbitMQServer_QueueMultiError) AllErrors() []error { return m }
 
-// HTTPServerValidationError is the validation error returned by
-// HTTPServer.Validate if the designated constraints aren't met.
-type HTTPServerValidationError struct {
+// RabbitMQServer_QueueValidationError is the validation error returned by
+// RabbitMQServer_Queue.Validate if the designated constraints aren't met.
+type RabbitMQServer_QueueValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -941,22 +4766,24 @@ type HTTPServerValidationError struct {
 }
 
 // Field function returns field value.
-func (e HTTPServerValidationError) Field() string { return e.field }
+func (e RabbitMQServer_QueueValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e HTTPServerValidationError) Reason() string { return e.reason }
+func (e RabbitMQServer_QueueValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e HTTPServerValidationError) Cause() error { return e.cause }
+func (e RabbitMQServer_QueueValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e HTTPServerValidationError) Key() bool { return e.key }
+func (e RabbitMQServer_QueueValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e HTTPServerValidationError) ErrorName() string { return "HTTPServerValidationError" }
+func (e RabbitMQServer_QueueValidationError) ErrorName() string {
+	return "RabbitMQServer_QueueValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e HTTPServerValidationError) Error() string {
+func (e RabbitMQServer_QueueValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -968,14 +4795,14 @@ func (e HTTPServerValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sHTTPServer.%s: %s%s",
+		"invalid %sRabbitMQServer_Queue.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = HTTPServerValidationError{}
+var _ error = RabbitMQServer_QueueValidationError{}
 
 var _ interface {
 	Field() string
@@ -983,101 +4810,87 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = HTTPServerValidationError{}
+} = RabbitMQServer_QueueValidationError{}
 
-var _HTTPServer_Network_InLookup = map[string]struct{}{
-	"tcp":  {},
-	"tcp4": {},
-	"tcp6": {},
+var _RabbitMQServer_Queue_UnknownEvents_InLookup = map[string]struct{}{
+	"":            {},
+	"ignore":      {},
+	"dead_letter": {},
 }
 
-// Validate checks the field values on GRPCServer with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GRPCServer) Validate() error {
+// Validate checks the field values on RabbitMQServer_Retry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RabbitMQServer_Retry) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GRPCServer with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GRPCServerMultiError, or
-// nil if none found.
-func (m *GRPCServer) ValidateAll() error {
+// ValidateAll checks the field values on RabbitMQServer_Retry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RabbitMQServer_RetryMultiError, or nil if none found.
+func (m *RabbitMQServer_Retry) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GRPCServer) validate(all bool) error {
+func (m *RabbitMQServer_Retry) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if _, ok := _GRPCServer_Network_InLookup[m.GetNetwork()]; !ok {
-		err := GRPCServerValidationError{
-			field:  "Network",
-			reason: "value must be in list [tcp tcp4 tcp6]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetAddr()) < 1 {
-		err := GRPCServerValidationError{
-			field:  "Addr",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if d := m.GetTimeout(); d != nil {
-		dur, err := d.AsDuration(), d.CheckValid()
-		if err != nil {
-			err = GRPCServerValidationError{
-				field:  "Timeout",
-				reason: "value is not a valid duration",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-
-			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+	for idx, item := range m.GetDelays() {
+		_, _ = idx, item
 
-			if dur <= gt {
-				err := GRPCServerValidationError{
-					field:  "Timeout",
-					reason: "value must be greater than 0s",
+		if d := item; d != nil {
+			dur, err := d.AsDuration(), d.CheckValid()
+			if err != nil {
+				err = RabbitMQServer_RetryValidationError{
+					field:  fmt.Sprintf("Delays[%v]", idx),
+					reason: "value is not a valid duration",
+					cause:  err,
 				}
 				if !all {
 					return err
 				}
 				errors = append(errors, err)
-			}
+			} else {
+
+				gte := time.Duration(1*time.Second + 0*time.Nanosecond)
+
+				if dur < gte {
+					err := RabbitMQServer_RetryValidationError{
+						field:  fmt.Sprintf("Delays[%v]", idx),
+						reason: "value must be greater than or equal to 1s",
+					}
+					if !all {
+						return err
+					}
+					errors = append(errors, err)
+				}
 
+			}
 		}
+
 	}
 
+	// no validation rules for MaxAttempts
+
 	if len(errors) > 0 {
-		return GRPCServerMultiError(errors)
+		return RabbitMQServer_RetryMultiError(errors)
 	}
 
 	return nil
 }
 
-// GRPCServerMultiError is an error wrapping multiple validation errors
-// returned by GRPCServer.ValidateAll() if the designated constraints aren't met.
-type GRPCServerMultiError []error
+// RabbitMQServer_RetryMultiError is an error wrapping multiple validation
+// errors returned by RabbitMQServer_Retry.ValidateAll() if the designated
+// constraints aren't met.
+type RabbitMQServer_RetryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GRPCServerMultiError) Error() string {
+func (m RabbitMQServer_RetryMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1086,11 +4899,11 @@ func (m GRPCServerMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GRPCServerMultiError) AllErrors() []error { return m }
+func (m RabbitMQServer_RetryMultiError) AllErrors() []error { return m }
 
-// GRPCServerValidationError is the validation error returned by
-// GRPCServer.Validate if the designated constraints aren't met.
-type GRPCServerValidationError struct {
+// RabbitMQServer_RetryValidationError is the validation error returned by
+// RabbitMQServer_Retry.Validate if the designated constraints aren't met.
+type RabbitMQServer_RetryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1098,22 +4911,24 @@ type GRPCServerValidationError struct {
 }
 
 // Field function returns field value.
-func (e GRPCServerValidationError) Field() string { return e.field }
+func (e RabbitMQServer_RetryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GRPCServerValidationError) Reason() string { return e.reason }
+func (e RabbitMQServer_RetryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GRPCServerValidationError) Cause() error { return e.cause }
+func (e RabbitMQServer_RetryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GRPCServerValidationError) Key() bool { return e.key }
+func (e RabbitMQServer_RetryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GRPCServerValidationError) ErrorName() string { return "GRPCServerValidationError" }
+func (e RabbitMQServer_RetryValidationError) ErrorName() string {
+	return "RabbitMQServer_RetryValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GRPCServerValidationError) Error() string {
+func (e RabbitMQServer_RetryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1125,14 +4940,14 @@ func (e GRPCServerValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGRPCServer.%s: %s%s",
+		"invalid %sRabbitMQServer_Retry.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GRPCServerValidationError{}
+var _ error = RabbitMQServer_RetryValidationError{}
 
 var _ interface {
 	Field() string
@@ -1140,102 +4955,65 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GRPCServerValidationError{}
-
-var _GRPCServer_Network_InLookup = map[string]struct{}{
-	"tcp":  {},
-	"tcp4": {},
-	"tcp6": {},
-}
+} = RabbitMQServer_RetryValidationError{}
 
-// Validate checks the field values on Database with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Database) Validate() error {
+// Validate checks the field values on RabbitMQServer_DeadLetter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RabbitMQServer_DeadLetter) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Database with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DatabaseMultiError, or nil
-// if none found.
-func (m *Database) ValidateAll() error {
+// ValidateAll checks the field values on RabbitMQServer_DeadLetter with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RabbitMQServer_DeadLetterMultiError, or nil if none found.
+func (m *RabbitMQServer_DeadLetter) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Database) validate(all bool) error {
+func (m *RabbitMQServer_DeadLetter) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetDriver()) < 1 {
-		err := DatabaseValidationError{
-			field:  "Driver",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetSource()) < 1 {
-		err := DatabaseValidationError{
-			field:  "Source",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if all {
-		switch v := interface{}(m.GetRunMigrations()).(type) {
+		switch v := interface{}(m.GetEnabled()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DatabaseValidationError{
-					field:  "RunMigrations",
+				errors = append(errors, RabbitMQServer_DeadLetterValidationError{
+					field:  "Enabled",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DatabaseValidationError{
-					field:  "RunMigrations",
+				errors = append(errors, RabbitMQServer_DeadLetterValidationError{
+					field:  "Enabled",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetRunMigrations()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetEnabled()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DatabaseValidationError{
-				field:  "RunMigrations",
+			return RabbitMQServer_DeadLetterValidationError{
+				field:  "Enabled",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if m.GetMaxIdleConns() < 0 {
-		err := DatabaseValidationError{
-			field:  "MaxIdleConns",
-			reason: "value must be greater than or equal to 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Exchange
 
-	if m.GetMaxOpenConns() <= 0 {
-		err := DatabaseValidationError{
-			field:  "MaxOpenConns",
-			reason: "value must be greater than 0",
+	if utf8.RuneCountInString(m.GetQueue()) < 1 {
+		err := RabbitMQServer_DeadLetterValidationError{
+			field:  "Queue",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -1243,49 +5021,20 @@ func (m *Database) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if d := m.GetConnMaxLifetime(); d != nil {
-		dur, err := d.AsDuration(), d.CheckValid()
-		if err != nil {
-			err = DatabaseValidationError{
-				field:  "ConnMaxLifetime",
-				reason: "value is not a valid duration",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-
-			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
-
-			if dur <= gt {
-				err := DatabaseValidationError{
-					field:  "ConnMaxLifetime",
-					reason: "value must be greater than 0s",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
-
-		}
-	}
-
 	if len(errors) > 0 {
-		return DatabaseMultiError(errors)
+		return RabbitMQServer_DeadLetterMultiError(errors)
 	}
 
 	return nil
 }
 
-// DatabaseMultiError is an error wrapping multiple validation errors returned
-// by Database.ValidateAll() if the designated constraints aren't met.
-type DatabaseMultiError []error
+// RabbitMQServer_DeadLetterMultiError is an error wrapping multiple validation
+// errors returned by RabbitMQServer_DeadLetter.ValidateAll() if the
+// designated constraints aren't met.
+type RabbitMQServer_DeadLetterMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DatabaseMultiError) Error() string {
+func (m RabbitMQServer_DeadLetterMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1294,11 +5043,11 @@ func (m DatabaseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DatabaseMultiError) AllErrors() []error { return m }
+func (m RabbitMQServer_DeadLetterMultiError) AllErrors() []error { return m }
 
-// DatabaseValidationError is the validation error returned by
-// Database.Validate if the designated constraints aren't met.
-type DatabaseValidationError struct {
+// RabbitMQServer_DeadLetterValidationError is the validation error returned by
+// RabbitMQServer_DeadLetter.Validate if the designated constraints aren't met.
+type RabbitMQServer_DeadLetterValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1306,22 +5055,24 @@ type DatabaseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DatabaseValidationError) Field() string { return e.field }
+func (e RabbitMQServer_DeadLetterValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DatabaseValidationError) Reason() string { return e.reason }
+func (e RabbitMQServer_DeadLetterValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DatabaseValidationError) Cause() error { return e.cause }
+func (e RabbitMQServer_DeadLetterValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DatabaseValidationError) Key() bool { return e.key }
+func (e RabbitMQServer_DeadLetterValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DatabaseValidationError) ErrorName() string { return "DatabaseValidationError" }
+func (e RabbitMQServer_DeadLetterValidationError) ErrorName() string {
+	return "RabbitMQServer_DeadLetterValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DatabaseValidationError) Error() string {
+func (e RabbitMQServer_DeadLetterValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1333,14 +5084,14 @@ func (e DatabaseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDatabase.%s: %s%s",
+		"invalid %sRabbitMQServer_DeadLetter.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DatabaseValidationError{}
+var _ error = RabbitMQServer_DeadLetterValidationError{}
 
 var _ interface {
 	Field() string
@@ -1348,134 +5099,93 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DatabaseValidationError{}
+} = RabbitMQServer_DeadLetterValidationError{}
 
-// Validate checks the field values on RabbitMQServer with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *RabbitMQServer) Validate() error {
+// Validate checks the field values on RabbitMQServer_Inbox with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RabbitMQServer_Inbox) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RabbitMQServer with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RabbitMQServerMultiError,
-// or nil if none found.
-func (m *RabbitMQServer) ValidateAll() error {
+// ValidateAll checks the field values on RabbitMQServer_Inbox with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RabbitMQServer_InboxMultiError, or nil if none found.
+func (m *RabbitMQServer_Inbox) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RabbitMQServer) validate(all bool) error {
+func (m *RabbitMQServer_Inbox) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetAddr()) < 1 {
-		err := RabbitMQServerValidationError{
-			field:  "Addr",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if !_RabbitMQServer_Addr_Pattern.MatchString(m.GetAddr()) {
-		err := RabbitMQServerValidationError{
-			field:  "Addr",
-			reason: "value does not match regex pattern \"^amqps?://.*\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if d := m.GetDialTimeout(); d != nil {
-		dur, err := d.AsDuration(), d.CheckValid()
-		if err != nil {
-			err = RabbitMQServerValidationError{
-				field:  "DialTimeout",
-				reason: "value is not a valid duration",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-
-			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
-
-			if dur <= gt {
-				err := RabbitMQServerValidationError{
-					field:  "DialTimeout",
-					reason: "value must be greater than 0s",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
-
-		}
-	}
-
 	if all {
-		switch v := interface{}(m.GetExchange()).(type) {
+		switch v := interface{}(m.GetEnabled()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RabbitMQServerValidationError{
-					field:  "Exchange",
+				errors = append(errors, RabbitMQServer_InboxValidationError{
+					field:  "Enabled",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RabbitMQServerValidationError{
-					field:  "Exchange",
+				errors = append(errors, RabbitMQServer_InboxValidationError{
+					field:  "Enabled",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetExchange()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetEnabled()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RabbitMQServerValidationError{
-				field:  "Exchange",
+			return RabbitMQServer_InboxValidationError{
+				field:  "Enabled",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if _, ok := _RabbitMQServer_Inbox_Backend_InLookup[m.GetBackend()]; !ok {
+		err := RabbitMQServer_InboxValidationError{
+			field:  "Backend",
+			reason: "value must be in list [ sql memory]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if all {
-		switch v := interface{}(m.GetQueue()).(type) {
+		switch v := interface{}(m.GetTtl()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RabbitMQServerValidationError{
-					field:  "Queue",
+				errors = append(errors, RabbitMQServer_InboxValidationError{
+					field:  "Ttl",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RabbitMQServerValidationError{
-					field:  "Queue",
+				errors = append(errors, RabbitMQServer_InboxValidationError{
+					field:  "Ttl",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetQueue()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTtl()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RabbitMQServerValidationError{
-				field:  "Queue",
+			return RabbitMQServer_InboxValidationError{
+				field:  "Ttl",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1483,19 +5193,19 @@ func (m *RabbitMQServer) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return RabbitMQServerMultiError(errors)
+		return RabbitMQServer_InboxMultiError(errors)
 	}
 
 	return nil
 }
 
-// RabbitMQServerMultiError is an error wrapping multiple validation errors
-// returned by RabbitMQServer.ValidateAll() if the designated constraints
-// aren't met.
-type RabbitMQServerMultiError []error
+// RabbitMQServer_InboxMultiError is an error wrapping multiple validation
+// errors returned by RabbitMQServer_Inbox.ValidateAll() if the designated
+// constraints aren't met.
+type RabbitMQServer_InboxMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RabbitMQServerMultiError) Error() string {
+func (m RabbitMQServer_InboxMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1504,11 +5214,11 @@ func (m RabbitMQServerMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RabbitMQServerMultiError) AllErrors() []error { return m }
+func (m RabbitMQServer_InboxMultiError) AllErrors() []error { return m }
 
-// RabbitMQServerValidationError is the validation error returned by
-// RabbitMQServer.Validate if the designated constraints aren't met.
-type RabbitMQServerValidationError struct {
+// RabbitMQServer_InboxValidationError is the validation error returned by
+// RabbitMQServer_Inbox.Validate if the designated constraints aren't met.
+type RabbitMQServer_InboxValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1516,22 +5226,24 @@ type RabbitMQServerValidationError struct {
 }
 
 // Field function returns field value.
-func (e RabbitMQServerValidationError) Field() string { return e.field }
+func (e RabbitMQServer_InboxValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RabbitMQServerValidationError) Reason() string { return e.reason }
+func (e RabbitMQServer_InboxValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RabbitMQServerValidationError) Cause() error { return e.cause }
+func (e RabbitMQServer_InboxValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RabbitMQServerValidationError) Key() bool { return e.key }
+func (e RabbitMQServer_InboxValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RabbitMQServerValidationError) ErrorName() string { return "RabbitMQServerValidationError" }
+func (e RabbitMQServer_InboxValidationError) ErrorName() string {
+	return "RabbitMQServer_InboxValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RabbitMQServerValidationError) Error() string {
+func (e RabbitMQServer_InboxValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1543,14 +5255,14 @@ func (e RabbitMQServerValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRabbitMQServer.%s: %s%s",
+		"invalid %sRabbitMQServer_Inbox.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RabbitMQServerValidationError{}
+var _ error = RabbitMQServer_InboxValidationError{}
 
 var _ interface {
 	Field() string
@@ -1558,56 +5270,108 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RabbitMQServerValidationError{}
+} = RabbitMQServer_InboxValidationError{}
 
-var _RabbitMQServer_Addr_Pattern = regexp.MustCompile("^amqps?://.*")
+var _RabbitMQServer_Inbox_Backend_InLookup = map[string]struct{}{
+	"":       {},
+	"sql":    {},
+	"memory": {},
+}
 
-// Validate checks the field values on LogConfig with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *LogConfig) Validate() error {
+// Validate checks the field values on RabbitMQServer_Publisher with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RabbitMQServer_Publisher) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LogConfig with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in LogConfigMultiError, or nil
-// if none found.
-func (m *LogConfig) ValidateAll() error {
+// ValidateAll checks the field values on RabbitMQServer_Publisher with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RabbitMQServer_PublisherMultiError, or nil if none found.
+func (m *RabbitMQServer_Publisher) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LogConfig) validate(all bool) error {
+func (m *RabbitMQServer_Publisher) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if _, ok := _LogConfig_Level_InLookup[m.GetLevel()]; !ok {
-		err := LogConfigValidationError{
-			field:  "Level",
-			reason: "value must be in list [debug info warn error]",
+	if all {
+		switch v := interface{}(m.GetConfirmDelivery()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RabbitMQServer_PublisherValidationError{
+					field:  "ConfirmDelivery",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RabbitMQServer_PublisherValidationError{
+					field:  "ConfirmDelivery",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetConfirmDelivery()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RabbitMQServer_PublisherValidationError{
+				field:  "ConfirmDelivery",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCircuitBreaker()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RabbitMQServer_PublisherValidationError{
+					field:  "CircuitBreaker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RabbitMQServer_PublisherValidationError{
+					field:  "CircuitBreaker",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCircuitBreaker()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RabbitMQServer_PublisherValidationError{
+				field:  "CircuitBreaker",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return LogConfigMultiError(errors)
+		return RabbitMQServer_PublisherMultiError(errors)
 	}
 
 	return nil
 }
 
-// LogConfigMultiError is an error wrapping multiple validation errors returned
-// by LogConfig.ValidateAll() if the designated constraints aren't met.
-type LogConfigMultiError []error
+// RabbitMQServer_PublisherMultiError is an error wrapping multiple validation
+// errors returned by RabbitMQServer_Publisher.ValidateAll() if the designated
+// constraints aren't met.
+type RabbitMQServer_PublisherMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LogConfigMultiError) Error() string {
+func (m RabbitMQServer_PublisherMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1616,11 +5380,11 @@ func (m LogConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LogConfigMultiError) AllErrors() []error { return m }
+func (m RabbitMQServer_PublisherMultiError) AllErrors() []error { return m }
 
-// LogConfigValidationError is the validation error returned by
-// LogConfig.Validate if the designated constraints aren't met.
-type LogConfigValidationError struct {
+// RabbitMQServer_PublisherValidationError is the validation error returned by
+// RabbitMQServer_Publisher.Validate if the designated constraints aren't met.
+type RabbitMQServer_PublisherValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1628,22 +5392,24 @@ type LogConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e LogConfigValidationError) Field() string { return e.field }
+func (e RabbitMQServer_PublisherValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LogConfigValidationError) Reason() string { return e.reason }
+func (e RabbitMQServer_PublisherValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LogConfigValidationError) Cause() error { return e.cause }
+func (e RabbitMQServer_PublisherValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LogConfigValidationError) Key() bool { return e.key }
+func (e RabbitMQServer_PublisherValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LogConfigValidationError) ErrorName() string { return "LogConfigValidationError" }
+func (e RabbitMQServer_PublisherValidationError) ErrorName() string {
+	return "RabbitMQServer_PublisherValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e LogConfigValidationError) Error() string {
+func (e RabbitMQServer_PublisherValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1655,14 +5421,14 @@ func (e LogConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLogConfig.%s: %s%s",
+		"invalid %sRabbitMQServer_Publisher.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LogConfigValidationError{}
+var _ error = RabbitMQServer_PublisherValidationError{}
 
 var _ interface {
 	Field() string
@@ -1670,92 +5436,126 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LogConfigValidationError{}
-
-var _LogConfig_Level_InLookup = map[string]struct{}{
-	"debug": {},
-	"info":  {},
-	"warn":  {},
-	"error": {},
-}
+} = RabbitMQServer_PublisherValidationError{}
 
-// Validate checks the field values on Metrics with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Metrics) Validate() error {
+// Validate checks the field values on RabbitMQServer_CircuitBreaker with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RabbitMQServer_CircuitBreaker) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Metrics with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in MetricsMultiError, or nil if none found.
-func (m *Metrics) ValidateAll() error {
+// ValidateAll checks the field values on RabbitMQServer_CircuitBreaker with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RabbitMQServer_CircuitBreakerMultiError, or nil if none found.
+func (m *RabbitMQServer_CircuitBreaker) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Metrics) validate(all bool) error {
+func (m *RabbitMQServer_CircuitBreaker) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEnabled()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RabbitMQServer_CircuitBreakerValidationError{
+					field:  "Enabled",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RabbitMQServer_CircuitBreakerValidationError{
+					field:  "Enabled",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnabled()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RabbitMQServer_CircuitBreakerValidationError{
+				field:  "Enabled",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FailureThreshold
 
 	if all {
-		switch v := interface{}(m.GetEnabled()).(type) {
+		switch v := interface{}(m.GetOpenTimeout()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsValidationError{
-					field:  "Enabled",
+				errors = append(errors, RabbitMQServer_CircuitBreakerValidationError{
+					field:  "OpenTimeout",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsValidationError{
-					field:  "Enabled",
+				errors = append(errors, RabbitMQServer_CircuitBreakerValidationError{
+					field:  "OpenTimeout",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetEnabled()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetOpenTimeout()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsValidationError{
-				field:  "Enabled",
+			return RabbitMQServer_CircuitBreakerValidationError{
+				field:  "OpenTimeout",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for ServiceName
+	if _, ok := _RabbitMQServer_CircuitBreaker_Policy_InLookup[m.GetPolicy()]; !ok {
+		err := RabbitMQServer_CircuitBreakerValidationError{
+			field:  "Policy",
+			reason: "value must be in list [ fail spool]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Path
+	// no validation rules for SpoolDir
 
 	if all {
-		switch v := interface{}(m.GetIncludeRuntime()).(type) {
+		switch v := interface{}(m.GetSpoolRetryInterval()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MetricsValidationError{
-					field:  "IncludeRuntime",
+				errors = append(errors, RabbitMQServer_CircuitBreakerValidationError{
+					field:  "SpoolRetryInterval",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, MetricsValidationError{
-					field:  "IncludeRuntime",
+				errors = append(errors, RabbitMQServer_CircuitBreakerValidationError{
+					field:  "SpoolRetryInterval",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetIncludeRuntime()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSpoolRetryInterval()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return MetricsValidationError{
-				field:  "IncludeRuntime",
+			return RabbitMQServer_CircuitBreakerValidationError{
+				field:  "SpoolRetryInterval",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1763,18 +5563,19 @@ func (m *Metrics) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return MetricsMultiError(errors)
+		return RabbitMQServer_CircuitBreakerMultiError(errors)
 	}
 
 	return nil
 }
 
-// MetricsMultiError is an error wrapping multiple validation errors returned
-// by Metrics.ValidateAll() if the designated constraints aren't met.
-type MetricsMultiError []error
+// RabbitMQServer_CircuitBreakerMultiError is an error wrapping multiple
+// validation errors returned by RabbitMQServer_CircuitBreaker.ValidateAll()
+// if the designated constraints aren't met.
+type RabbitMQServer_CircuitBreakerMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MetricsMultiError) Error() string {
+func (m RabbitMQServer_CircuitBreakerMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1783,11 +5584,12 @@ func (m MetricsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MetricsMultiError) AllErrors() []error { return m }
+func (m RabbitMQServer_CircuitBreakerMultiError) AllErrors() []error { return m }
 
-// MetricsValidationError is the validation error returned by Metrics.Validate
-// if the designated constraints aren't met.
-type MetricsValidationError struct {
+// RabbitMQServer_CircuitBreakerValidationError is the validation error
+// returned by RabbitMQServer_CircuitBreaker.Validate if the designated
+// constraints aren't met.
+type RabbitMQServer_CircuitBreakerValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1795,22 +5597,24 @@ type MetricsValidationError struct {
 }
 
 // Field function returns field value.
-func (e MetricsValidationError) Field() string { return e.field }
+func (e RabbitMQServer_CircuitBreakerValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MetricsValidationError) Reason() string { return e.reason }
+func (e RabbitMQServer_CircuitBreakerValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MetricsValidationError) Cause() error { return e.cause }
+func (e RabbitMQServer_CircuitBreakerValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MetricsValidationError) Key() bool { return e.key }
+func (e RabbitMQServer_CircuitBreakerValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MetricsValidationError) ErrorName() string { return "MetricsValidationError" }
+func (e RabbitMQServer_CircuitBreakerValidationError) ErrorName() string {
+	return "RabbitMQServer_CircuitBreakerValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MetricsValidationError) Error() string {
+func (e RabbitMQServer_CircuitBreakerValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1822,14 +5626,14 @@ func (e MetricsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMetrics.%s: %s%s",
+		"invalid %sRabbitMQServer_CircuitBreaker.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MetricsValidationError{}
+var _ error = RabbitMQServer_CircuitBreakerValidationError{}
 
 var _ interface {
 	Field() string
@@ -1837,75 +5641,59 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MetricsValidationError{}
+} = RabbitMQServer_CircuitBreakerValidationError{}
 
-// Validate checks the field values on RabbitMQServer_Exchange with the rules
+var _RabbitMQServer_CircuitBreaker_Policy_InLookup = map[string]struct{}{
+	"":      {},
+	"fail":  {},
+	"spool": {},
+}
+
+// Validate checks the field values on RabbitMQServer_Reconnect with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RabbitMQServer_Exchange) Validate() error {
+func (m *RabbitMQServer_Reconnect) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RabbitMQServer_Exchange with the
+// ValidateAll checks the field values on RabbitMQServer_Reconnect with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RabbitMQServer_ExchangeMultiError, or nil if none found.
-func (m *RabbitMQServer_Exchange) ValidateAll() error {
+// RabbitMQServer_ReconnectMultiError, or nil if none found.
+func (m *RabbitMQServer_Reconnect) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RabbitMQServer_Exchange) validate(all bool) error {
+func (m *RabbitMQServer_Reconnect) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := RabbitMQServer_ExchangeValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if _, ok := _RabbitMQServer_Exchange_Type_InLookup[m.GetType()]; !ok {
-		err := RabbitMQServer_ExchangeValidationError{
-			field:  "Type",
-			reason: "value must be in list [direct topic fanout headers]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if all {
-		switch v := interface{}(m.GetDurable()).(type) {
+		switch v := interface{}(m.GetInitialInterval()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RabbitMQServer_ExchangeValidationError{
-					field:  "Durable",
+				errors = append(errors, RabbitMQServer_ReconnectValidationError{
+					field:  "InitialInterval",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RabbitMQServer_ExchangeValidationError{
-					field:  "Durable",
+				errors = append(errors, RabbitMQServer_ReconnectValidationError{
+					field:  "InitialInterval",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDurable()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetInitialInterval()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RabbitMQServer_ExchangeValidationError{
-				field:  "Durable",
+			return RabbitMQServer_ReconnectValidationError{
+				field:  "InitialInterval",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1913,48 +5701,59 @@ func (m *RabbitMQServer_Exchange) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetAutoDelete()).(type) {
+		switch v := interface{}(m.GetMaxInterval()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RabbitMQServer_ExchangeValidationError{
-					field:  "AutoDelete",
+				errors = append(errors, RabbitMQServer_ReconnectValidationError{
+					field:  "MaxInterval",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RabbitMQServer_ExchangeValidationError{
-					field:  "AutoDelete",
+				errors = append(errors, RabbitMQServer_ReconnectValidationError{
+					field:  "MaxInterval",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetAutoDelete()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetMaxInterval()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RabbitMQServer_ExchangeValidationError{
-				field:  "AutoDelete",
+			return RabbitMQServer_ReconnectValidationError{
+				field:  "MaxInterval",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if m.GetMultiplier() < 0 {
+		err := RabbitMQServer_ReconnectValidationError{
+			field:  "Multiplier",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return RabbitMQServer_ExchangeMultiError(errors)
+		return RabbitMQServer_ReconnectMultiError(errors)
 	}
 
 	return nil
 }
 
-// RabbitMQServer_ExchangeMultiError is an error wrapping multiple validation
-// errors returned by RabbitMQServer_Exchange.ValidateAll() if the designated
+// RabbitMQServer_ReconnectMultiError is an error wrapping multiple validation
+// errors returned by RabbitMQServer_Reconnect.ValidateAll() if the designated
 // constraints aren't met.
-type RabbitMQServer_ExchangeMultiError []error
+type RabbitMQServer_ReconnectMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RabbitMQServer_ExchangeMultiError) Error() string {
+func (m RabbitMQServer_ReconnectMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1963,11 +5762,11 @@ func (m RabbitMQServer_ExchangeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RabbitMQServer_ExchangeMultiError) AllErrors() []error { return m }
+func (m RabbitMQServer_ReconnectMultiError) AllErrors() []error { return m }
 
-// RabbitMQServer_ExchangeValidationError is the validation error returned by
-// RabbitMQServer_Exchange.Validate if the designated constraints aren't met.
-type RabbitMQServer_ExchangeValidationError struct {
+// RabbitMQServer_ReconnectValidationError is the validation error returned by
+// RabbitMQServer_Reconnect.Validate if the designated constraints aren't met.
+type RabbitMQServer_ReconnectValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1975,24 +5774,24 @@ type RabbitMQServer_ExchangeValidationError struct {
 }
 
 // Field function returns field value.
-func (e RabbitMQServer_ExchangeValidationError) Field() string { return e.field }
+func (e RabbitMQServer_ReconnectValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RabbitMQServer_ExchangeValidationError) Reason() string { return e.reason }
+func (e RabbitMQServer_ReconnectValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RabbitMQServer_ExchangeValidationError) Cause() error { return e.cause }
+func (e RabbitMQServer_ReconnectValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RabbitMQServer_ExchangeValidationError) Key() bool { return e.key }
+func (e RabbitMQServer_ReconnectValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RabbitMQServer_ExchangeValidationError) ErrorName() string {
-	return "RabbitMQServer_ExchangeValidationError"
+func (e RabbitMQServer_ReconnectValidationError) ErrorName() string {
+	return "RabbitMQServer_ReconnectValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RabbitMQServer_ExchangeValidationError) Error() string {
+func (e RabbitMQServer_ReconnectValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2004,14 +5803,14 @@ func (e RabbitMQServer_ExchangeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRabbitMQServer_Exchange.%s: %s%s",
+		"invalid %sRabbitMQServer_Reconnect.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RabbitMQServer_ExchangeValidationError{}
+var _ error = RabbitMQServer_ReconnectValidationError{}
 
 var _ interface {
 	Field() string
@@ -2019,41 +5818,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RabbitMQServer_ExchangeValidationError{}
+} = RabbitMQServer_ReconnectValidationError{}
 
-var _RabbitMQServer_Exchange_Type_InLookup = map[string]struct{}{
-	"direct":  {},
-	"topic":   {},
-	"fanout":  {},
-	"headers": {},
-}
-
-// Validate checks the field values on RabbitMQServer_Queue with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RabbitMQServer_Queue_Handler with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RabbitMQServer_Queue) Validate() error {
+func (m *RabbitMQServer_Queue_Handler) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RabbitMQServer_Queue with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RabbitMQServer_Queue_Handler with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RabbitMQServer_QueueMultiError, or nil if none found.
-func (m *RabbitMQServer_Queue) ValidateAll() error {
+// RabbitMQServer_Queue_HandlerMultiError, or nil if none found.
+func (m *RabbitMQServer_Queue_Handler) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RabbitMQServer_Queue) validate(all bool) error {
+func (m *RabbitMQServer_Queue_Handler) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := RabbitMQServer_QueueValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 runes",
+	if m.GetWorkerCount() <= 0 {
+		err := RabbitMQServer_Queue_HandlerValidationError{
+			field:  "WorkerCount",
+			reason: "value must be greater than 0",
 		}
 		if !all {
 			return err
@@ -2062,130 +5854,48 @@ func (m *RabbitMQServer_Queue) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetDurable()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RabbitMQServer_QueueValidationError{
-					field:  "Durable",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RabbitMQServer_QueueValidationError{
-					field:  "Durable",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDurable()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RabbitMQServer_QueueValidationError{
-				field:  "Durable",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetAutoDelete()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RabbitMQServer_QueueValidationError{
-					field:  "AutoDelete",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RabbitMQServer_QueueValidationError{
-					field:  "AutoDelete",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAutoDelete()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RabbitMQServer_QueueValidationError{
-				field:  "AutoDelete",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetExclusive()).(type) {
+		switch v := interface{}(m.GetRetry()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RabbitMQServer_QueueValidationError{
-					field:  "Exclusive",
+				errors = append(errors, RabbitMQServer_Queue_HandlerValidationError{
+					field:  "Retry",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RabbitMQServer_QueueValidationError{
-					field:  "Exclusive",
+				errors = append(errors, RabbitMQServer_Queue_HandlerValidationError{
+					field:  "Retry",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetExclusive()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRetry()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RabbitMQServer_QueueValidationError{
-				field:  "Exclusive",
+			return RabbitMQServer_Queue_HandlerValidationError{
+				field:  "Retry",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for BindingKey
-
-	if m.GetPrefetchCount() <= 0 {
-		err := RabbitMQServer_QueueValidationError{
-			field:  "PrefetchCount",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetWorkerCount() <= 0 {
-		err := RabbitMQServer_QueueValidationError{
-			field:  "WorkerCount",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return RabbitMQServer_QueueMultiError(errors)
+		return RabbitMQServer_Queue_HandlerMultiError(errors)
 	}
 
 	return nil
 }
 
-// RabbitMQServer_QueueMultiError is an error wrapping multiple validation
-// errors returned by RabbitMQServer_Queue.ValidateAll() if the designated
-// constraints aren't met.
-type RabbitMQServer_QueueMultiError []error
+// RabbitMQServer_Queue_HandlerMultiError is an error wrapping multiple
+// validation errors returned by RabbitMQServer_Queue_Handler.ValidateAll() if
+// the designated constraints aren't met.
+type RabbitMQServer_Queue_HandlerMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RabbitMQServer_QueueMultiError) Error() string {
+func (m RabbitMQServer_Queue_HandlerMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2194,11 +5904,12 @@ func (m RabbitMQServer_QueueMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RabbitMQServer_QueueMultiError) AllErrors() []error { return m }
+func (m RabbitMQServer_Queue_HandlerMultiError) AllErrors() []error { return m }
 
-// RabbitMQServer_QueueValidationError is the validation error returned by
-// RabbitMQServer_Queue.Validate if the designated constraints aren't met.
-type RabbitMQServer_QueueValidationError struct {
+// RabbitMQServer_Queue_HandlerValidationError is the validation error returned
+// by RabbitMQServer_Queue_Handler.Validate if the designated constraints
+// aren't met.
+type RabbitMQServer_Queue_HandlerValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2206,24 +5917,24 @@ type RabbitMQServer_QueueValidationError struct {
 }
 
 // Field function returns field value.
-func (e RabbitMQServer_QueueValidationError) Field() string { return e.field }
+func (e RabbitMQServer_Queue_HandlerValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RabbitMQServer_QueueValidationError) Reason() string { return e.reason }
+func (e RabbitMQServer_Queue_HandlerValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RabbitMQServer_QueueValidationError) Cause() error { return e.cause }
+func (e RabbitMQServer_Queue_HandlerValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RabbitMQServer_QueueValidationError) Key() bool { return e.key }
+func (e RabbitMQServer_Queue_HandlerValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RabbitMQServer_QueueValidationError) ErrorName() string {
-	return "RabbitMQServer_QueueValidationError"
+func (e RabbitMQServer_Queue_HandlerValidationError) ErrorName() string {
+	return "RabbitMQServer_Queue_HandlerValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RabbitMQServer_QueueValidationError) Error() string {
+func (e RabbitMQServer_Queue_HandlerValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2235,14 +5946,14 @@ func (e RabbitMQServer_QueueValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRabbitMQServer_Queue.%s: %s%s",
+		"invalid %sRabbitMQServer_Queue_Handler.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RabbitMQServer_QueueValidationError{}
+var _ error = RabbitMQServer_Queue_HandlerValidationError{}
 
 var _ interface {
 	Field() string
@@ -2250,4 +5961,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RabbitMQServer_QueueValidationError{}
+} = RabbitMQServer_Queue_HandlerValidationError{}
@@ -0,0 +1,265 @@
+package data
+
+import (
+	"context"
+	eventsv1 "contracts/gen/events/symbols/v1"
+	"os"
+	"platform/events"
+	platform_logger "platform/logger"
+	"strings"
+	"symbols/internal/biz/domain"
+	"symbols/internal/biz/event"
+	conf "symbols/internal/conf/gen"
+	"symbols/internal/data/mq"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/go-kratos/kratos/v2/log"
+	natsserver "github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// brokerBackend is a broker the conformance suite runs against. setup returns the data config
+// selecting it, with unique names, and the database of the sql broker.
+type brokerBackend struct {
+	name string
+	// unordered brokers may deliver the events of a project out of order
+	unordered bool
+	setup     func(t *testing.T) (*conf.Data, *gorm.DB)
+}
+
+// brokerBackends are the backends of the conformance suite. NATS runs against an embedded server
+// unless NATS_URL points to one with JetStream enabled. The external brokers run when their address
+// is set: AMQP_URL for RabbitMQ and KAFKA_BROKERS (comma separated) for Kafka.
+var brokerBackends = []brokerBackend{
+	{
+		name: mq.BrokerGoChannel,
+		// The GoChannel hands every message to its subscribers from its own goroutine
+		unordered: true,
+		setup: func(t *testing.T) (*conf.Data, *gorm.DB) {
+			return newBrokerConfig(mq.BrokerGoChannel), nil
+		},
+	},
+	{
+		name: mq.BrokerSQL,
+		setup: func(t *testing.T) (*conf.Data, *gorm.DB) {
+			db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+			require.NoError(t, err)
+			// Every connection of an in-memory database is a separate database
+			sqlDB, err := db.DB()
+			require.NoError(t, err)
+			sqlDB.SetMaxOpenConns(1)
+
+			cfg := newBrokerConfig(mq.BrokerSQL)
+			cfg.SqlPubsub = &conf.SQLPubSub{PollInterval: durationpb.New(10 * time.Millisecond)}
+			return cfg, db
+		},
+	},
+	{
+		name: mq.BrokerAMQP,
+		setup: func(t *testing.T) (*conf.Data, *gorm.DB) {
+			addr := os.Getenv("AMQP_URL")
+			if addr == "" {
+				t.Skip("AMQP_URL is not set")
+			}
+
+			cfg := newBrokerConfig(mq.BrokerAMQP)
+			cfg.Mq.Addr = addr
+			return cfg, nil
+		},
+	},
+	{
+		name: mq.BrokerNATS,
+		setup: func(t *testing.T) (*conf.Data, *gorm.DB) {
+			url := os.Getenv("NATS_URL")
+			if url == "" {
+				url = runNATSServer(t)
+			}
+
+			cfg := newBrokerConfig(mq.BrokerNATS)
+			cfg.Nats = &conf.NATS{
+				Url:           url,
+				SubjectPrefix: "conformance_" + watermill.NewShortUUID(),
+				OrderingKey:   mq.OrderingKeyProjectID,
+				AckWait:       durationpb.New(time.Second),
+			}
+			t.Cleanup(func() {
+				nc, err := nats.Connect(url)
+				require.NoError(t, err)
+				defer nc.Close()
+				js, err := jetstream.New(nc)
+				require.NoError(t, err)
+				_ = js.DeleteStream(context.Background(), cfg.Mq.Exchange.Name)
+			})
+			return cfg, nil
+		},
+	},
+	{
+		name: mq.BrokerKafka,
+		setup: func(t *testing.T) (*conf.Data, *gorm.DB) {
+			brokers := os.Getenv("KAFKA_BROKERS")
+			if brokers == "" {
+				t.Skip("KAFKA_BROKERS is not set")
+			}
+
+			cfg := newBrokerConfig(mq.BrokerKafka)
+			cfg.Kafka = &conf.Kafka{
+				Brokers:     strings.Split(brokers, ","),
+				OrderingKey: mq.OrderingKeyProjectID,
+			}
+			t.Cleanup(func() {
+				admin, err := sarama.NewClusterAdmin(cfg.Kafka.Brokers, sarama.NewConfig())
+				require.NoError(t, err)
+				defer admin.Close()
+				_ = admin.DeleteTopic(cfg.Mq.Exchange.Name)
+			})
+			return cfg, nil
+		},
+	},
+}
+
+// runNATSServer starts an embedded NATS server with JetStream for the test and returns its URL.
+func runNATSServer(t *testing.T) string {
+	s, err := natsserver.NewServer(&natsserver.Options{
+		Host:      "127.0.0.1",
+		Port:      natsserver.RANDOM_PORT,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	require.NoError(t, err)
+
+	go s.Start()
+	t.Cleanup(s.Shutdown)
+	if !s.ReadyForConnections(10 * time.Second) {
+		t.Fatal("the embedded NATS server did not start")
+	}
+
+	return s.ClientURL()
+}
+
+// newBrokerConfig returns the data config of broker with unique exchange and queue names.
+func newBrokerConfig(broker string) *conf.Data {
+	id := watermill.NewShortUUID()
+	return &conf.Data{
+		Broker: broker,
+		Mq: &conf.RabbitMQServer{
+			Exchange: &conf.RabbitMQServer_Exchange{
+				Name:       "conformance_" + id,
+				Type:       "fanout",
+				Durable:    wrapperspb.Bool(false),
+				AutoDelete: wrapperspb.Bool(true),
+			},
+			Queue: &conf.RabbitMQServer_Queue{
+				Name:       "conformance_queue_" + id,
+				Durable:    wrapperspb.Bool(false),
+				AutoDelete: wrapperspb.Bool(true),
+				Exclusive:  wrapperspb.Bool(false),
+				BindingKey: "#",
+			},
+		},
+	}
+}
+
+// subscribeBroker subscribes the worker topic of the backend and returns its event publisher.
+func subscribeBroker(t *testing.T, backend brokerBackend) (domain.SymbolEventPublisher, <-chan *message.Message) {
+	logger := log.NewStdLogger(os.Stdout)
+	wmLogger := platform_logger.NewWatermillLogger(logger)
+	cfg, db := backend.setup(t)
+
+	goChannel, cleanup := NewGoChannel(cfg, logger, wmLogger)
+	t.Cleanup(cleanup)
+
+	sub := NewSubscriber(cfg, goChannel, db, logger, wmLogger)
+	pub, closePub := NewPublisher(cfg, goChannel, db, nil, logger, wmLogger)
+	t.Cleanup(func() {
+		closePub()
+		_ = sub.Close()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	msgs, err := sub.Subscribe(ctx, cfg.Mq.Exchange.Name)
+	require.NoError(t, err)
+
+	return mq.NewEventPublisher(pub, logger), msgs
+}
+
+// receiveEvent returns the next message of msgs, failing the test when none arrives.
+func receiveEvent(t *testing.T, msgs <-chan *message.Message) *message.Message {
+	select {
+	case msg := <-msgs:
+		return msg
+	case <-time.After(10 * time.Second):
+		t.Fatal("no message received")
+		return nil
+	}
+}
+
+// TestBrokerConformance checks that every broker delivers the symbol events the same way.
+func TestBrokerConformance(t *testing.T) {
+	for _, backend := range brokerBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			t.Run("delivers events to the worker topic", func(t *testing.T) {
+				pub, msgs := subscribeBroker(t, backend)
+				symbol := &domain.Symbol{ID: 42, Project: 7, UID: "uid"}
+				require.NoError(t, pub.PublishSymbolUpdated(context.Background(), symbol))
+
+				msg := receiveEvent(t, msgs)
+				defer msg.Ack()
+
+				assert.Equal(t, event.SymbolUpdatedTopic, mq.MessageRoutingKey(msg))
+				assert.Equal(t, "7", msg.Metadata.Get(mq.ProjectIDKey))
+				assert.Equal(t, "42", msg.Metadata.Get(events.HeaderSubject))
+
+				evt, err := mq.DecodeEvent(msg)
+				require.NoError(t, err)
+				updated, ok := evt.(*eventsv1.SymbolUpdated)
+				require.True(t, ok, "got %T", evt)
+				assert.Equal(t, uint64(42), updated.GetId())
+				assert.Equal(t, "uid", updated.GetUid())
+			})
+
+			t.Run("redelivers nacked messages", func(t *testing.T) {
+				pub, msgs := subscribeBroker(t, backend)
+				require.NoError(t, pub.PublishSymbolDeleted(context.Background(), &domain.Symbol{ID: 1, Project: 7}))
+
+				msg := receiveEvent(t, msgs)
+				msg.Nack()
+
+				redelivered := receiveEvent(t, msgs)
+				defer redelivered.Ack()
+				assert.Equal(t, msg.UUID, redelivered.UUID)
+			})
+
+			t.Run("keeps the order of a project", func(t *testing.T) {
+				if backend.unordered {
+					t.Skip("the broker does not order events")
+				}
+				pub, msgs := subscribeBroker(t, backend)
+				for id := uint64(1); id <= 5; id++ {
+					require.NoError(t, pub.PublishSymbolCreated(context.Background(), &domain.Symbol{ID: id, Project: 7}))
+				}
+
+				var subjects []string
+				for range 5 {
+					msg := receiveEvent(t, msgs)
+					subjects = append(subjects, msg.Metadata.Get(events.HeaderSubject))
+					msg.Ack()
+				}
+				assert.Equal(t, []string{"1", "2", "3", "4", "5"}, subjects)
+			})
+		})
+	}
+}
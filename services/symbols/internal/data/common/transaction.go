@@ -3,8 +3,25 @@ package common //nolint:revive // "common" is an acceptable name for shared util
 
 import (
 	"context"
+
+	"gorm.io/gorm"
 )
 
 type Transaction interface {
 	InTx(context.Context, func(ctx context.Context) error) error
 }
+
+type txKey struct{}
+
+// WithTx returns a context carrying the transaction tx.
+func WithTx(ctx context.Context, tx *gorm.DB) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// DB returns the transaction carried by ctx, or db when ctx carries none, bound to ctx.
+func DB(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		db = tx
+	}
+	return db.WithContext(ctx)
+}
@@ -3,18 +3,31 @@ package data
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
 	"platform/events"
+	"platform/idempotency"
+	"platform/inbox"
 	platform_logger "platform/logger"
 	"platform/metrics"
+	"platform/ratelimit"
 	"symbols/internal/biz/domain"
 	"symbols/internal/conf/gen"
 	"symbols/internal/data/common"
 	"symbols/internal/data/model"
 	"symbols/internal/data/mq"
+	"symbols/internal/data/repo"
+	"time"
 
 	"github.com/ThreeDotsLabs/watermill-amqp/v3/pkg/amqp"
+	wmsql "github.com/ThreeDotsLabs/watermill-sql/v3/pkg/sql"
 	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/nats-io/nats.go"
+	"github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel/trace"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 )
@@ -44,15 +57,22 @@ func NewData(db *gorm.DB, logger log.Logger) (*Data, func(), error) {
 }
 
 // NewDB initializes and returns a new gorm.DB connection configured with the given settings and logger.
-func NewDB(cfg *conf.Data, logger log.Logger) *gorm.DB {
+// Queries run in spans of tp, when not nil.
+func NewDB(cfg *conf.Data, tp trace.TracerProvider, logger log.Logger) *gorm.DB {
 	l := log.NewHelper(logger)
 	db, err := gorm.Open(mysql.Open(cfg.Database.Source), &gorm.Config{})
 	if err != nil {
 		l.Fatalf("failed opening connection to mysql: %v", err)
 	}
 
+	if tp != nil {
+		if err := db.Use(newTracingPlugin(tp)); err != nil {
+			l.Fatalf("failed to register the tracing plugin: %v", err)
+		}
+	}
+
 	if cfg.Database.RunMigrations.Value {
-		if err := db.AutoMigrate(&model.Symbol{}, &model.SymbolData{}); err != nil {
+		if err := db.AutoMigrate(&model.Symbol{}, &model.SymbolData{}, &model.SymbolReference{}, &model.SymbolTag{}, &model.SymbolLock{}, &model.AuditEvent{}, &model.SymbolRevision{}, &model.ProjectSymbolStats{}, &model.InboxMessage{}, &model.SchedulerLease{}, &model.IdempotencyKey{}, &model.RateLimitBucket{}); err != nil {
 			l.Fatalf("Failed to migrate: %v", err)
 		}
 	}
@@ -76,6 +96,315 @@ func NewTransaction(d *Data) common.Transaction {
 	return d
 }
 
+// NewGoChannel creates the in-process broker when conf.Data.broker selects it, or returns nil.
+// It is not persistent: messages published while nothing subscribes to their topic are dropped.
+func NewGoChannel(cfg *conf.Data, logger log.Logger, wmLogger *platform_logger.WatermillLogger) (*gochannel.GoChannel, func()) {
+	if cfg.GetBroker() != mq.BrokerGoChannel {
+		return nil, func() {}
+	}
+
+	l := log.NewHelper(logger)
+	l.Warn("Using the in-process gochannel broker: events are not delivered to other processes")
+
+	goChannel := gochannel.NewGoChannel(gochannel.Config{OutputChannelBuffer: 100}, wmLogger)
+	cleanup := func() {
+		if err := goChannel.Close(); err != nil {
+			l.Errorf("failed to close the gochannel broker: %v", err)
+		}
+	}
+
+	return goChannel, cleanup
+}
+
+// NewPublisher creates the publisher of the broker selected by conf.Data.broker. The AMQP publisher
+// is guarded by the circuit breaker of conf.Data.mq.publisher, whose state is recorded in reg.
+func NewPublisher(cfg *conf.Data, goChannel *gochannel.GoChannel, db *gorm.DB, reg *metrics.Registry, logger log.Logger, wmLogger *platform_logger.WatermillLogger) (message.Publisher, func()) {
+	if goChannel != nil {
+		// The GoChannel is closed by its own cleanup
+		return mq.NewExchangePublisher(goChannel, cfg.Mq.Exchange.Name), func() {}
+	}
+
+	var pub message.Publisher
+	switch cfg.GetBroker() {
+	case mq.BrokerSQL:
+		pub = mq.NewExchangePublisher(NewSQLPublisher(db, cfg.Mq.Exchange.Name, logger, wmLogger), cfg.Mq.Exchange.Name)
+	case mq.BrokerNATS:
+		pub = NewNATSPublisher(natsConfig(cfg), cfg.GetNats().GetUrl(), logger)
+	case mq.BrokerKafka:
+		pub = NewKafkaPublisher(kafkaConfig(cfg), logger, wmLogger)
+	default:
+		pub = NewBreakerPublisher(cfg, NewAMQPPublisher(cfg, logger, wmLogger), reg, logger, wmLogger)
+	}
+
+	l := log.NewHelper(logger)
+	cleanup := func() {
+		if err := pub.Close(); err != nil {
+			l.Errorf("failed to close the publisher: %v", err)
+		}
+	}
+
+	return pub, cleanup
+}
+
+// amqpBreakerName names the circuit breaker of the AMQP publisher in metrics and health checks.
+const amqpBreakerName = "amqp_publisher"
+
+// NewBreakerPublisher guards pub with the circuit breaker of conf.Data.mq.publisher, returning pub
+// when the breaker is disabled.
+func NewBreakerPublisher(cfg *conf.Data, pub message.Publisher, reg *metrics.Registry, logger log.Logger, wmLogger *platform_logger.WatermillLogger) message.Publisher {
+	cb := cfg.GetMq().GetPublisher().GetCircuitBreaker()
+	if !cb.GetEnabled().GetValue() {
+		return pub
+	}
+
+	bc := mq.BreakerConfig{
+		Name:             amqpBreakerName,
+		FailureThreshold: cb.GetFailureThreshold(),
+		OpenTimeout:      cb.GetOpenTimeout().AsDuration(),
+		RetryInterval:    cb.GetSpoolRetryInterval().AsDuration(),
+	}
+	if bc.FailureThreshold == 0 {
+		bc.FailureThreshold = 5
+	}
+	if bc.OpenTimeout == 0 {
+		bc.OpenTimeout = 30 * time.Second
+	}
+	if bc.RetryInterval == 0 {
+		bc.RetryInterval = 5 * time.Second
+	}
+
+	l := log.NewHelper(logger)
+	if cb.GetPolicy() == mq.BreakerPolicySpool {
+		dir := cb.GetSpoolDir()
+		if dir == "" {
+			dir = filepath.Join(os.TempDir(), "symbols-spool")
+		}
+		spool, err := mq.NewSpool(dir)
+		if err != nil {
+			l.Fatalf("failed to open the publisher spool: %v", err)
+		}
+		bc.Spool = spool
+		l.Infof("Events the broker cannot take are spooled to %s (%d pending)", dir, spool.Len())
+	}
+
+	return mq.NewBreakerPublisher(pub, bc, metrics.NewCircuitBreakerMetrics(reg), wmLogger)
+}
+
+// newAMQPReconnectConfig returns the backoff of the AMQP reconnections, defaulting to Watermill's.
+func newAMQPReconnectConfig(cfg *conf.Data) *amqp.ReconnectConfig {
+	rc := amqp.DefaultReconnectConfig()
+	r := cfg.GetMq().GetReconnect()
+	if d := r.GetInitialInterval(); d != nil {
+		rc.BackoffInitialInterval = d.AsDuration()
+	}
+	if d := r.GetMaxInterval(); d != nil {
+		rc.BackoffMaxInterval = d.AsDuration()
+	}
+	if m := r.GetMultiplier(); m > 0 {
+		rc.BackoffMultiplier = m
+	}
+	return rc
+}
+
+// NewSubscriber creates the subscriber of the broker selected by conf.Data.broker.
+func NewSubscriber(cfg *conf.Data, goChannel *gochannel.GoChannel, db *gorm.DB, logger log.Logger, wmLogger *platform_logger.WatermillLogger) message.Subscriber {
+	if goChannel != nil {
+		return goChannel
+	}
+	switch cfg.GetBroker() {
+	case mq.BrokerSQL:
+		return NewSQLSubscriber(cfg, db, logger, wmLogger)
+	case mq.BrokerNATS:
+		return NewNATSSubscriber(natsConfig(cfg), cfg.GetNats().GetUrl(), logger, wmLogger)
+	case mq.BrokerKafka:
+		return NewKafkaSubscriber(kafkaConfig(cfg), logger, wmLogger)
+	}
+
+	return NewAMQPSubscriber(cfg, logger, wmLogger)
+}
+
+// natsDeadLetterSuffix suffixes the stream and subject prefix of the NATS dead letters.
+const natsDeadLetterSuffix = "_dead_letters"
+
+// natsConfig maps the nats config to the JetStream topology, defaulting to the mq names.
+func natsConfig(cfg *conf.Data) mq.NATSConfig {
+	c := cfg.GetNats()
+	nc := mq.NATSConfig{
+		Stream:        c.GetStream(),
+		SubjectPrefix: c.GetSubjectPrefix(),
+		Subjects:      c.GetSubjects(),
+		Consumer:      c.GetConsumer(),
+		OrderingKey:   c.GetOrderingKey(),
+		AckWait:       c.GetAckWait().AsDuration(),
+		MaxMessages:   int(cfg.GetMq().GetQueue().GetPrefetchCount()),
+	}
+	if nc.Stream == "" {
+		nc.Stream = cfg.GetMq().GetExchange().GetName()
+	}
+	if nc.SubjectPrefix == "" {
+		nc.SubjectPrefix = "symbols"
+	}
+	if nc.Consumer == "" {
+		nc.Consumer = cfg.GetMq().GetQueue().GetName()
+	}
+	if nc.AckWait == 0 {
+		nc.AckWait = 30 * time.Second
+	}
+	return nc
+}
+
+// NewNATSPublisher connects to the NATS server at url and creates the JetStream publisher, creating the stream.
+func NewNATSPublisher(c mq.NATSConfig, url string, logger log.Logger) message.Publisher {
+	l := log.NewHelper(logger)
+
+	nc, err := connectNATS(url, "symbols-publisher")
+	if err != nil {
+		l.Fatalf("failed to connect to NATS: %v", err)
+	}
+	publisher, err := mq.NewNATSPublisher(nc, c)
+	if err != nil {
+		nc.Close()
+		l.Fatalf("failed to create NATS publisher: %v", err)
+	}
+
+	return publisher
+}
+
+// NewNATSSubscriber connects to the NATS server at url and creates the subscriber of the durable
+// consumer the workers share, creating the stream.
+func NewNATSSubscriber(c mq.NATSConfig, url string, logger log.Logger, wmLogger *platform_logger.WatermillLogger) message.Subscriber {
+	l := log.NewHelper(logger)
+
+	nc, err := connectNATS(url, "symbols-worker")
+	if err != nil {
+		l.Fatalf("failed to connect to NATS: %v", err)
+	}
+	subscriber, err := mq.NewNATSSubscriber(nc, c, wmLogger)
+	if err != nil {
+		nc.Close()
+		l.Fatalf("failed to create NATS subscriber: %v", err)
+	}
+
+	return subscriber
+}
+
+// connectNATS connects to the NATS server at url, or the local default server.
+func connectNATS(url, name string) (*nats.Conn, error) {
+	if url == "" {
+		url = nats.DefaultURL
+	}
+	return nats.Connect(url, nats.Name(name))
+}
+
+// kafkaDeadLetterSuffix suffixes the Kafka topic of the dead letters.
+const kafkaDeadLetterSuffix = "_dead_letters"
+
+// kafkaConfig maps the kafka config to the Kafka topics, defaulting to the mq names.
+func kafkaConfig(cfg *conf.Data) mq.KafkaConfig {
+	c := cfg.GetKafka()
+	kc := mq.KafkaConfig{
+		Brokers:       c.GetBrokers(),
+		Topic:         c.GetTopic(),
+		Topics:        c.GetTopics(),
+		ConsumerGroup: c.GetConsumerGroup(),
+		OrderingKey:   c.GetOrderingKey(),
+	}
+	if len(kc.Brokers) == 0 {
+		kc.Brokers = []string{"localhost:9092"}
+	}
+	if kc.Topic == "" {
+		kc.Topic = cfg.GetMq().GetExchange().GetName()
+	}
+	if kc.ConsumerGroup == "" {
+		kc.ConsumerGroup = cfg.GetMq().GetQueue().GetName()
+	}
+	return kc
+}
+
+// NewKafkaPublisher creates the Kafka publisher of the topics of c.
+func NewKafkaPublisher(c mq.KafkaConfig, logger log.Logger, wmLogger *platform_logger.WatermillLogger) message.Publisher {
+	publisher, err := mq.NewKafkaPublisher(c, wmLogger)
+	if err != nil {
+		log.NewHelper(logger).Fatalf("failed to create Kafka publisher: %v", err)
+	}
+
+	return publisher
+}
+
+// NewKafkaSubscriber creates the subscriber of the consumer group the workers share.
+func NewKafkaSubscriber(c mq.KafkaConfig, logger log.Logger, wmLogger *platform_logger.WatermillLogger) message.Subscriber {
+	subscriber, err := mq.NewKafkaSubscriber(c, wmLogger)
+	if err != nil {
+		log.NewHelper(logger).Fatalf("failed to create Kafka subscriber: %v", err)
+	}
+
+	return subscriber
+}
+
+// NewSQLPublisher creates the SQL Pub/Sub publisher of topic, creating its message table. Messages
+// published within a transaction carried by their context are inserted with that transaction.
+func NewSQLPublisher(db *gorm.DB, topic string, logger log.Logger, wmLogger *platform_logger.WatermillLogger) message.Publisher {
+	l := log.NewHelper(logger)
+
+	schema, _, err := mq.NewSQLAdapters(db.Dialector.Name())
+	if err != nil {
+		l.Fatalf("failed to create SQL publisher: %v", err)
+	}
+	if err := mq.InitializeSQLSchema(db, schema, topic); err != nil {
+		l.Fatalf("failed to create SQL publisher: %v", err)
+	}
+
+	return mq.NewSQLPublisher(db, schema, wmLogger)
+}
+
+// NewSQLSubscriber creates the SQL Pub/Sub subscriber of the queue consumer group. Offsets are stored
+// per consumer group, and handlers run in the transaction acking their message.
+func NewSQLSubscriber(cfg *conf.Data, db *gorm.DB, logger log.Logger, wmLogger *platform_logger.WatermillLogger) message.Subscriber {
+	l := log.NewHelper(logger)
+
+	schema, offsets, err := mq.NewSQLAdapters(db.Dialector.Name())
+	if err != nil {
+		l.Fatalf("failed to create SQL subscriber: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		l.Fatalf("failed to get database handle: %v", err)
+	}
+
+	subscriber, err := wmsql.NewSubscriber(sqlDB, wmsql.SubscriberConfig{
+		ConsumerGroup:    cfg.Mq.Queue.Name,
+		PollInterval:     cfg.GetSqlPubsub().GetPollInterval().AsDuration(),
+		SchemaAdapter:    schema,
+		OffsetsAdapter:   offsets,
+		InitializeSchema: true,
+	}, wmLogger)
+	if err != nil {
+		l.Fatalf("failed to create SQL subscriber: %v", err)
+	}
+
+	return mq.NewSQLSubscriber(subscriber, db)
+}
+
+// NewEmbeddedSubscriber returns the subscriber of the worker handlers the API server runs in-process
+// with the gochannel broker, or nil with an external broker, which the worker consumes from instead.
+func NewEmbeddedSubscriber(goChannel *gochannel.GoChannel) message.Subscriber {
+	if goChannel == nil {
+		return nil
+	}
+
+	return goChannel
+}
+
+// NewEmbeddedDeadLetterPublisher returns the dead-letter publisher of the worker handlers the API
+// server runs in-process, see NewEmbeddedSubscriber.
+func NewEmbeddedDeadLetterPublisher(cfg *conf.Data, goChannel *gochannel.GoChannel) mq.DeadLetterPublisher {
+	if goChannel == nil || !cfg.Mq.GetDeadLetter().GetEnabled().GetValue() {
+		return nil
+	}
+
+	return goChannel
+}
+
 func NewAMQPPublisher(cfg *conf.Data, logger log.Logger, wmLogger *platform_logger.WatermillLogger) message.Publisher {
 	amqpConfig := amqp.NewDurablePubSubConfig(
 		cfg.Mq.Addr,
@@ -90,11 +419,16 @@ func NewAMQPPublisher(cfg *conf.Data, logger log.Logger, wmLogger *platform_logg
 		Durable:     cfg.Mq.Exchange.Durable.Value,
 		AutoDeleted: cfg.Mq.Exchange.AutoDelete.Value,
 	}
+	// Publish returns once the broker has the message, so a lost event fails the request
+	confirm := cfg.Mq.GetPublisher().GetConfirmDelivery()
 	amqpConfig.Publish = amqp.PublishConfig{
 		GenerateRoutingKey: func(topic string) string {
 			return topic
 		},
+		ConfirmDelivery: confirm == nil || confirm.Value,
 	}
+	amqpConfig.Connection.Reconnect = newAMQPReconnectConfig(cfg)
+	amqpConfig.Marshaler = mq.NewMarshaler()
 
 	publisher, err := amqp.NewPublisher(amqpConfig, wmLogger)
 
@@ -104,7 +438,158 @@ func NewAMQPPublisher(cfg *conf.Data, logger log.Logger, wmLogger *platform_logg
 	return publisher
 }
 
+// NewDeadLetterPublisher creates the publisher the worker parks poison messages with,
+// declaring the dead-letter queue so nothing published to it is lost. It returns nil when
+// dead-lettering is disabled.
+func NewDeadLetterPublisher(cfg *conf.Data, goChannel *gochannel.GoChannel, db *gorm.DB, logger log.Logger, wmLogger *platform_logger.WatermillLogger) (mq.DeadLetterPublisher, func(), error) {
+	dl := cfg.Mq.GetDeadLetter()
+	if !dl.GetEnabled().GetValue() {
+		return nil, func() {}, nil
+	}
+	if goChannel != nil {
+		return goChannel, func() {}, nil
+	}
+	switch cfg.GetBroker() {
+	case mq.BrokerSQL:
+		// Dead letters are inserted with the transaction acking the message they replace
+		return NewSQLPublisher(db, dl.Queue, logger, wmLogger), func() {}, nil
+	case mq.BrokerNATS:
+		// Dead letters get their own stream, out of the subjects the worker consumes
+		c := natsConfig(cfg)
+		c.Stream += natsDeadLetterSuffix
+		c.SubjectPrefix += natsDeadLetterSuffix
+		c.OrderingKey = ""
+		pub := NewNATSPublisher(c, cfg.GetNats().GetUrl(), logger)
+		return pub, func() { _ = pub.Close() }, nil
+	case mq.BrokerKafka:
+		// Dead letters get their own topic, out of the topics the worker consumes
+		c := kafkaConfig(cfg)
+		c.Topic += kafkaDeadLetterSuffix
+		c.Topics = nil
+		c.OrderingKey = ""
+		pub := NewKafkaPublisher(c, logger, wmLogger)
+		return pub, func() { _ = pub.Close() }, nil
+	}
+
+	conn, err := amqp091.Dial(cfg.Mq.Addr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to AMQP: %w", err)
+	}
+	defer conn.Close()
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open AMQP channel: %w", err)
+	}
+	if err := mq.DeclareDeadLetterQueue(ch, dl.Exchange, dl.Queue); err != nil {
+		return nil, nil, err
+	}
+
+	amqpConfig := amqp.NewDurableQueueConfig(cfg.Mq.Addr)
+	amqpConfig.Exchange = amqp.ExchangeConfig{
+		GenerateName: func(topic string) string {
+			return dl.Exchange
+		},
+		Type:    amqp091.ExchangeFanout,
+		Durable: true,
+	}
+	amqpConfig.Marshaler = mq.NewMarshaler()
+
+	publisher, err := amqp.NewPublisher(amqpConfig, wmLogger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create dead-letter publisher: %w", err)
+	}
+
+	l := log.NewHelper(logger)
+	l.Infof("Dead letters are published to queue %s", dl.Queue)
+	cleanup := func() {
+		if err := publisher.Close(); err != nil {
+			l.Errorf("failed to close dead-letter publisher: %v", err)
+		}
+	}
+
+	return publisher, cleanup, nil
+}
+
+// NewRetryPublisher creates the publisher the worker delays retries with, declaring the retry queue of
+// every delay of conf.Data.mq.queue.retry and its handlers. It returns nil when no delay is configured or
+// the broker is not amqp; handlers then retry in-process.
+func NewRetryPublisher(cfg *conf.Data, logger log.Logger, wmLogger *platform_logger.WatermillLogger) (mq.RetryPublisher, func(), error) {
+	queue := cfg.GetMq().GetQueue()
+	delays := retryDelays(queue.GetRetry())
+	for _, h := range queue.GetHandlers() {
+		delays = append(delays, retryDelays(h.GetRetry())...)
+	}
+	if b := cfg.GetBroker(); len(delays) == 0 || (b != "" && b != mq.BrokerAMQP) {
+		return nil, func() {}, nil
+	}
+
+	conn, err := amqp091.Dial(cfg.Mq.Addr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to AMQP: %w", err)
+	}
+	defer conn.Close()
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open AMQP channel: %w", err)
+	}
+	if err := mq.DeclareRetryQueues(ch, queue.GetName(), delays); err != nil {
+		return nil, nil, err
+	}
+
+	// Retries are published to the retry queues through the default exchange, and the failed message
+	// is acked only once the broker confirmed its retry
+	amqpConfig := amqp.NewDurableQueueConfig(cfg.Mq.Addr)
+	amqpConfig.Publish.ConfirmDelivery = true
+	amqpConfig.Connection.Reconnect = newAMQPReconnectConfig(cfg)
+	amqpConfig.Marshaler = mq.NewMarshaler()
+
+	publisher, err := amqp.NewPublisher(amqpConfig, wmLogger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create retry publisher: %w", err)
+	}
+
+	l := log.NewHelper(logger)
+	l.Infof("Failed messages are retried through the retry queues of %s", queue.GetName())
+	cleanup := func() {
+		if err := publisher.Close(); err != nil {
+			l.Errorf("failed to close retry publisher: %v", err)
+		}
+	}
+
+	return publisher, cleanup, nil
+}
+
+// NewEmbeddedRetryPublisher returns the retry publisher of the worker handlers the API server runs
+// in-process, which always retry in-process.
+func NewEmbeddedRetryPublisher() mq.RetryPublisher {
+	return nil
+}
+
+// retryDelays returns the delays of r.
+func retryDelays(r *conf.RabbitMQServer_Retry) []time.Duration {
+	var delays []time.Duration
+	for _, d := range r.GetDelays() {
+		delays = append(delays, d.AsDuration())
+	}
+	return delays
+}
+
 func NewAMQPSubscriber(cfg *conf.Data, logger log.Logger, wmLogger *platform_logger.WatermillLogger) message.Subscriber {
+	amqpConfig := newAMQPSubscriberConfig(cfg)
+
+	subscriber, err := amqp.NewSubscriber(amqpConfig, wmLogger)
+
+	if err != nil {
+		log.NewHelper(logger).Fatalf("failed to create AMQP subscriber: %v", err)
+	}
+	return subscriber
+}
+
+// newAMQPSubscriberConfig builds the consumer side AMQP config. Every Subscribe call opens its own
+// channel and consumer, and prefetch_count limits the unacked messages each of them holds.
+func newAMQPSubscriberConfig(cfg *conf.Data) amqp.Config {
 	amqpConfig := amqp.NewDurablePubSubConfig(
 		cfg.Mq.Addr,
 		amqp.GenerateQueueNameConstant(cfg.Mq.Queue.Name),
@@ -126,29 +611,90 @@ func NewAMQPSubscriber(cfg *conf.Data, logger log.Logger, wmLogger *platform_log
 		},
 	}
 
-	subscriber, err := amqp.NewSubscriber(amqpConfig, wmLogger)
-
-	if err != nil {
-		log.NewHelper(logger).Fatalf("failed to create AMQP subscriber: %v", err)
+	if cfg.Mq.Queue.PrefetchCount > 0 {
+		amqpConfig.Consume.Qos.PrefetchCount = int(cfg.Mq.Queue.PrefetchCount)
 	}
-	return subscriber
-}
+	amqpConfig.Connection.Reconnect = newAMQPReconnectConfig(cfg)
+	// Messages coming back from the retry queues carry the dead-lettering headers of RabbitMQ
+	amqpConfig.Marshaler = mq.NewMarshaler()
 
-type txKey struct{}
+	return amqpConfig
+}
 
+// InTx runs fn in a transaction carried by its context. Within a transaction already carried by ctx,
+// like the one the sql broker consumes a message in, it runs fn in a savepoint of that transaction.
 func (d *Data) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
-	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		txCtx := context.WithValue(ctx, txKey{}, tx)
-		return fn(txCtx)
+	return common.DB(ctx, d.db).Transaction(func(tx *gorm.DB) error {
+		return fn(common.WithTx(ctx, tx))
 	})
 }
 
+// NewInboxStore creates the inbox store deduplicating the messages the worker consumes.
+// It returns nil when the inbox is disabled.
+func NewInboxStore(cfg *conf.Data, db *gorm.DB, tx common.Transaction, logger log.Logger) inbox.Store {
+	c := cfg.GetMq().GetInbox()
+	if !c.GetEnabled().GetValue() {
+		return nil
+	}
+
+	if c.GetBackend() == "memory" {
+		return inbox.NewMemoryStore()
+	}
+
+	return repo.NewInboxStore(db, tx, logger)
+}
+
+// NewIdempotencyStore creates the store of the idempotency keys selected by conf.Server.idempotency.
+// It returns nil when idempotency keys are disabled.
+func NewIdempotencyStore(c *conf.Server, db *gorm.DB, logger log.Logger) idempotency.Store {
+	ic := c.GetIdempotency()
+	if !ic.GetEnabled().GetValue() {
+		return nil
+	}
+
+	if ic.GetBackend() == "sql" {
+		return repo.NewIdempotencyStore(db, logger)
+	}
+
+	return idempotency.NewMemoryStore()
+}
+
+// NewRateLimitStore creates the store of the rate limit buckets selected by conf.Server.rate_limit.
+// It returns nil when rate limiting is disabled.
+func NewRateLimitStore(c *conf.Server, db *gorm.DB, logger log.Logger) ratelimit.Store {
+	rl := c.GetRateLimit()
+	if !rl.GetEnabled().GetValue() {
+		return nil
+	}
+
+	if rl.GetBackend() == "sql" {
+		return repo.NewRateLimitStore(db, rl.GetIdleTtl().AsDuration(), logger)
+	}
+
+	return ratelimit.NewMemoryStore(rl.GetIdleTtl().AsDuration())
+}
+
+// NewProjectStatsRepo creates the project statistics repository from the stats configuration.
+// Materialization is disabled when not configured.
+func NewProjectStatsRepo(db *gorm.DB, cfg *conf.Data, logger log.Logger) domain.ProjectStatsRepo {
+	var opts repo.StatsOptions
+	if stats := cfg.GetStats(); stats != nil {
+		opts.Materialized = stats.GetMaterialized().GetValue()
+		opts.MaxStaleness = stats.GetMaxStaleness().AsDuration()
+	}
+
+	return repo.NewProjectStatsRepo(db, opts, logger)
+}
+
 // NewEventPublisherWithMetrics wraps the base event publisher with metrics if enabled.
-// Note: Currently returns the base publisher without metrics wrapping as the SymbolEventPublisher
-// interface methods (PublishSymbolCreated, etc.) are not part of the generic events.Publisher interface.
-// TODO: Create a dedicated metrics wrapper for SymbolEventPublisher if per-event-type metrics are needed.
+// Publishes are recorded per event type, as the SymbolEventPublisher methods are not part of the
+// generic events.Publisher interface.
 func NewEventPublisherWithMetrics(pub message.Publisher, mc *conf.Metrics, reg *metrics.Registry, logger log.Logger) domain.SymbolEventPublisher {
-	return mq.NewEventPublisher(pub, logger)
+	basePub := mq.NewEventPublisher(pub, logger)
+	if mc != nil && mc.Enabled.Value && reg != nil {
+		return mq.NewEventPublisherWithMetrics(basePub, metrics.NewEventPublishMetrics(reg))
+	}
+	return basePub
 }
 
 // NewEventSubscriberWithMetrics wraps the base event subscriber with metrics if enabled.
@@ -0,0 +1,32 @@
+package data
+
+import (
+	"platform/health"
+	"symbols/internal/data/mq"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// Names of the readiness checks of the data layer.
+const (
+	HealthCheckDatabase = "mysql"
+	HealthCheckBroker   = "broker"
+)
+
+// NewHealthChecks returns the readiness checks of the data layer: a ping of the database and the
+// connection of the event publisher.
+func NewHealthChecks(db *gorm.DB, pub message.Publisher, logger log.Logger) health.Checks {
+	checks := health.Checks{
+		HealthCheckBroker: mq.PublisherChecker(pub),
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.NewHelper(logger).Fatalf("failed to get database handle: %v", err)
+	}
+	checks[HealthCheckDatabase] = health.PingChecker(sqlDB)
+
+	return checks
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// AuditEvent is an append-only audit trail record of a symbol mutation.
+// It has no UpdatedAt/DeletedAt: rows are never modified, only purged by retention.
+type AuditEvent struct {
+	ID        uint64    `gorm:"primaryKey;autoIncrement"`
+	ProjectID uint64    `gorm:"not null;index:idx_audit_project_created,priority:1" json:"project_id"`
+	SymbolID  uint64    `gorm:"not null;index:idx_audit_symbol" json:"symbol_id"`
+	Operation string    `gorm:"not null;size:16" json:"operation"`
+	Actor     string    `gorm:"not null;size:255" json:"actor"`
+	RequestID string    `gorm:"size:64" json:"request_id"`
+	ClientIP  string    `gorm:"size:64" json:"client_ip"`
+	Changes   string    `gorm:"not null;type:text" json:"changes"` // JSON encoded []domain.FieldChange
+	CreatedAt time.Time `gorm:"not null;index:idx_audit_project_created,priority:2;index:idx_audit_created" json:"created_at"`
+}
+
+func (AuditEvent) TableName() string {
+	return "symbol_audit_events"
+}
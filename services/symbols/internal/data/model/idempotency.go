@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// IdempotencyKey is the stored state of an Idempotency-Key, shared by the API replicas.
+// Rows are kept until ExpiresAt, then purged by the worker.
+type IdempotencyKey struct {
+	Key         string    `gorm:"primaryKey;size:512" json:"key"`
+	Fingerprint string    `gorm:"not null;size:64" json:"fingerprint"`
+	Response    []byte    `json:"response"`
+	Completed   bool      `gorm:"not null" json:"completed"`
+	ExpiresAt   time.Time `gorm:"not null;index" json:"expires_at"`
+}
+
+func (IdempotencyKey) TableName() string {
+	return "idempotency_keys"
+}
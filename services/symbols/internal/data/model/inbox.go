@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// InboxMessage records a message a consumer processed, so its redeliveries are skipped.
+// Rows are kept until ExpiresAt, then purged.
+type InboxMessage struct {
+	Consumer    string    `gorm:"primaryKey;size:255" json:"consumer"`
+	MessageID   string    `gorm:"primaryKey;size:255" json:"message_id"`
+	ProcessedAt time.Time `gorm:"not null" json:"processed_at"`
+	ExpiresAt   time.Time `gorm:"not null;index" json:"expires_at"`
+}
+
+func (InboxMessage) TableName() string {
+	return "inbox_messages"
+}
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// SchedulerLease is the lease of a scheduled job. ScheduledAt is the last run claimed, so each run is
+// claimed by a single replica, and LockedUntil keeps the job locked while that run goes on.
+type SchedulerLease struct {
+	Name        string    `gorm:"primaryKey;size:255" json:"name"`
+	Holder      string    `gorm:"not null;size:255" json:"holder"`
+	ScheduledAt time.Time `gorm:"not null" json:"scheduled_at"`
+	LockedUntil time.Time `gorm:"not null" json:"locked_until"`
+}
+
+func (SchedulerLease) TableName() string {
+	return "scheduler_leases"
+}
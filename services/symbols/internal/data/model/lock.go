@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// SymbolLock is the editing lease of a symbol. A symbol has at most one lease;
+// expired rows are taken over by the next Acquire rather than deleted.
+type SymbolLock struct {
+	SymbolID   uint64    `gorm:"primaryKey;autoIncrement:false" json:"symbol_id"`
+	ProjectID  uint64    `gorm:"not null;index" json:"project_id"`
+	Holder     string    `gorm:"not null;size:255" json:"holder"`
+	SessionID  string    `gorm:"not null;size:255" json:"session_id"`
+	AcquiredAt time.Time `gorm:"not null" json:"acquired_at"`
+	ExpiresAt  time.Time `gorm:"not null;index" json:"expires_at"`
+}
+
+func (SymbolLock) TableName() string {
+	return "symbol_locks"
+}
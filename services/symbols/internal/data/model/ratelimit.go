@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// RateLimitBucket is the state of a rate limit token bucket, shared by the API replicas.
+// Buckets idle for longer than the configured TTL are deleted by the replicas using them.
+type RateLimitBucket struct {
+	Key       string    `gorm:"primaryKey;size:512" json:"key"`
+	Tokens    float64   `gorm:"not null" json:"tokens"`
+	UpdatedAt time.Time `gorm:"not null;index" json:"updated_at"`
+}
+
+func (RateLimitBucket) TableName() string {
+	return "rate_limit_buckets"
+}
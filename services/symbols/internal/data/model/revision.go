@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+// SymbolRevision is a full snapshot of a symbol, written with every create and update.
+// Rows are never modified; they outlive the soft deletion of their symbol.
+type SymbolRevision struct {
+	ID              uint64            `gorm:"primaryKey;autoIncrement"`
+	SymbolID        uint64            `gorm:"not null;uniqueIndex:idx_symbol_revisions_symbol_revision,priority:1" json:"symbol_id"`
+	Revision        uint32            `gorm:"not null;uniqueIndex:idx_symbol_revisions_symbol_revision,priority:2" json:"revision"`
+	ProjectID       uint64            `gorm:"not null" json:"project_id"`
+	UID             string            `gorm:"not null;size:255" json:"uid"`
+	Label           string            `gorm:"not null;size:255" json:"label"`
+	ClassName       string            `gorm:"not null;size:255" json:"class_name"`
+	ComponentTarget string            `gorm:"not null;size:255" json:"component_target"`
+	Version         uint32            `gorm:"not null" json:"version"`
+	Data            []byte            `gorm:"type:longblob" json:"data"`
+	References      []string          `gorm:"type:text;serializer:json" json:"references,omitempty"`
+	Tags            map[string]string `gorm:"type:text;serializer:json" json:"tags,omitempty"`
+	CreatedAt       time.Time
+}
+
+func (SymbolRevision) TableName() string {
+	return "symbol_revisions"
+}
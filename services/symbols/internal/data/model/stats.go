@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// ProjectSymbolStats is the materialized copy of a project's symbol statistics,
+// recomputed by the worker when the project's symbols change.
+type ProjectSymbolStats struct {
+	ProjectID        uint64            `gorm:"primaryKey;autoIncrement:false" json:"project_id"`
+	TotalSymbols     uint64            `gorm:"not null" json:"total_symbols"`
+	ComponentTargets map[string]uint64 `gorm:"not null;type:text;serializer:json" json:"component_targets"`
+	TotalDataBytes   uint64            `gorm:"not null" json:"total_data_bytes"`
+	ChangedLastDay   uint64            `gorm:"not null" json:"changed_last_day"`
+	ChangedLastWeek  uint64            `gorm:"not null" json:"changed_last_week"`
+	ComputedAt       time.Time         `gorm:"not null" json:"computed_at"`
+}
+
+func (ProjectSymbolStats) TableName() string {
+	return "project_symbol_stats"
+}
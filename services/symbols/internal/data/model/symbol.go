@@ -16,13 +16,15 @@ type BaseModel struct {
 
 type Symbol struct {
 	BaseModel
-	ProjectID       uint64      `gorm:"not null;uniqueIndex:idx_project_uid,priority:1;index:idx_project_id,priority:1;index:idx_symbols_project_deleted_at,priority:1" json:"project_id"`
-	UID             string      `gorm:"not null;size:255;uniqueIndex:idx_project_uid,priority:2" json:"uid"`
-	Label           string      `gorm:"not null;size:255" json:"label"`
-	ClassName       string      `gorm:"not null;size:255" json:"class_name"`
-	ComponentTarget string      `gorm:"not null;size:255" json:"component_target"`
-	Version         uint32      `gorm:"not null" json:"version"`
-	SymbolData      *SymbolData `gorm:"foreignKey:SymbolID;references:ID;constraint:OnDelete:CASCADE" json:"symbol_data,omitempty"`
+	ProjectID       uint64            `gorm:"not null;uniqueIndex:idx_project_uid,priority:1;index:idx_project_id,priority:1;index:idx_symbols_project_deleted_at,priority:1" json:"project_id"`
+	UID             string            `gorm:"not null;size:255;uniqueIndex:idx_project_uid,priority:2" json:"uid"`
+	Label           string            `gorm:"not null;size:255" json:"label"`
+	ClassName       string            `gorm:"not null;size:255" json:"class_name"`
+	ComponentTarget string            `gorm:"not null;size:255" json:"component_target"`
+	Version         uint32            `gorm:"not null" json:"version"`
+	SymbolData      *SymbolData       `gorm:"foreignKey:SymbolID;references:ID;constraint:OnDelete:CASCADE" json:"symbol_data,omitempty"`
+	References      []SymbolReference `gorm:"foreignKey:SymbolID;references:ID;constraint:OnDelete:CASCADE" json:"references,omitempty"`
+	Tags            []SymbolTag       `gorm:"foreignKey:SymbolID;references:ID;constraint:OnDelete:CASCADE" json:"tags,omitempty"`
 }
 
 func (Symbol) TableName() string {
@@ -38,3 +40,31 @@ type SymbolData struct {
 func (SymbolData) TableName() string {
 	return "symbol_data"
 }
+
+// SymbolReference records that the symbol SymbolID references the symbol RefUID of the same project.
+// References point at UIDs rather than IDs so that they survive a symbol being re-created.
+type SymbolReference struct {
+	ID        uint64 `gorm:"primaryKey;autoIncrement"`
+	ProjectID uint64 `gorm:"not null;index:idx_symbol_references_project_ref,priority:1" json:"project_id"`
+	SymbolID  uint64 `gorm:"not null;uniqueIndex:idx_symbol_references_symbol_ref,priority:1" json:"symbol_id"`
+	RefUID    string `gorm:"not null;size:255;uniqueIndex:idx_symbol_references_symbol_ref,priority:2;index:idx_symbol_references_project_ref,priority:2" json:"ref_uid"`
+	CreatedAt time.Time
+}
+
+func (SymbolReference) TableName() string {
+	return "symbol_references"
+}
+
+// SymbolTag is a key/value tag of a symbol. Tags are normalized into their own table
+// so that symbols can be filtered and faceted by tag.
+type SymbolTag struct {
+	ID        uint64 `gorm:"primaryKey;autoIncrement"`
+	ProjectID uint64 `gorm:"not null;index:idx_symbol_tags_project_key_value,priority:1" json:"project_id"`
+	SymbolID  uint64 `gorm:"not null;uniqueIndex:idx_symbol_tags_symbol_key,priority:1" json:"symbol_id"`
+	Key       string `gorm:"column:tag_key;not null;size:64;uniqueIndex:idx_symbol_tags_symbol_key,priority:2;index:idx_symbol_tags_project_key_value,priority:2" json:"key"`
+	Value     string `gorm:"column:tag_value;not null;size:255;index:idx_symbol_tags_project_key_value,priority:3" json:"value"`
+}
+
+func (SymbolTag) TableName() string {
+	return "symbol_tags"
+}
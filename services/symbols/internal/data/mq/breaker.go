@@ -0,0 +1,169 @@
+package mq
+
+import (
+	"context"
+	"fmt"
+	"platform/metrics"
+	"sync"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/sony/gobreaker"
+)
+
+// What BreakerPublisher does with the messages it fails to publish.
+const (
+	// BreakerPolicyFail returns the error, failing the request publishing the event.
+	BreakerPolicyFail = "fail"
+	// BreakerPolicySpool spools the messages and publishes them once the broker is back.
+	BreakerPolicySpool = "spool"
+)
+
+// BreakerConfig configures the circuit breaker of a BreakerPublisher.
+type BreakerConfig struct {
+	// Name labels the metrics of the breaker.
+	Name string
+	// FailureThreshold is the number of consecutive failed publishes opening the breaker.
+	FailureThreshold uint32
+	// OpenTimeout is how long the breaker rejects publishes before letting a trial one through.
+	OpenTimeout time.Duration
+	// Spool holds the messages of the failed publishes with BreakerPolicySpool. Nil fails them.
+	Spool *Spool
+	// RetryInterval is how often the spooled messages are retried.
+	RetryInterval time.Duration
+}
+
+// BreakerPublisher guards a publisher with a circuit breaker, so publishes fail fast while the
+// broker is down instead of waiting on it. With a spool, failed publishes are spooled and
+// published again in order once the breaker lets publishes through.
+type BreakerPublisher struct {
+	next    message.Publisher
+	cb      *gobreaker.CircuitBreaker
+	cfg     BreakerConfig
+	metrics *metrics.CircuitBreakerMetrics
+	logger  watermill.LoggerAdapter
+
+	closing   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// NewBreakerPublisher wraps next with the breaker of cfg, recording its state in m.
+// With a spool, it retries the spooled messages in the background until closed.
+func NewBreakerPublisher(next message.Publisher, cfg BreakerConfig, m *metrics.CircuitBreakerMetrics, logger watermill.LoggerAdapter) *BreakerPublisher {
+	p := &BreakerPublisher{
+		next:    next,
+		cfg:     cfg,
+		metrics: m,
+		logger:  logger.With(watermill.LogFields{"breaker": cfg.Name}),
+		closing: make(chan struct{}),
+	}
+	p.cb = gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:    cfg.Name,
+		Timeout: cfg.OpenTimeout,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			return counts.ConsecutiveFailures >= max(cfg.FailureThreshold, 1)
+		},
+		OnStateChange: func(_ string, from, to gobreaker.State) {
+			p.logger.Info("Circuit breaker state changed", watermill.LogFields{"from": from.String(), "to": to.String()})
+			p.metrics.SetState(cfg.Name, int(to))
+		},
+	})
+	p.metrics.SetState(cfg.Name, int(gobreaker.StateClosed))
+
+	if cfg.Spool != nil {
+		p.metrics.SetSpooled(cfg.Name, cfg.Spool.Len())
+		p.wg.Add(1)
+		go p.retrySpooled()
+	}
+
+	return p
+}
+
+// State returns the state of the breaker.
+func (p *BreakerPublisher) State() gobreaker.State {
+	return p.cb.State()
+}
+
+// Unwrap returns the guarded publisher.
+func (p *BreakerPublisher) Unwrap() message.Publisher {
+	return p.next
+}
+
+// Check reports an open breaker, for health checks.
+func (p *BreakerPublisher) Check(_ context.Context) error {
+	if state := p.cb.State(); state == gobreaker.StateOpen {
+		return fmt.Errorf("circuit breaker %s is %s", p.cfg.Name, state)
+	}
+	return nil
+}
+
+func (p *BreakerPublisher) Publish(topic string, msgs ...*message.Message) error {
+	spool := p.cfg.Spool
+	// Messages queue behind the spooled ones, so events keep their order
+	if spool != nil && spool.Len() > 0 {
+		return p.spool(topic, msgs)
+	}
+
+	err := p.publish(topic, msgs...)
+	if err == nil || spool == nil {
+		return err
+	}
+
+	p.logger.Error("Failed to publish, spooling messages", err, watermill.LogFields{"topic": topic, "count": len(msgs)})
+	return p.spool(topic, msgs)
+}
+
+func (p *BreakerPublisher) publish(topic string, msgs ...*message.Message) error {
+	_, err := p.cb.Execute(func() (any, error) {
+		return nil, p.next.Publish(topic, msgs...)
+	})
+	return err
+}
+
+func (p *BreakerPublisher) spool(topic string, msgs []*message.Message) error {
+	err := p.cfg.Spool.Append(topic, msgs...)
+	p.metrics.SetSpooled(p.cfg.Name, p.cfg.Spool.Len())
+	return err
+}
+
+// retrySpooled drains the spool every RetryInterval while the breaker lets publishes through.
+func (p *BreakerPublisher) retrySpooled() {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.cfg.RetryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.closing:
+			return
+		case <-ticker.C:
+		}
+
+		if p.cfg.Spool.Len() == 0 || p.cb.State() == gobreaker.StateOpen {
+			continue
+		}
+
+		n, err := p.cfg.Spool.Drain(func(topic string, msg *message.Message) error {
+			return p.publish(topic, msg)
+		})
+		p.metrics.SetSpooled(p.cfg.Name, p.cfg.Spool.Len())
+		if err != nil {
+			p.logger.Error("Failed to publish spooled messages", err, watermill.LogFields{"published": n})
+			continue
+		}
+		p.logger.Info("Published spooled messages", watermill.LogFields{"published": n})
+	}
+}
+
+// Close stops retrying the spooled messages, which stay spooled for the next run, and closes the
+// wrapped publisher.
+func (p *BreakerPublisher) Close() error {
+	p.closeOnce.Do(func() {
+		close(p.closing)
+		p.wg.Wait()
+	})
+	return p.next.Close()
+}
@@ -0,0 +1,151 @@
+package mq
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/sony/gobreaker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyPublisher fails every publish while down and records the UUIDs it published.
+type flakyPublisher struct {
+	mu        sync.Mutex
+	down      bool
+	calls     int
+	published []string
+}
+
+func (p *flakyPublisher) setDown(down bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.down = down
+}
+
+func (p *flakyPublisher) Publish(_ string, msgs ...*message.Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.calls++
+	if p.down {
+		return errors.New("not connected to AMQP")
+	}
+	for _, msg := range msgs {
+		p.published = append(p.published, msg.UUID)
+	}
+	return nil
+}
+
+func (p *flakyPublisher) Published() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.published...)
+}
+
+func (p *flakyPublisher) Calls() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.calls
+}
+
+func (p *flakyPublisher) Close() error { return nil }
+
+func TestBreakerPublisher_FailPolicy(t *testing.T) {
+	next := &flakyPublisher{down: true}
+	pub := NewBreakerPublisher(next, BreakerConfig{
+		Name:             "amqp_publisher",
+		FailureThreshold: 2,
+		OpenTimeout:      50 * time.Millisecond,
+	}, nil, watermill.NopLogger{})
+	defer pub.Close()
+
+	for range 2 {
+		require.Error(t, pub.Publish("symbol.created", message.NewMessage(watermill.NewUUID(), nil)))
+	}
+	assert.Equal(t, gobreaker.StateOpen, pub.State())
+	assert.Error(t, pub.Check(context.Background()))
+
+	err := pub.Publish("symbol.created", message.NewMessage(watermill.NewUUID(), nil))
+	require.ErrorIs(t, err, gobreaker.ErrOpenState)
+	assert.Equal(t, 2, next.Calls(), "an open breaker fails fast")
+
+	next.setDown(false)
+	time.Sleep(60 * time.Millisecond)
+	require.NoError(t, pub.Publish("symbol.created", message.NewMessage("m1", nil)))
+	assert.Equal(t, gobreaker.StateClosed, pub.State(), "a successful trial publish closes the breaker")
+	assert.NoError(t, pub.Check(context.Background()))
+}
+
+func TestBreakerPublisher_SpoolPolicy(t *testing.T) {
+	spool, err := NewSpool(t.TempDir())
+	require.NoError(t, err)
+
+	next := &flakyPublisher{down: true}
+	pub := NewBreakerPublisher(next, BreakerConfig{
+		Name:             "amqp_publisher",
+		FailureThreshold: 1,
+		OpenTimeout:      50 * time.Millisecond,
+		Spool:            spool,
+		RetryInterval:    10 * time.Millisecond,
+	}, nil, watermill.NopLogger{})
+	defer pub.Close()
+
+	require.NoError(t, pub.Publish("symbol.created", message.NewMessage("m1", nil)), "failed publishes are spooled")
+	require.NoError(t, pub.Publish("symbol.updated", message.NewMessage("m2", nil), message.NewMessage("m3", nil)))
+	assert.Equal(t, 3, spool.Len())
+
+	next.setDown(false)
+	require.NoError(t, pub.Publish("symbol.deleted", message.NewMessage("m4", nil)))
+
+	require.Eventually(t, func() bool {
+		return spool.Len() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"m1", "m2", "m3", "m4"}, next.Published(), "spooled messages keep their order")
+}
+
+func TestSpool(t *testing.T) {
+	dir := t.TempDir()
+	spool, err := NewSpool(dir)
+	require.NoError(t, err)
+
+	msg := message.NewMessage("m1", []byte("payload"))
+	SetMessageRoutingKey("symbol.created", msg)
+	require.NoError(t, spool.Append("lifecycle_events", msg, message.NewMessage("m2", nil)))
+
+	// Spooled messages survive a restart
+	spool, err = NewSpool(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 2, spool.Len())
+
+	errDown := errors.New("down")
+	var drained []*message.Message
+	n, err := spool.Drain(func(topic string, msg *message.Message) error {
+		assert.Equal(t, "lifecycle_events", topic)
+		if len(drained) == 1 {
+			return errDown
+		}
+		drained = append(drained, msg)
+		return nil
+	})
+	require.ErrorIs(t, err, errDown)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, 1, spool.Len(), "a failed publish stops the drain")
+	require.Len(t, drained, 1)
+	assert.Equal(t, "m1", drained[0].UUID)
+	assert.Equal(t, "payload", string(drained[0].Payload))
+	assert.Equal(t, "symbol.created", MessageRoutingKey(drained[0]))
+
+	n, err = spool.Drain(func(topic string, msg *message.Message) error {
+		drained = append(drained, msg)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, 0, spool.Len())
+	assert.Equal(t, "m2", drained[1].UUID)
+}
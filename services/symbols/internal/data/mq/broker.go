@@ -0,0 +1,62 @@
+package mq
+
+import (
+	"platform/events"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+// Brokers selectable with conf.Data.broker.
+const (
+	BrokerAMQP      = "amqp"
+	BrokerGoChannel = "gochannel"
+	BrokerSQL       = "sql"
+	BrokerNATS      = "nats"
+	BrokerKafka     = "kafka"
+)
+
+// Ordering keys of brokers delivering the events of a key in publishing order.
+const (
+	OrderingKeyProjectID = "project_id"
+	OrderingKeySymbolID  = "symbol_id"
+)
+
+// OrderingKey returns the value of the ordering key of msg, or "" without ordering key.
+func OrderingKey(msg *message.Message, key string) string {
+	switch key {
+	case OrderingKeyProjectID:
+		return msg.Metadata.Get(ProjectIDKey)
+	case OrderingKeySymbolID:
+		return msg.Metadata.Get(events.HeaderSubject)
+	}
+	return ""
+}
+
+// NewExchangePublisher returns a publisher sending every topic to the exchange topic, like the AMQP
+// fanout exchange does: the topic travels as the routing_key metadata only. It lets brokers without
+// exchanges, which deliver a topic to its subscribers only, feed the worker subscribed to the exchange.
+func NewExchangePublisher(pub message.Publisher, exchange string) message.Publisher {
+	return &exchangePublisher{pub: pub, exchange: exchange}
+}
+
+type exchangePublisher struct {
+	pub      message.Publisher
+	exchange string
+}
+
+func (p *exchangePublisher) Publish(topic string, msgs ...*message.Message) error {
+	for _, msg := range msgs {
+		SetMessageRoutingKey(topic, msg)
+	}
+
+	return p.pub.Publish(p.exchange, msgs...)
+}
+
+func (p *exchangePublisher) Close() error {
+	return p.pub.Close()
+}
+
+// Unwrap returns the publisher of the exchange topic.
+func (p *exchangePublisher) Unwrap() message.Publisher {
+	return p.pub
+}
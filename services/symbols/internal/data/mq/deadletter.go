@@ -0,0 +1,282 @@
+package mq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"platform/events"
+	"slices"
+	"strconv"
+
+	"github.com/ThreeDotsLabs/watermill-amqp/v3/pkg/amqp"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
+	"github.com/rabbitmq/amqp091-go"
+)
+
+// AttemptsKey is the metadata key holding how many times the worker tried to handle a message.
+const AttemptsKey = "attempts_poisoned"
+
+// MaxListLimit caps the dead letters listed at once. Listed dead letters stay unacknowledged
+// until the listing is done, so an unbounded listing would hold the whole queue.
+const MaxListLimit = 100
+
+// ErrDeadLetterNotFound is returned when no dead letter has the requested message ID.
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+// poisonKeys are the metadata keys describing why a message was dead-lettered.
+var poisonKeys = []string{
+	middleware.ReasonForPoisonedKey,
+	middleware.PoisonedTopicKey,
+	middleware.PoisonedHandlerKey,
+	middleware.PoisonedSubscriberKey,
+	AttemptsKey,
+}
+
+// DeadLetterPublisher publishes the messages the worker gave up on to the dead-letter queue.
+type DeadLetterPublisher interface {
+	message.Publisher
+}
+
+// NewMarshaler returns the AMQP marshaler of lifecycle events.
+// CloudEvents over AMQP maps datacontenttype to the content-type property.
+func NewMarshaler() amqp.Marshaler {
+	return marshaler{amqp.DefaultMarshaler{
+		PostprocessPublishing: func(p amqp091.Publishing) amqp091.Publishing {
+			if ct, ok := p.Headers[events.HeaderDataContentType].(string); ok {
+				p.ContentType = ct
+			}
+			return p
+		},
+	}}
+}
+
+// marshaler drops the headers RabbitMQ adds to dead-lettered messages, like those coming back from
+// a retry queue: x-death is a table, which message metadata cannot hold.
+type marshaler struct {
+	amqp.DefaultMarshaler
+}
+
+func (m marshaler) Unmarshal(d amqp091.Delivery) (*message.Message, error) {
+	for key := range d.Headers {
+		if isDeathHeader(key) {
+			// The delivery headers are not ours to modify
+			d.Headers = maps.Clone(d.Headers)
+			maps.DeleteFunc(d.Headers, func(key string, _ interface{}) bool { return isDeathHeader(key) })
+			break
+		}
+	}
+
+	return m.DefaultMarshaler.Unmarshal(d)
+}
+
+// DeclareDeadLetterQueue declares the durable dead-letter queue and, unless the default
+// exchange is used, the fanout exchange it is bound to.
+func DeclareDeadLetterQueue(ch *amqp091.Channel, exchange, queue string) error {
+	if _, err := ch.QueueDeclare(queue, true, false, false, false, nil); err != nil {
+		return fmt.Errorf("failed to declare dead-letter queue %s: %w", queue, err)
+	}
+	if exchange == "" {
+		return nil
+	}
+
+	if err := ch.ExchangeDeclare(exchange, amqp091.ExchangeFanout, true, false, false, false, nil); err != nil {
+		return fmt.Errorf("failed to declare dead-letter exchange %s: %w", exchange, err)
+	}
+	if err := ch.QueueBind(queue, "", exchange, false, nil); err != nil {
+		return fmt.Errorf("failed to bind dead-letter queue %s: %w", queue, err)
+	}
+
+	return nil
+}
+
+// DeadLetter is a message the worker gave up on.
+type DeadLetter struct {
+	ID         string
+	RoutingKey string
+	Reason     string
+	Attempts   int
+	Handler    string
+	Metadata   message.Metadata
+	Payload    []byte
+	// Redelivered is set when the dead letter was read before, e.g. by a previous List.
+	Redelivered bool
+}
+
+// Channel is the part of an AMQP channel the dead-letter queue needs.
+type Channel interface {
+	Get(queue string, autoAck bool) (amqp091.Delivery, bool, error)
+	PublishWithContext(ctx context.Context, exchange, key string, mandatory, immediate bool, msg amqp091.Publishing) error
+	Close() error
+}
+
+// DeadLetterQueue lists, inspects, requeues and discards dead letters.
+//
+// AMQP queues cannot be browsed, so every operation takes the dead letters off the queue
+// without acknowledging them and releases the ones it does not consume when done.
+// Released messages keep their position but are flagged as redelivered.
+type DeadLetterQueue struct {
+	open      func() (Channel, error)
+	queue     string
+	exchange  string
+	marshaler amqp.Marshaler
+}
+
+// NewDeadLetterQueue creates a DeadLetterQueue over the given dead-letter queue.
+// Requeued messages are published to exchange with their original routing key.
+func NewDeadLetterQueue(open func() (Channel, error), queue, exchange string) *DeadLetterQueue {
+	return &DeadLetterQueue{
+		open:      open,
+		queue:     queue,
+		exchange:  exchange,
+		marshaler: NewMarshaler(),
+	}
+}
+
+// List returns up to limit dead letters, oldest first. A limit that is not positive or above
+// MaxListLimit lists MaxListLimit of them. All of them stay on the queue.
+func (q *DeadLetterQueue) List(ctx context.Context, limit int) ([]*DeadLetter, error) {
+	if limit <= 0 || limit > MaxListLimit {
+		limit = MaxListLimit
+	}
+
+	ch, err := q.open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open channel: %w", err)
+	}
+	defer ch.Close()
+
+	var letters []*DeadLetter
+	deliveries, err := q.collect(ch, func(dl *DeadLetter) bool {
+		letters = append(letters, dl)
+		return len(letters) >= limit
+	})
+	if err := release(deliveries, err); err != nil {
+		return nil, err
+	}
+
+	return letters, nil
+}
+
+// Inspect returns the dead letter with the given message ID. It stays on the queue.
+func (q *DeadLetterQueue) Inspect(ctx context.Context, id string) (*DeadLetter, error) {
+	return q.find(id, nil)
+}
+
+// Requeue publishes the dead letter with the given message ID back to the lifecycle exchange,
+// without the dead-letter metadata, and removes it from the dead-letter queue.
+func (q *DeadLetterQueue) Requeue(ctx context.Context, id string) (*DeadLetter, error) {
+	return q.find(id, func(ch Channel, dl *DeadLetter) error {
+		msg := message.NewMessage(dl.ID, dl.Payload)
+		for k, v := range dl.Metadata {
+			if !slices.Contains(poisonKeys, k) {
+				msg.Metadata.Set(k, v)
+			}
+		}
+
+		publishing, err := q.marshaler.Marshal(msg)
+		if err != nil {
+			return fmt.Errorf("failed to marshal dead letter %s: %w", dl.ID, err)
+		}
+
+		if err := ch.PublishWithContext(ctx, q.exchange, dl.RoutingKey, false, false, publishing); err != nil {
+			return fmt.Errorf("failed to requeue dead letter %s: %w", dl.ID, err)
+		}
+
+		return nil
+	})
+}
+
+// Discard removes the dead letter with the given message ID from the dead-letter queue.
+func (q *DeadLetterQueue) Discard(ctx context.Context, id string) (*DeadLetter, error) {
+	return q.find(id, func(Channel, *DeadLetter) error { return nil })
+}
+
+// find looks up the dead letter with the given message ID. When consume is set it runs
+// on the dead letter, which is then removed from the queue.
+func (q *DeadLetterQueue) find(id string, consume func(ch Channel, dl *DeadLetter) error) (*DeadLetter, error) {
+	ch, err := q.open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open channel: %w", err)
+	}
+	defer ch.Close()
+
+	var found *DeadLetter
+	deliveries, err := q.collect(ch, func(dl *DeadLetter) bool {
+		if dl.ID == id {
+			found = dl
+		}
+		return found != nil
+	})
+	if err == nil && found != nil && consume != nil {
+		// The match is the last delivery collected
+		match := deliveries[len(deliveries)-1]
+		if err = consume(ch, found); err == nil {
+			if err = match.Ack(false); err == nil {
+				deliveries = deliveries[:len(deliveries)-1]
+			} else {
+				err = fmt.Errorf("failed to ack dead letter %s: %w", id, err)
+			}
+		}
+	}
+	if err := release(deliveries, err); err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, fmt.Errorf("%w: %s", ErrDeadLetterNotFound, id)
+	}
+
+	return found, nil
+}
+
+// collect gets dead letters one at a time, without acknowledging them, until stop returns
+// true or the queue is drained. The caller must release the returned deliveries.
+func (q *DeadLetterQueue) collect(ch Channel, stop func(dl *DeadLetter) bool) ([]amqp091.Delivery, error) {
+	var deliveries []amqp091.Delivery
+	for {
+		d, ok, err := ch.Get(q.queue, false)
+		if err != nil {
+			return deliveries, fmt.Errorf("failed to get dead letter: %w", err)
+		}
+		if !ok {
+			return deliveries, nil
+		}
+		deliveries = append(deliveries, d)
+
+		msg, err := q.marshaler.Unmarshal(d)
+		if err != nil {
+			return deliveries, fmt.Errorf("failed to unmarshal dead letter: %w", err)
+		}
+
+		if stop(toDeadLetter(msg, d.Redelivered)) {
+			return deliveries, nil
+		}
+	}
+}
+
+// release puts the deliveries back on the queue and returns err or the first release failure.
+func release(deliveries []amqp091.Delivery, err error) error {
+	for _, d := range deliveries {
+		if nackErr := d.Nack(false, true); nackErr != nil && err == nil {
+			err = fmt.Errorf("failed to release dead letter: %w", nackErr)
+		}
+	}
+
+	return err
+}
+
+func toDeadLetter(msg *message.Message, redelivered bool) *DeadLetter {
+	attempts, _ := strconv.Atoi(msg.Metadata.Get(AttemptsKey))
+
+	return &DeadLetter{
+		ID:          msg.UUID,
+		RoutingKey:  MessageRoutingKey(msg),
+		Reason:      msg.Metadata.Get(middleware.ReasonForPoisonedKey),
+		Attempts:    attempts,
+		Handler:     msg.Metadata.Get(middleware.PoisonedHandlerKey),
+		Metadata:    msg.Metadata,
+		Payload:     msg.Payload,
+		Redelivered: redelivered,
+	}
+}
@@ -0,0 +1,224 @@
+package mq
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
+	"github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeChannel serves a queue of dead letters and records what was acked, released and published.
+type fakeChannel struct {
+	queue     []amqp091.Delivery
+	next      int
+	acked     []uint64
+	released  []uint64
+	published []fakePublishing
+	getErr    error
+	closed    bool
+}
+
+type fakePublishing struct {
+	exchange string
+	key      string
+	msg      amqp091.Publishing
+}
+
+func (c *fakeChannel) Get(queue string, autoAck bool) (amqp091.Delivery, bool, error) {
+	if c.getErr != nil {
+		return amqp091.Delivery{}, false, c.getErr
+	}
+	if c.next >= len(c.queue) {
+		return amqp091.Delivery{}, false, nil
+	}
+	d := c.queue[c.next]
+	d.Acknowledger = c
+	c.next++
+	return d, true, nil
+}
+
+func (c *fakeChannel) PublishWithContext(_ context.Context, exchange, key string, _, _ bool, msg amqp091.Publishing) error {
+	c.published = append(c.published, fakePublishing{exchange: exchange, key: key, msg: msg})
+	return nil
+}
+
+func (c *fakeChannel) Close() error {
+	c.closed = true
+	return nil
+}
+
+func (c *fakeChannel) Ack(tag uint64, _ bool) error {
+	c.acked = append(c.acked, tag)
+	return nil
+}
+
+func (c *fakeChannel) Nack(tag uint64, _ bool, requeue bool) error {
+	if requeue {
+		c.released = append(c.released, tag)
+	}
+	return nil
+}
+
+func (c *fakeChannel) Reject(tag uint64, requeue bool) error {
+	return c.Nack(tag, false, requeue)
+}
+
+// deadLetterDelivery marshals a dead-lettered message the way the worker publishes it.
+func deadLetterDelivery(t *testing.T, tag uint64, id string) amqp091.Delivery {
+	msg := message.NewMessage(id, []byte("payload-"+id))
+	SetMessageRoutingKey("symbol.created", msg)
+	msg.Metadata.Set("correlation_id", "corr-"+id)
+	msg.Metadata.Set(middleware.ReasonForPoisonedKey, "db down")
+	msg.Metadata.Set(middleware.PoisonedHandlerKey, "events")
+	msg.Metadata.Set(AttemptsKey, "4")
+
+	publishing, err := NewMarshaler().Marshal(msg)
+	require.NoError(t, err)
+
+	return amqp091.Delivery{
+		DeliveryTag: tag,
+		Headers:     publishing.Headers,
+		Body:        publishing.Body,
+	}
+}
+
+func newTestDeadLetterQueue(t *testing.T, ids ...string) (*DeadLetterQueue, *fakeChannel) {
+	ch := &fakeChannel{}
+	for i, id := range ids {
+		ch.queue = append(ch.queue, deadLetterDelivery(t, uint64(i+1), id))
+	}
+
+	q := NewDeadLetterQueue(func() (Channel, error) { return ch, nil }, "lifecycle_event_queue.dlq", "lifecycle_events")
+	return q, ch
+}
+
+func TestDeadLetterQueue_List(t *testing.T) {
+	many := make([]string, MaxListLimit+1)
+	for i := range many {
+		many[i] = strconv.Itoa(i)
+	}
+
+	tests := []struct {
+		name    string
+		ids     []string
+		limit   int
+		wantIDs []string
+	}{
+		{name: "limited", ids: []string{"a", "b", "c"}, limit: 2, wantIDs: []string{"a", "b"}},
+		{name: "fewer than limit", ids: []string{"a", "b", "c"}, limit: 5, wantIDs: []string{"a", "b", "c"}},
+		{name: "zero capped", ids: many, limit: 0, wantIDs: many[:MaxListLimit]},
+		{name: "above max capped", ids: many, limit: MaxListLimit + 1, wantIDs: many[:MaxListLimit]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, ch := newTestDeadLetterQueue(t, tt.ids...)
+
+			letters, err := q.List(context.Background(), tt.limit)
+
+			require.NoError(t, err)
+			var ids []string
+			for _, dl := range letters {
+				ids = append(ids, dl.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, "db down", letters[0].Reason)
+			assert.Equal(t, 4, letters[0].Attempts)
+			assert.Equal(t, "events", letters[0].Handler)
+			assert.Equal(t, "symbol.created", letters[0].RoutingKey)
+			assert.Equal(t, []byte("payload-"+tt.ids[0]), letters[0].Payload)
+			assert.Empty(t, ch.acked, "listing must not consume dead letters")
+			assert.Len(t, ch.released, len(tt.wantIDs))
+			assert.True(t, ch.closed)
+		})
+	}
+}
+
+func TestDeadLetterQueue_Inspect(t *testing.T) {
+	q, ch := newTestDeadLetterQueue(t, "a", "b", "c")
+
+	dl, err := q.Inspect(context.Background(), "b")
+
+	require.NoError(t, err)
+	assert.Equal(t, "b", dl.ID)
+	assert.Equal(t, "corr-b", dl.Metadata.Get("correlation_id"))
+	assert.Empty(t, ch.acked)
+	assert.Equal(t, []uint64{1, 2}, ch.released)
+}
+
+func TestDeadLetterQueue_Requeue(t *testing.T) {
+	q, ch := newTestDeadLetterQueue(t, "a", "b", "c")
+
+	dl, err := q.Requeue(context.Background(), "b")
+
+	require.NoError(t, err)
+	assert.Equal(t, "b", dl.ID)
+	assert.Equal(t, []uint64{2}, ch.acked)
+	assert.Equal(t, []uint64{1}, ch.released)
+
+	require.Len(t, ch.published, 1)
+	p := ch.published[0]
+	assert.Equal(t, "lifecycle_events", p.exchange)
+	assert.Equal(t, "symbol.created", p.key)
+
+	msg, err := NewMarshaler().Unmarshal(amqp091.Delivery{Headers: p.msg.Headers, Body: p.msg.Body})
+	require.NoError(t, err)
+	assert.Equal(t, "b", msg.UUID)
+	assert.Equal(t, []byte("payload-b"), []byte(msg.Payload))
+	assert.Equal(t, "corr-b", msg.Metadata.Get("correlation_id"))
+	assert.Equal(t, "symbol.created", MessageRoutingKey(msg))
+	for _, k := range poisonKeys {
+		assert.Empty(t, msg.Metadata.Get(k), "requeued message must not carry %s", k)
+	}
+}
+
+func TestDeadLetterQueue_Discard(t *testing.T) {
+	q, ch := newTestDeadLetterQueue(t, "a", "b")
+
+	dl, err := q.Discard(context.Background(), "a")
+
+	require.NoError(t, err)
+	assert.Equal(t, "a", dl.ID)
+	assert.Equal(t, []uint64{1}, ch.acked)
+	assert.Empty(t, ch.released)
+	assert.Empty(t, ch.published)
+}
+
+func TestDeadLetterQueue_NotFound(t *testing.T) {
+	tests := []struct {
+		name string
+		op   func(q *DeadLetterQueue) error
+	}{
+		{name: "inspect", op: func(q *DeadLetterQueue) error { _, err := q.Inspect(context.Background(), "x"); return err }},
+		{name: "requeue", op: func(q *DeadLetterQueue) error { _, err := q.Requeue(context.Background(), "x"); return err }},
+		{name: "discard", op: func(q *DeadLetterQueue) error { _, err := q.Discard(context.Background(), "x"); return err }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, ch := newTestDeadLetterQueue(t, "a", "b")
+
+			err := tt.op(q)
+
+			assert.ErrorIs(t, err, ErrDeadLetterNotFound)
+			assert.Empty(t, ch.acked)
+			assert.Empty(t, ch.published)
+			assert.Equal(t, []uint64{1, 2}, ch.released, "every dead letter goes back to the queue")
+		})
+	}
+}
+
+func TestDeadLetterQueue_GetError(t *testing.T) {
+	q, ch := newTestDeadLetterQueue(t, "a")
+	ch.getErr = errors.New("channel closed")
+
+	_, err := q.List(context.Background(), MaxListLimit)
+
+	assert.ErrorContains(t, err, "channel closed")
+}
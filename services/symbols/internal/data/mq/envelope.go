@@ -0,0 +1,79 @@
+package mq
+
+import (
+	eventsv1 "contracts/gen/events/symbols/v1"
+	"errors"
+	"fmt"
+	"platform/events"
+	"symbols/internal/biz/event"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrUnknownEvent is returned by DecodeEvent for messages of an event type this service does not know.
+var ErrUnknownEvent = errors.New("unknown event type")
+
+// topicEvents maps each lifecycle topic to the protobuf message published on it.
+var topicEvents = map[string]proto.Message{
+	event.SymbolCreatedTopic:  &eventsv1.SymbolCreated{},
+	event.SymbolUpdatedTopic:  &eventsv1.SymbolUpdated{},
+	event.SymbolDeletedTopic:  &eventsv1.SymbolDeleted{},
+	event.SymbolLockedTopic:   &eventsv1.SymbolLocked{},
+	event.SymbolUnlockedTopic: &eventsv1.SymbolUnlocked{},
+}
+
+// typeEvents maps each CloudEvents type to the protobuf message it carries.
+var typeEvents = func() map[string]proto.Message {
+	types := make(map[string]proto.Message, len(topicEvents))
+	for _, evt := range topicEvents {
+		types[string(evt.ProtoReflect().Descriptor().FullName())] = evt
+	}
+	return types
+}()
+
+// EventType resolves the event type of a lifecycle event message in either form: the ce-type of a
+// CloudEvents envelope, or the event published on the routing key of a legacy message.
+// It returns an empty type for unknown routing keys.
+func EventType(msg *message.Message) (string, error) {
+	ce, err := events.ParseCloudEvent(msg)
+	if err != nil {
+		return "", err
+	}
+	if ce != nil {
+		return ce.Type, nil
+	}
+
+	evt, ok := topicEvents[MessageRoutingKey(msg)]
+	if !ok {
+		return "", nil
+	}
+
+	return string(evt.ProtoReflect().Descriptor().FullName()), nil
+}
+
+// DecodeEvent decodes a lifecycle event message in either form, see EventType.
+func DecodeEvent(msg *message.Message) (proto.Message, error) {
+	name, err := EventType(msg)
+	if err != nil {
+		return nil, err
+	}
+	if ct := msg.Metadata.Get(events.HeaderDataContentType); ct != "" && ct != events.ProtobufContentType {
+		return nil, fmt.Errorf("%w: unsupported content type %q", events.ErrInvalidCloudEvent, ct)
+	}
+
+	prototype, ok := typeEvents[name]
+	if !ok {
+		if name == "" {
+			name = MessageRoutingKey(msg)
+		}
+		return nil, fmt.Errorf("%w: %q", ErrUnknownEvent, name)
+	}
+
+	evt := prototype.ProtoReflect().New().Interface()
+	if err := proto.Unmarshal(msg.Payload, evt); err != nil {
+		return nil, fmt.Errorf("failed to decode %s event: %w", name, err)
+	}
+
+	return evt, nil
+}
@@ -0,0 +1,171 @@
+package mq
+
+import (
+	"context"
+	eventsv1 "contracts/gen/events/symbols/v1"
+	"os"
+	"platform/events"
+	"symbols/internal/biz/domain"
+	"symbols/internal/biz/event"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// capturingPublisher records the messages published to it.
+type capturingPublisher struct {
+	messages []*message.Message
+}
+
+func (p *capturingPublisher) Publish(_ string, msgs ...*message.Message) error {
+	p.messages = append(p.messages, msgs...)
+	return nil
+}
+
+func (p *capturingPublisher) Close() error { return nil }
+
+func TestEventPublisher_CloudEventsEnvelope(t *testing.T) {
+	pub := &capturingPublisher{}
+	ep := NewEventPublisher(pub, log.NewStdLogger(os.Stdout))
+	symbol := &domain.Symbol{ID: 42, Project: 7, UID: "6ba7b810-9dad-41d1-80b4-00c04fd430c8", Version: 1}
+
+	require.NoError(t, ep.PublishSymbolCreated(context.Background(), symbol))
+	require.Len(t, pub.messages, 1)
+	msg := pub.messages[0]
+
+	ce, err := events.ParseCloudEvent(msg)
+	require.NoError(t, err)
+	require.NotNil(t, ce)
+	assert.Equal(t, msg.UUID, ce.ID)
+	assert.Equal(t, "events.symbols.v1.SymbolCreated", ce.Type)
+	assert.Equal(t, EventSource, ce.Source)
+	assert.Equal(t, "42", ce.Subject)
+	assert.Equal(t, events.ProtobufContentType, ce.DataContentType)
+	assert.WithinDuration(t, time.Now(), ce.Time, time.Minute)
+	assert.Equal(t, event.SymbolCreatedTopic, MessageRoutingKey(msg))
+
+	evt, err := DecodeEvent(msg)
+	require.NoError(t, err)
+	created, ok := evt.(*eventsv1.SymbolCreated)
+	require.True(t, ok)
+	assert.Equal(t, uint64(42), created.GetId())
+	assert.Equal(t, uint64(7), created.GetProjectId())
+	assert.True(t, created.GetCreatedAt().AsTime().Equal(ce.Time), "ce-time is the event occurrence time")
+}
+
+func TestEventPublisher_ReplaySymbolCreated(t *testing.T) {
+	tests := []struct {
+		name           string
+		routingKey     string
+		wantRoutingKey string
+	}{
+		{name: "symbol created topic", wantRoutingKey: event.SymbolCreatedTopic},
+		{name: "routing key override", routingKey: "search.reindex", wantRoutingKey: "search.reindex"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pub := &capturingPublisher{}
+			ep := NewEventPublisher(pub, log.NewStdLogger(os.Stdout))
+			symbol := &domain.Symbol{ID: 42, Project: 7, UID: "6ba7b810-9dad-41d1-80b4-00c04fd430c8", Version: 1}
+
+			require.NoError(t, ep.ReplaySymbolCreated(context.Background(), symbol, tt.routingKey))
+			require.Len(t, pub.messages, 1)
+			msg := pub.messages[0]
+
+			assert.True(t, events.IsReplay(msg))
+			assert.Equal(t, tt.wantRoutingKey, MessageRoutingKey(msg))
+
+			evt, err := DecodeEvent(msg)
+			require.NoError(t, err, "replays carry the SymbolCreated type whatever the routing key")
+			assert.Equal(t, uint64(42), evt.(*eventsv1.SymbolCreated).GetId())
+		})
+	}
+}
+
+func TestDecodeEvent(t *testing.T) {
+	payload, err := proto.Marshal(&eventsv1.SymbolDeleted{Id: 1, ProjectId: 3})
+	require.NoError(t, err)
+
+	legacy := func(topic string) func() *message.Message {
+		return func() *message.Message {
+			msg := message.NewMessage(watermill.NewUUID(), payload)
+			SetMessageRoutingKey(topic, msg)
+			return msg
+		}
+	}
+	enveloped := func(ce events.CloudEvent) func() *message.Message {
+		return func() *message.Message {
+			msg := message.NewMessage(watermill.NewUUID(), payload)
+			events.SetCloudEvent(msg, ce)
+			return msg
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     func() *message.Message
+		wantErr error
+	}{
+		{
+			name: "legacy message",
+			msg:  legacy(event.SymbolDeletedTopic),
+		},
+		{
+			name: "cloudevents envelope",
+			msg: enveloped(events.CloudEvent{
+				Type:            "events.symbols.v1.SymbolDeleted",
+				Source:          EventSource,
+				DataContentType: events.ProtobufContentType,
+			}),
+		},
+		{
+			name: "envelope takes precedence over the routing key",
+			msg: func() *message.Message {
+				msg := enveloped(events.CloudEvent{Type: "events.symbols.v1.SymbolDeleted", Source: EventSource})()
+				SetMessageRoutingKey(event.SymbolCreatedTopic, msg)
+				return msg
+			},
+		},
+		{
+			name:    "unknown topic",
+			msg:     legacy("symbol.archived"),
+			wantErr: ErrUnknownEvent,
+		},
+		{
+			name:    "unknown type",
+			msg:     enveloped(events.CloudEvent{Type: "events.symbols.v1.SymbolArchived", Source: EventSource}),
+			wantErr: ErrUnknownEvent,
+		},
+		{
+			name: "unsupported content type",
+			msg: enveloped(events.CloudEvent{
+				Type:            "events.symbols.v1.SymbolDeleted",
+				Source:          EventSource,
+				DataContentType: "application/json",
+			}),
+			wantErr: events.ErrInvalidCloudEvent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evt, err := DecodeEvent(tt.msg())
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			deleted, ok := evt.(*eventsv1.SymbolDeleted)
+			require.True(t, ok)
+			assert.Equal(t, uint64(3), deleted.GetProjectId())
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package mq
+
+import (
+	"context"
+	"errors"
+	"platform/health"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+// ErrBrokerDisconnected fails the readiness of a publisher that lost its broker connection.
+var ErrBrokerDisconnected = errors.New("not connected to the broker")
+
+// PublisherChecker checks pub and the publishers it wraps: an open circuit breaker (Check) or a
+// lost broker connection (IsConnected, e.g. the AMQP and NATS publishers) fails it. Publishers
+// exposing neither, like the in-process broker, always pass.
+func PublisherChecker(pub message.Publisher) health.Checker {
+	return health.CheckerFunc(func(ctx context.Context) error {
+		for p := pub; p != nil; {
+			if c, ok := p.(health.Checker); ok {
+				if err := c.Check(ctx); err != nil {
+					return err
+				}
+			}
+			if c, ok := p.(interface{ IsConnected() bool }); ok && !c.IsConnected() {
+				return ErrBrokerDisconnected
+			}
+
+			u, ok := p.(interface{ Unwrap() message.Publisher })
+			if !ok {
+				break
+			}
+			p = u.Unwrap()
+		}
+		return nil
+	})
+}
@@ -0,0 +1,77 @@
+package mq
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// connPublisher reports a broker connection, like the AMQP publisher.
+type connPublisher struct {
+	flakyPublisher
+	connected bool
+}
+
+func (p *connPublisher) IsConnected() bool { return p.connected }
+
+func TestPublisherChecker(t *testing.T) {
+	tests := []struct {
+		name    string
+		pub     func() message.Publisher
+		wantErr string
+	}{
+		{
+			name: "no connection state",
+			pub:  func() message.Publisher { return &flakyPublisher{} },
+		},
+		{
+			name: "connected",
+			pub:  func() message.Publisher { return &connPublisher{connected: true} },
+		},
+		{
+			name:    "disconnected",
+			pub:     func() message.Publisher { return &connPublisher{} },
+			wantErr: ErrBrokerDisconnected.Error(),
+		},
+		{
+			name: "disconnected behind the exchange and the breaker",
+			pub: func() message.Publisher {
+				return NewExchangePublisher(
+					NewBreakerPublisher(&connPublisher{}, BreakerConfig{Name: "amqp_publisher"}, nil, watermill.NopLogger{}),
+					"lifecycle_events",
+				)
+			},
+			wantErr: ErrBrokerDisconnected.Error(),
+		},
+		{
+			name: "open breaker",
+			pub: func() message.Publisher {
+				pub := NewBreakerPublisher(&connPublisher{connected: true}, BreakerConfig{
+					Name:             "amqp_publisher",
+					FailureThreshold: 1,
+					OpenTimeout:      time.Minute,
+				}, nil, watermill.NopLogger{})
+				pub.next.(*connPublisher).down = true
+				_ = pub.Publish("symbol.created", message.NewMessage(watermill.NewUUID(), nil))
+				return pub
+			},
+			wantErr: "circuit breaker amqp_publisher is open",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := PublisherChecker(tt.pub()).Check(context.Background())
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
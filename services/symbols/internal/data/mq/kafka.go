@@ -0,0 +1,145 @@
+package mq
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/IBM/sarama"
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill-kafka/v3/pkg/kafka"
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+// KafkaConfig maps the topics of the symbols service to Kafka topics.
+type KafkaConfig struct {
+	// Brokers are the bootstrap brokers of the cluster.
+	Brokers []string
+	// Topic is the Kafka topic of the topics without an entry in Topics.
+	Topic string
+	// Topics overrides the Kafka topic of topics.
+	Topics map[string]string
+	// ConsumerGroup is the consumer group the subscribers share, like the AMQP queue.
+	ConsumerGroup string
+	// OrderingKey is the partition key of the events. Kafka keeps the order of a partition.
+	OrderingKey string
+}
+
+// KafkaTopic returns the Kafka topic topic is published to.
+func (c KafkaConfig) KafkaTopic(topic string) string {
+	if t, ok := c.Topics[topic]; ok {
+		return t
+	}
+	return c.Topic
+}
+
+// kafkaTopics returns every Kafka topic the events are published to.
+func (c KafkaConfig) kafkaTopics() []string {
+	topics := []string{c.Topic}
+	for _, t := range c.Topics {
+		if !slices.Contains(topics, t) {
+			topics = append(topics, t)
+		}
+	}
+	slices.Sort(topics)
+	return topics
+}
+
+// NewKafkaPublisher returns a publisher of the Kafka topics of cfg. The topic travels as the
+// routing_key metadata, and the ordering key of the event is its partition key.
+func NewKafkaPublisher(cfg KafkaConfig, logger watermill.LoggerAdapter) (message.Publisher, error) {
+	pub, err := kafka.NewPublisher(kafka.PublisherConfig{
+		Brokers: cfg.Brokers,
+		Marshaler: kafka.NewWithPartitioningMarshaler(func(_ string, msg *message.Message) (string, error) {
+			return OrderingKey(msg, cfg.OrderingKey), nil
+		}),
+	}, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &kafkaPublisher{pub: pub, cfg: cfg}, nil
+}
+
+type kafkaPublisher struct {
+	pub message.Publisher
+	cfg KafkaConfig
+}
+
+func (p *kafkaPublisher) Publish(topic string, msgs ...*message.Message) error {
+	for _, msg := range msgs {
+		SetMessageRoutingKey(topic, msg)
+	}
+
+	return p.pub.Publish(p.cfg.KafkaTopic(topic), msgs...)
+}
+
+func (p *kafkaPublisher) Close() error {
+	return p.pub.Close()
+}
+
+// NewKafkaSubscriber returns a subscriber of the consumer group of cfg. Every subscription consumes
+// all the Kafka topics of cfg, whatever its topic, like the AMQP queue bound to the exchange. A group
+// consuming for the first time starts from the oldest messages, so none published before is lost.
+func NewKafkaSubscriber(cfg KafkaConfig, logger watermill.LoggerAdapter) (message.Subscriber, error) {
+	saramaConfig := kafka.DefaultSaramaSubscriberConfig()
+	saramaConfig.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	sub, err := kafka.NewSubscriber(kafka.SubscriberConfig{
+		Brokers:               cfg.Brokers,
+		OverwriteSaramaConfig: saramaConfig,
+		ConsumerGroup:         cfg.ConsumerGroup,
+	}, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &kafkaSubscriber{sub: sub, cfg: cfg}, nil
+}
+
+type kafkaSubscriber struct {
+	sub message.Subscriber
+	cfg KafkaConfig
+}
+
+func (s *kafkaSubscriber) Subscribe(ctx context.Context, _ string) (<-chan *message.Message, error) {
+	topics := s.cfg.kafkaTopics()
+	if len(topics) == 1 {
+		return s.sub.Subscribe(ctx, topics[0])
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	out := make(chan *message.Message)
+	var wg sync.WaitGroup
+	for _, topic := range topics {
+		msgs, err := s.sub.Subscribe(ctx, topic)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for msg := range msgs {
+				select {
+				case out <- msg:
+				case <-ctx.Done():
+					msg.Nack()
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		cancel()
+		close(out)
+	}()
+
+	return out, nil
+}
+
+func (s *kafkaSubscriber) Close() error {
+	return s.sub.Close()
+}
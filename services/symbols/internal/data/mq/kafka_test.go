@@ -0,0 +1,23 @@
+package mq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKafkaConfig_Topics(t *testing.T) {
+	cfg := KafkaConfig{
+		Topic: "lifecycle_events",
+		Topics: map[string]string{
+			"symbol.deleted": "symbol_deletions",
+			"symbol.locked":  "symbol_locks",
+			"symbol.created": "lifecycle_events",
+		},
+	}
+
+	assert.Equal(t, "lifecycle_events", cfg.KafkaTopic("symbol.updated"))
+	assert.Equal(t, "symbol_deletions", cfg.KafkaTopic("symbol.deleted"))
+	assert.Equal(t, []string{"lifecycle_events", "symbol_deletions", "symbol_locks"}, cfg.kafkaTopics())
+	assert.Equal(t, []string{"lifecycle_events"}, KafkaConfig{Topic: "lifecycle_events"}.kafkaTopics())
+}
@@ -0,0 +1,64 @@
+package mq
+
+import (
+	"context"
+	"platform/metrics"
+	"symbols/internal/biz/domain"
+	"symbols/internal/biz/event"
+	"time"
+)
+
+// replayEventType labels the replays of SymbolCreated, whatever routing key they are published to.
+const replayEventType = event.SymbolCreatedTopic + ".replay"
+
+// NewEventPublisherWithMetrics wraps pub, recording every publish in m under the topic of its event.
+func NewEventPublisherWithMetrics(pub domain.SymbolEventPublisher, m *metrics.EventPublishMetrics) domain.SymbolEventPublisher {
+	return &eventPublisherWithMetrics{next: pub, metrics: m}
+}
+
+type eventPublisherWithMetrics struct {
+	next    domain.SymbolEventPublisher
+	metrics *metrics.EventPublishMetrics
+}
+
+func (p *eventPublisherWithMetrics) PublishSymbolCreated(ctx context.Context, symbol *domain.Symbol) error {
+	start := time.Now()
+	err := p.next.PublishSymbolCreated(ctx, symbol)
+	p.metrics.Observe(event.SymbolCreatedTopic, start, err)
+	return err
+}
+
+func (p *eventPublisherWithMetrics) PublishSymbolUpdated(ctx context.Context, symbol *domain.Symbol) error {
+	start := time.Now()
+	err := p.next.PublishSymbolUpdated(ctx, symbol)
+	p.metrics.Observe(event.SymbolUpdatedTopic, start, err)
+	return err
+}
+
+func (p *eventPublisherWithMetrics) PublishSymbolDeleted(ctx context.Context, symbol *domain.Symbol) error {
+	start := time.Now()
+	err := p.next.PublishSymbolDeleted(ctx, symbol)
+	p.metrics.Observe(event.SymbolDeletedTopic, start, err)
+	return err
+}
+
+func (p *eventPublisherWithMetrics) PublishSymbolLocked(ctx context.Context, symbol *domain.Symbol, lock *domain.SymbolLock) error {
+	start := time.Now()
+	err := p.next.PublishSymbolLocked(ctx, symbol, lock)
+	p.metrics.Observe(event.SymbolLockedTopic, start, err)
+	return err
+}
+
+func (p *eventPublisherWithMetrics) PublishSymbolUnlocked(ctx context.Context, symbol *domain.Symbol, lock *domain.SymbolLock, releasedBy string, forced bool) error {
+	start := time.Now()
+	err := p.next.PublishSymbolUnlocked(ctx, symbol, lock, releasedBy, forced)
+	p.metrics.Observe(event.SymbolUnlockedTopic, start, err)
+	return err
+}
+
+func (p *eventPublisherWithMetrics) ReplaySymbolCreated(ctx context.Context, symbol *domain.Symbol, routingKey string) error {
+	start := time.Now()
+	err := p.next.ReplaySymbolCreated(ctx, symbol, routingKey)
+	p.metrics.Observe(replayEventType, start, err)
+	return err
+}
@@ -0,0 +1,75 @@
+package mq
+
+import (
+	"context"
+	"errors"
+	"os"
+	"platform/build"
+	"platform/metrics"
+	"symbols/internal/biz/domain"
+	"symbols/internal/biz/event"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingPublisher fails the publishes to the topics in fail.
+type failingPublisher struct {
+	fail map[string]bool
+}
+
+func (p *failingPublisher) Publish(topic string, _ ...*message.Message) error {
+	if p.fail[topic] {
+		return errors.New("broker down")
+	}
+	return nil
+}
+
+func (p *failingPublisher) Close() error { return nil }
+
+func TestEventPublisherWithMetrics(t *testing.T) {
+	reg := metrics.NewRegistry(build.NewBuildInfo("test_service", "1.0.0"))
+	pub := NewEventPublisherWithMetrics(
+		NewEventPublisher(&failingPublisher{fail: map[string]bool{event.SymbolDeletedTopic: true}}, log.NewStdLogger(os.Stdout)),
+		metrics.NewEventPublishMetrics(reg),
+	)
+
+	ctx := context.Background()
+	symbol := &domain.Symbol{ID: 1, Project: 7}
+	lock := &domain.SymbolLock{SymbolID: 1}
+	require.NoError(t, pub.PublishSymbolCreated(ctx, symbol))
+	require.NoError(t, pub.PublishSymbolUpdated(ctx, symbol))
+	require.Error(t, pub.PublishSymbolDeleted(ctx, symbol))
+	require.NoError(t, pub.PublishSymbolLocked(ctx, symbol, lock))
+	require.NoError(t, pub.PublishSymbolUnlocked(ctx, symbol, lock, "user", false))
+	require.NoError(t, pub.ReplaySymbolCreated(ctx, symbol, "symbols.rebuild"))
+
+	families, err := reg.Unwrap().Gather()
+	require.NoError(t, err)
+
+	published := make(map[string]float64)
+	for _, f := range families {
+		if f.GetName() != "test_service_events_published_total" {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			labels := make(map[string]string)
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			published[labels["event_type"]+"/"+labels["outcome"]] = m.GetCounter().GetValue()
+		}
+	}
+
+	assert.Equal(t, map[string]float64{
+		"symbol.created/success":        1,
+		"symbol.updated/success":        1,
+		"symbol.deleted/error":          1,
+		"symbol.locked/success":         1,
+		"symbol.unlocked/success":       1,
+		"symbol.created.replay/success": 1,
+	}, published)
+}
@@ -0,0 +1,255 @@
+package mq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+// natsUUIDHeader is the header carrying the Watermill message UUID.
+const natsUUIDHeader = "_watermill_message_uuid"
+
+// natsSetupTimeout bounds the creation of streams and consumers.
+const natsSetupTimeout = 10 * time.Second
+
+// NATSConfig maps the topics of the symbols service to the subjects of a JetStream stream.
+type NATSConfig struct {
+	// Stream is the JetStream stream storing every subject under SubjectPrefix.
+	Stream string
+	// SubjectPrefix prefixes the subjects: topics are published to <prefix>.<topic>[.<ordering key>].
+	SubjectPrefix string
+	// Subjects overrides the subject token of topics.
+	Subjects map[string]string
+	// Consumer is the durable consumer the subscribers share, like the AMQP queue.
+	Consumer string
+	// OrderingKey appends the OrderingKey of the event to its subject. JetStream keeps the order of a subject.
+	OrderingKey string
+	// AckWait is how long JetStream waits for an ack before redelivering a message.
+	AckWait time.Duration
+	// MaxMessages is how many messages each subscription pulls ahead.
+	MaxMessages int
+}
+
+// Subject returns the subject msg is published to on topic.
+func (c NATSConfig) Subject(topic string, msg *message.Message) string {
+	if subject, ok := c.Subjects[topic]; ok {
+		topic = subject
+	}
+
+	subject := c.SubjectPrefix + "." + topic
+	if key := OrderingKey(msg, c.OrderingKey); key != "" {
+		subject += "." + key
+	}
+	return subject
+}
+
+// filterSubject matches every subject of the stream.
+func (c NATSConfig) filterSubject() string {
+	return c.SubjectPrefix + ".>"
+}
+
+// ensureStream creates or updates the stream of cfg.
+func ensureStream(js jetstream.JetStream, cfg NATSConfig) error {
+	ctx, cancel := context.WithTimeout(context.Background(), natsSetupTimeout)
+	defer cancel()
+
+	_, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     cfg.Stream,
+		Subjects: []string{cfg.filterSubject()},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create stream %s: %w", cfg.Stream, err)
+	}
+
+	return nil
+}
+
+// NewNATSPublisher returns a publisher of the JetStream stream of cfg, creating the stream.
+// It owns nc and closes it with the publisher.
+func NewNATSPublisher(nc *nats.Conn, cfg NATSConfig) (message.Publisher, error) {
+	js, err := jetstream.New(nc)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureStream(js, cfg); err != nil {
+		return nil, err
+	}
+
+	return &natsPublisher{nc: nc, js: js, cfg: cfg}, nil
+}
+
+type natsPublisher struct {
+	nc  *nats.Conn
+	js  jetstream.JetStream
+	cfg NATSConfig
+}
+
+func (p *natsPublisher) Publish(topic string, msgs ...*message.Message) error {
+	for _, msg := range msgs {
+		m := nats.NewMsg(p.cfg.Subject(topic, msg))
+		m.Data = msg.Payload
+		m.Header.Set(natsUUIDHeader, msg.UUID)
+		for k, v := range msg.Metadata {
+			m.Header.Set(k, v)
+		}
+
+		// The message ID lets JetStream drop the duplicates of a retried publish
+		if _, err := p.js.PublishMsg(msg.Context(), m, jetstream.WithMsgID(msg.UUID)); err != nil {
+			return fmt.Errorf("failed to publish message %s to subject %s: %w", msg.UUID, m.Subject, err)
+		}
+	}
+
+	return nil
+}
+
+func (p *natsPublisher) Close() error {
+	p.nc.Close()
+	return nil
+}
+
+// IsConnected reports whether the connection to the NATS server is up, for health checks.
+func (p *natsPublisher) IsConnected() bool {
+	return p.nc.IsConnected()
+}
+
+// NewNATSSubscriber returns a subscriber of the durable consumer of cfg, creating the stream.
+// Every subscription consumes all the subjects of the stream, whatever its topic, like the AMQP
+// queue bound to the exchange. It owns nc and closes it with the subscriber.
+func NewNATSSubscriber(nc *nats.Conn, cfg NATSConfig, logger watermill.LoggerAdapter) (message.Subscriber, error) {
+	js, err := jetstream.New(nc)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureStream(js, cfg); err != nil {
+		return nil, err
+	}
+
+	return &natsSubscriber{
+		nc:      nc,
+		js:      js,
+		cfg:     cfg,
+		logger:  logger,
+		closing: make(chan struct{}),
+	}, nil
+}
+
+type natsSubscriber struct {
+	nc     *nats.Conn
+	js     jetstream.JetStream
+	cfg    NATSConfig
+	logger watermill.LoggerAdapter
+
+	closing   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+func (s *natsSubscriber) Subscribe(ctx context.Context, topic string) (<-chan *message.Message, error) {
+	setupCtx, cancel := context.WithTimeout(ctx, natsSetupTimeout)
+	defer cancel()
+
+	consumer, err := s.js.CreateOrUpdateConsumer(setupCtx, s.cfg.Stream, jetstream.ConsumerConfig{
+		Durable:       s.cfg.Consumer,
+		FilterSubject: s.cfg.filterSubject(),
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       s.cfg.AckWait,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create consumer %s: %w", s.cfg.Consumer, err)
+	}
+
+	it, err := consumer.Messages(jetstream.PullMaxMessages(max(s.cfg.MaxMessages, 1)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to consume %s: %w", s.cfg.Consumer, err)
+	}
+
+	ctx, cancel = context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-s.closing:
+			cancel()
+		}
+		it.Stop()
+	}()
+
+	out := make(chan *message.Message)
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer close(out)
+		defer cancel()
+
+		logger := s.logger.With(watermill.LogFields{"topic": topic, "consumer": s.cfg.Consumer})
+		for {
+			m, err := it.Next()
+			if errors.Is(err, jetstream.ErrMsgIteratorClosed) {
+				return
+			}
+			if err != nil {
+				logger.Error("Failed to pull message", err, nil)
+				continue
+			}
+
+			if !s.deliver(ctx, m, out) {
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// deliver sends m to out and acks or naks it as the handler does. A nacked message is redelivered
+// by JetStream. It returns false when ctx is canceled.
+func (s *natsSubscriber) deliver(ctx context.Context, m jetstream.Msg, out chan<- *message.Message) bool {
+	msg := message.NewMessage(m.Headers().Get(natsUUIDHeader), m.Data())
+	for k, v := range m.Headers() {
+		// Nats- headers are set by JetStream
+		if k == natsUUIDHeader || strings.HasPrefix(k, "Nats-") || len(v) == 0 {
+			continue
+		}
+		msg.Metadata.Set(k, v[0])
+	}
+	msg.SetContext(ctx)
+
+	select {
+	case out <- msg:
+	case <-ctx.Done():
+		_ = m.Nak()
+		return false
+	}
+
+	select {
+	case <-msg.Acked():
+		if err := m.Ack(); err != nil {
+			s.logger.Error("Failed to ack message", err, watermill.LogFields{"message_uuid": msg.UUID})
+		}
+		return true
+	case <-msg.Nacked():
+		if err := m.Nak(); err != nil {
+			s.logger.Error("Failed to nack message", err, watermill.LogFields{"message_uuid": msg.UUID})
+		}
+		return true
+	case <-ctx.Done():
+		_ = m.Nak()
+		return false
+	}
+}
+
+func (s *natsSubscriber) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.closing)
+		s.wg.Wait()
+		s.nc.Close()
+	})
+	return nil
+}
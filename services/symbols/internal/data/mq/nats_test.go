@@ -0,0 +1,37 @@
+package mq
+
+import (
+	"platform/events"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNATSConfig_Subject(t *testing.T) {
+	msg := message.NewMessage(watermill.NewUUID(), nil)
+	msg.Metadata.Set(ProjectIDKey, "7")
+	msg.Metadata.Set(events.HeaderSubject, "42")
+
+	tests := []struct {
+		name string
+		cfg  NATSConfig
+		want string
+	}{
+		{name: "topic", cfg: NATSConfig{SubjectPrefix: "symbols"}, want: "symbols.symbol.created"},
+		{name: "project ordering key", cfg: NATSConfig{SubjectPrefix: "symbols", OrderingKey: OrderingKeyProjectID}, want: "symbols.symbol.created.7"},
+		{name: "symbol ordering key", cfg: NATSConfig{SubjectPrefix: "symbols", OrderingKey: OrderingKeySymbolID}, want: "symbols.symbol.created.42"},
+		{
+			name: "subject override",
+			cfg:  NATSConfig{SubjectPrefix: "symbols", Subjects: map[string]string{"symbol.created": "created"}},
+			want: "symbols.created",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.cfg.Subject("symbol.created", msg))
+		})
+	}
+}
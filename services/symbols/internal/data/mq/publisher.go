@@ -4,7 +4,10 @@ package mq
 import (
 	"context"
 	"fmt"
+	"platform/events"
 	middleware2 "platform/middleware"
+	"platform/tracing"
+	"strconv"
 	"symbols/internal/biz/domain"
 	"symbols/internal/biz/event"
 	"time"
@@ -18,6 +21,12 @@ import (
 
 const RoutingKey = "routing_key"
 
+// ProjectIDKey is the metadata key holding the project ID of a symbol event.
+const ProjectIDKey = "project_id"
+
+// EventSource is the CloudEvents source of the events published by the symbols service.
+const EventSource = "/services/symbols"
+
 func NewEventPublisher(pub message.Publisher, logger log.Logger) domain.SymbolEventPublisher {
 	return &eventPublisher{
 		pub:    pub,
@@ -35,9 +44,15 @@ func (ep *eventPublisher) Unwrap() message.Publisher {
 	return ep.pub
 }
 
+// Publish sends a raw payload without a CloudEvents envelope.
 func (ep *eventPublisher) Publish(ctx context.Context, topic string, payload []byte) error {
+	return ep.publish(ctx, topic, message.NewMessage(watermill.NewUUID(), payload))
+}
 
-	msg := message.NewMessage(watermill.NewUUID(), payload)
+func (ep *eventPublisher) publish(ctx context.Context, topic string, msg *message.Message) (err error) {
+	// The trace context travels in the metadata: the consumers process the message in children of the publish span
+	ctx, span := tracing.StartPublishSpan(ctx, topic, msg)
+	defer func() { tracing.EndSpan(span, err) }()
 
 	// Propagate context to subscriber
 	msg.SetContext(ctx)
@@ -66,55 +81,114 @@ func (ep *eventPublisher) Publish(ctx context.Context, topic string, payload []b
 	return nil
 }
 
-func (ep *eventPublisher) PublishSymbolCreated(ctx context.Context, symbol *domain.Symbol) error {
-	// Convert to event proto using mapper
-	evt := event.ToSymbolCreatedEvent(symbol, time.Now())
-	if evt == nil {
-		return fmt.Errorf("failed to convert symbol to created event: symbol is nil")
+// publishEvent publishes a symbol event in a CloudEvents binary-mode envelope.
+func (ep *eventPublisher) publishEvent(ctx context.Context, topic string, symbol *domain.Symbol, at time.Time, evt proto.Message) error {
+	msg, err := newEventMessage(symbol, at, evt)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s event: %w", topic, err)
 	}
 
-	// Marshal proto to bytes
+	return ep.publish(ctx, topic, msg)
+}
+
+// newEventMessage wraps a symbol event in a CloudEvents binary-mode envelope.
+// The event type is the fully qualified name of its protobuf message and the subject is the symbol ID.
+// The project ID travels in the metadata, for brokers ordering events by project.
+func newEventMessage(symbol *domain.Symbol, at time.Time, evt proto.Message) (*message.Message, error) {
 	payload, err := proto.Marshal(evt)
 	if err != nil {
-		return fmt.Errorf("failed to marshal symbol created event: %w", err)
+		return nil, err
 	}
 
-	// Publish using base publisher (handles context, correlation ID, logging)
-	return ep.Publish(ctx, event.SymbolCreatedTopic, payload)
+	msg := message.NewMessage(watermill.NewUUID(), payload)
+	events.SetCloudEvent(msg, events.CloudEvent{
+		Type:            string(evt.ProtoReflect().Descriptor().FullName()),
+		Source:          EventSource,
+		Subject:         strconv.FormatUint(symbol.ID, 10),
+		Time:            at,
+		DataContentType: events.ProtobufContentType,
+	})
+	msg.Metadata.Set(ProjectIDKey, strconv.FormatUint(symbol.Project, 10))
+
+	return msg, nil
 }
 
-func (ep *eventPublisher) PublishSymbolUpdated(ctx context.Context, symbol *domain.Symbol) error {
+func (ep *eventPublisher) PublishSymbolCreated(ctx context.Context, symbol *domain.Symbol) error {
+	now := time.Now()
+
 	// Convert to event proto using mapper
-	evt := event.ToSymbolUpdatedEvent(symbol, time.Now())
+	evt := event.ToSymbolCreatedEvent(symbol, now)
 	if evt == nil {
-		return fmt.Errorf("failed to convert symbol to updated event: symbol is nil")
+		return fmt.Errorf("failed to convert symbol to created event: symbol is nil")
 	}
 
-	// Marshal proto to bytes
-	payload, err := proto.Marshal(evt)
-	if err != nil {
-		return fmt.Errorf("failed to marshal symbol updated event: %w", err)
+	return ep.publishEvent(ctx, event.SymbolCreatedTopic, symbol, now, evt)
+}
+
+func (ep *eventPublisher) PublishSymbolUpdated(ctx context.Context, symbol *domain.Symbol) error {
+	now := time.Now()
+
+	// Convert to event proto using mapper
+	evt := event.ToSymbolUpdatedEvent(symbol, now)
+	if evt == nil {
+		return fmt.Errorf("failed to convert symbol to updated event: symbol is nil")
 	}
 
-	// Publish using base publisher (handles context, correlation ID, logging)
-	return ep.Publish(ctx, event.SymbolUpdatedTopic, payload)
+	return ep.publishEvent(ctx, event.SymbolUpdatedTopic, symbol, now, evt)
 }
 
 func (ep *eventPublisher) PublishSymbolDeleted(ctx context.Context, symbol *domain.Symbol) error {
+	now := time.Now()
+
 	// Convert to event proto using mapper
-	evt := event.ToSymbolDeletedEvent(symbol, time.Now())
+	evt := event.ToSymbolDeletedEvent(symbol, now)
 	if evt == nil {
 		return fmt.Errorf("failed to convert symbol to deleted event: symbol is nil")
 	}
 
-	// Marshal proto to bytes
-	payload, err := proto.Marshal(evt)
+	return ep.publishEvent(ctx, event.SymbolDeletedTopic, symbol, now, evt)
+}
+
+func (ep *eventPublisher) PublishSymbolLocked(ctx context.Context, symbol *domain.Symbol, lock *domain.SymbolLock) error {
+	evt := event.ToSymbolLockedEvent(symbol, lock)
+	if evt == nil {
+		return fmt.Errorf("failed to convert symbol to locked event: symbol or lock is nil")
+	}
+
+	return ep.publishEvent(ctx, event.SymbolLockedTopic, symbol, lock.AcquiredAt, evt)
+}
+
+func (ep *eventPublisher) PublishSymbolUnlocked(ctx context.Context, symbol *domain.Symbol, lock *domain.SymbolLock, releasedBy string, forced bool) error {
+	now := time.Now()
+
+	evt := event.ToSymbolUnlockedEvent(symbol, lock, releasedBy, forced, now)
+	if evt == nil {
+		return fmt.Errorf("failed to convert symbol to unlocked event: symbol or lock is nil")
+	}
+
+	return ep.publishEvent(ctx, event.SymbolUnlockedTopic, symbol, now, evt)
+}
+
+func (ep *eventPublisher) ReplaySymbolCreated(ctx context.Context, symbol *domain.Symbol, routingKey string) error {
+	now := time.Now()
+
+	evt := event.ToSymbolCreatedEvent(symbol, now)
+	if evt == nil {
+		return fmt.Errorf("failed to convert symbol to created event: symbol is nil")
+	}
+
+	topic := event.SymbolCreatedTopic
+	if routingKey != "" {
+		topic = routingKey
+	}
+
+	msg, err := newEventMessage(symbol, now, evt)
 	if err != nil {
-		return fmt.Errorf("failed to marshal symbol deleted event: %w", err)
+		return fmt.Errorf("failed to marshal %s event: %w", topic, err)
 	}
+	msg.Metadata.Set(events.ReplayKey, "true")
 
-	// Publish using base publisher (handles context, correlation ID, logging)
-	return ep.Publish(ctx, event.SymbolDeletedTopic, payload)
+	return ep.publish(ctx, topic, msg)
 }
 
 func SetMessageRoutingKey(key string, msg *message.Message) {
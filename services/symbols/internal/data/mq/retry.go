@@ -0,0 +1,78 @@
+package mq
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/rabbitmq/amqp091-go"
+)
+
+// RetryPublisher publishes failed messages to the retry queues, see DeclareRetryQueues.
+// Topics are retry queue names.
+type RetryPublisher interface {
+	message.Publisher
+}
+
+// RetryPolicy sets how a handler retries the messages it fails to handle.
+type RetryPolicy struct {
+	// Delays are the delays before the nth retry; the last one is reused for later retries.
+	// Without delays, messages are retried in-process.
+	Delays []time.Duration
+	// MaxAttempts is how many times a message is tried before it is dead-lettered.
+	MaxAttempts int
+}
+
+// Delayed reports whether failed messages wait in the retry queues.
+func (p RetryPolicy) Delayed() bool {
+	return len(p.Delays) > 0
+}
+
+// Delay returns the delay before the retry following the given attempt, attempts counting from 1.
+func (p RetryPolicy) Delay(attempt int) time.Duration {
+	return p.Delays[min(max(attempt, 1), len(p.Delays))-1]
+}
+
+// RetryQueueName returns the name of the retry queue of queue holding messages for delay,
+// e.g. "lifecycle_event_queue.retry.10s".
+func RetryQueueName(queue string, delay time.Duration) string {
+	var d string
+	switch {
+	case delay%time.Hour == 0:
+		d = fmt.Sprintf("%dh", delay/time.Hour)
+	case delay%time.Minute == 0:
+		d = fmt.Sprintf("%dm", delay/time.Minute)
+	case delay%time.Second == 0:
+		d = fmt.Sprintf("%ds", delay/time.Second)
+	default:
+		d = fmt.Sprintf("%dms", delay/time.Millisecond)
+	}
+
+	return queue + ".retry." + d
+}
+
+// DeclareRetryQueues declares a durable retry queue of queue per delay. Messages expire from a retry queue
+// after its delay and are dead-lettered through the default exchange back to queue, and only to queue:
+// other queues bound to the lifecycle exchange do not see the retries.
+func DeclareRetryQueues(ch *amqp091.Channel, queue string, delays []time.Duration) error {
+	for _, delay := range slices.Compact(slices.Sorted(slices.Values(delays))) {
+		name := RetryQueueName(queue, delay)
+		_, err := ch.QueueDeclare(name, true, false, false, false, amqp091.Table{
+			"x-message-ttl":             delay.Milliseconds(),
+			"x-dead-letter-exchange":    "",
+			"x-dead-letter-routing-key": queue,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to declare retry queue %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// isDeathHeader reports whether key is one of the headers RabbitMQ adds to the messages it dead-letters.
+func isDeathHeader(key string) bool {
+	return key == "x-death" || strings.HasPrefix(key, "x-first-death-") || strings.HasPrefix(key, "x-last-death-")
+}
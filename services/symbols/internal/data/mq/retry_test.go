@@ -0,0 +1,67 @@
+package mq
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryQueueName(t *testing.T) {
+	tests := []struct {
+		delay time.Duration
+		want  string
+	}{
+		{delay: 10 * time.Second, want: "queue.retry.10s"},
+		{delay: 90 * time.Second, want: "queue.retry.90s"},
+		{delay: 10 * time.Minute, want: "queue.retry.10m"},
+		{delay: 2 * time.Hour, want: "queue.retry.2h"},
+		{delay: 1500 * time.Millisecond, want: "queue.retry.1500ms"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, RetryQueueName("queue", tt.delay))
+		})
+	}
+}
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	p := RetryPolicy{Delays: []time.Duration{10 * time.Second, time.Minute, 10 * time.Minute}}
+
+	assert.True(t, p.Delayed())
+	assert.Equal(t, 10*time.Second, p.Delay(1))
+	assert.Equal(t, time.Minute, p.Delay(2))
+	assert.Equal(t, 10*time.Minute, p.Delay(3))
+	assert.Equal(t, 10*time.Minute, p.Delay(7), "the last delay is reused")
+	assert.Equal(t, 10*time.Second, p.Delay(0))
+	assert.False(t, RetryPolicy{}.Delayed())
+}
+
+func TestMarshaler_DropsDeathHeaders(t *testing.T) {
+	msg := message.NewMessage("id", []byte("payload"))
+	msg.Metadata.Set(RoutingKey, "symbol.created")
+	msg.Metadata.Set(AttemptsKey, "1")
+	publishing, err := NewMarshaler().Marshal(msg)
+	require.NoError(t, err)
+
+	// RabbitMQ records the dead-lettering of a message coming back from a retry queue
+	headers := publishing.Headers
+	headers["x-death"] = []interface{}{amqp091.Table{"count": int64(1), "queue": "queue.retry.10s", "reason": "expired"}}
+	headers["x-first-death-queue"] = "queue.retry.10s"
+	headers["x-last-death-reason"] = "expired"
+
+	got, err := NewMarshaler().Unmarshal(amqp091.Delivery{Headers: headers, Body: publishing.Body})
+	require.NoError(t, err)
+
+	assert.Equal(t, "id", got.UUID)
+	assert.Equal(t, "symbol.created", MessageRoutingKey(got))
+	assert.Equal(t, "1", got.Metadata.Get(AttemptsKey))
+	assert.NotContains(t, got.Metadata, "x-death")
+	assert.NotContains(t, got.Metadata, "x-first-death-queue")
+	assert.NotContains(t, got.Metadata, "x-last-death-reason")
+	assert.Contains(t, headers, "x-death", "the delivery headers are left untouched")
+}
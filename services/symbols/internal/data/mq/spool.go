@@ -0,0 +1,142 @@
+package mq
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+// spoolExt is the extension of the files of spooled messages.
+const spoolExt = ".msg"
+
+// Spool stores messages in a local directory, one file per message, until they can be published.
+// Files are named after the time they were spooled, so a directory listing is in publish order.
+type Spool struct {
+	dir string
+
+	mu    sync.Mutex
+	seq   uint64
+	count atomic.Int64
+}
+
+// spooledMessage is the file content of a spooled message.
+type spooledMessage struct {
+	UUID     string            `json:"uuid"`
+	Topic    string            `json:"topic"`
+	Metadata map[string]string `json:"metadata"`
+	Payload  []byte            `json:"payload"`
+}
+
+// NewSpool opens the spool in dir, creating the directory. Messages left by a previous run are kept.
+func NewSpool(dir string) (*Spool, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create spool directory %s: %w", dir, err)
+	}
+
+	s := &Spool{dir: dir}
+	files, err := s.files()
+	if err != nil {
+		return nil, err
+	}
+	s.count.Store(int64(len(files)))
+
+	return s, nil
+}
+
+// Len returns the number of spooled messages.
+func (s *Spool) Len() int {
+	return int(s.count.Load())
+}
+
+// Append spools msgs for topic. Each file is written under a temporary name and renamed,
+// so a crash never leaves a partial message behind.
+func (s *Spool) Append(topic string, msgs ...*message.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, msg := range msgs {
+		data, err := json.Marshal(spooledMessage{
+			UUID:     msg.UUID,
+			Topic:    topic,
+			Metadata: msg.Metadata,
+			Payload:  msg.Payload,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to marshal spooled message %s: %w", msg.UUID, err)
+		}
+
+		s.seq++
+		name := filepath.Join(s.dir, fmt.Sprintf("%020d-%06d%s", time.Now().UnixNano(), s.seq%1_000_000, spoolExt))
+		if err := os.WriteFile(name+".tmp", data, 0o640); err != nil {
+			return fmt.Errorf("failed to spool message %s: %w", msg.UUID, err)
+		}
+		if err := os.Rename(name+".tmp", name); err != nil {
+			return fmt.Errorf("failed to spool message %s: %w", msg.UUID, err)
+		}
+		s.count.Add(1)
+	}
+
+	return nil
+}
+
+// Drain publishes the spooled messages in order, removing each once published. It stops at the
+// first failed publish, leaving that message and the following ones spooled, and returns how many
+// messages were published.
+func (s *Spool) Drain(publish func(topic string, msg *message.Message) error) (int, error) {
+	files, err := s.files()
+	if err != nil {
+		return 0, err
+	}
+
+	for i, name := range files {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return i, fmt.Errorf("failed to read spooled message %s: %w", name, err)
+		}
+		var sm spooledMessage
+		if err := json.Unmarshal(data, &sm); err != nil {
+			return i, fmt.Errorf("failed to unmarshal spooled message %s: %w", name, err)
+		}
+
+		msg := message.NewMessage(sm.UUID, sm.Payload)
+		for k, v := range sm.Metadata {
+			msg.Metadata.Set(k, v)
+		}
+		if err := publish(sm.Topic, msg); err != nil {
+			return i, err
+		}
+
+		if err := os.Remove(name); err != nil {
+			return i + 1, fmt.Errorf("failed to remove spooled message %s: %w", name, err)
+		}
+		s.count.Add(-1)
+	}
+
+	return len(files), nil
+}
+
+// files returns the paths of the spooled messages, oldest first.
+func (s *Spool) files() ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spool directory %s: %w", s.dir, err)
+	}
+
+	var files []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), spoolExt) {
+			files = append(files, filepath.Join(s.dir, e.Name()))
+		}
+	}
+	sort.Strings(files)
+
+	return files, nil
+}
@@ -0,0 +1,188 @@
+package mq
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"symbols/internal/data/common"
+
+	"github.com/ThreeDotsLabs/watermill"
+	wmsql "github.com/ThreeDotsLabs/watermill-sql/v3/pkg/sql"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"gorm.io/gorm"
+)
+
+// NewSQLAdapters returns the Watermill SQL Pub/Sub schema and offsets adapters of a GORM dialect.
+// Payloads are stored as binary: the default JSON column rejects protobuf events.
+func NewSQLAdapters(dialect string) (wmsql.SchemaAdapter, wmsql.OffsetsAdapter, error) {
+	switch dialect {
+	case "mysql":
+		schema := wmsql.DefaultMySQLSchema{
+			GeneratePayloadType: func(string) string { return "LONGBLOB" },
+		}
+		return schema, wmsql.DefaultMySQLOffsetsAdapter{}, nil
+	case "sqlite":
+		return sqliteSchema{}, sqliteOffsetsAdapter{}, nil
+	}
+
+	return nil, nil, fmt.Errorf("the sql broker does not support %s databases", dialect)
+}
+
+// InitializeSQLSchema creates the message table of topic unless it exists.
+func InitializeSQLSchema(db *gorm.DB, schema wmsql.SchemaAdapter, topic string) error {
+	for _, q := range schema.SchemaInitializingQueries(topic) {
+		if err := db.Exec(q.Query, q.Args...).Error; err != nil {
+			return fmt.Errorf("failed to create the message table of topic %s: %w", topic, err)
+		}
+	}
+
+	return nil
+}
+
+// NewSQLPublisher returns a publisher inserting messages into the Watermill SQL Pub/Sub tables with the
+// transaction their context carries, so events commit and roll back with the changes they announce.
+func NewSQLPublisher(db *gorm.DB, schema wmsql.SchemaAdapter, logger watermill.LoggerAdapter) message.Publisher {
+	return &sqlPublisher{db: db, schema: schema, logger: logger}
+}
+
+type sqlPublisher struct {
+	db     *gorm.DB
+	schema wmsql.SchemaAdapter
+	logger watermill.LoggerAdapter
+}
+
+func (p *sqlPublisher) Publish(topic string, msgs ...*message.Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	// The messages of one call are inserted together, with the transaction of the first one
+	conn := common.DB(msgs[0].Context(), p.db).Statement.ConnPool
+	pub, err := wmsql.NewPublisher(connExecutor{conn}, wmsql.PublisherConfig{SchemaAdapter: p.schema}, p.logger)
+	if err != nil {
+		return err
+	}
+
+	return pub.Publish(topic, msgs...)
+}
+
+func (p *sqlPublisher) Close() error {
+	return nil
+}
+
+// NewSQLSubscriber wraps a Watermill SQL subscriber so handlers join the transaction it consumes a
+// message in through common.DB: their writes commit with the consumer offset and roll back with it.
+func NewSQLSubscriber(sub message.Subscriber, db *gorm.DB) message.Subscriber {
+	return &sqlSubscriber{sub: sub, db: db}
+}
+
+type sqlSubscriber struct {
+	sub message.Subscriber
+	db  *gorm.DB
+}
+
+func (s *sqlSubscriber) Subscribe(ctx context.Context, topic string) (<-chan *message.Message, error) {
+	msgs, err := s.sub.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *message.Message)
+	go func() {
+		defer close(out)
+
+		for msg := range msgs {
+			if tx, ok := wmsql.TxFromContext(msg.Context()); ok {
+				// A session with a context has its own statement, so the shared one keeps its pool
+				db := s.db.Session(&gorm.Session{NewDB: true, Context: msg.Context()})
+				db.Statement.ConnPool = tx
+				msg.SetContext(common.WithTx(msg.Context(), db))
+			}
+
+			select {
+			case out <- msg:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func (s *sqlSubscriber) Close() error {
+	return s.sub.Close()
+}
+
+// connExecutor adapts a GORM connection pool, the database or a transaction, to the Watermill SQL executor.
+type connExecutor struct {
+	gorm.ConnPool
+}
+
+func (e connExecutor) Exec(query string, args ...any) (sql.Result, error) {
+	return e.ExecContext(context.Background(), query, args...)
+}
+
+func (e connExecutor) Query(query string, args ...any) (*sql.Rows, error) {
+	return e.QueryContext(context.Background(), query, args...)
+}
+
+func (e connExecutor) QueryRow(query string, args ...any) *sql.Row {
+	return e.QueryRowContext(context.Background(), query, args...)
+}
+
+// sqliteSchema is the MySQL schema with SQLite DDL; SQLite accepts the MySQL quoting of its queries.
+type sqliteSchema struct {
+	wmsql.DefaultMySQLSchema
+}
+
+func (s sqliteSchema) SchemaInitializingQueries(topic string) []wmsql.Query {
+	createMessagesTable := strings.Join([]string{
+		"CREATE TABLE IF NOT EXISTS " + s.MessagesTable(topic) + " (",
+		"`offset` INTEGER PRIMARY KEY AUTOINCREMENT,",
+		"`uuid` VARCHAR(36) NOT NULL,",
+		"`created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,",
+		"`payload` BLOB DEFAULT NULL,",
+		"`metadata` JSON DEFAULT NULL",
+		")",
+	}, "\n")
+
+	return []wmsql.Query{{Query: createMessagesTable}}
+}
+
+// SubscribeIsolationLevel is the default: SQLite transactions are serializable.
+func (s sqliteSchema) SubscribeIsolationLevel() sql.IsolationLevel {
+	return sql.LevelDefault
+}
+
+// sqliteOffsetsAdapter is the MySQL offsets adapter with SQLite upserts. SQLite locks the whole
+// database for writing, so it needs no FOR UPDATE.
+type sqliteOffsetsAdapter struct {
+	wmsql.DefaultMySQLOffsetsAdapter
+}
+
+func (a sqliteOffsetsAdapter) AckMessageQuery(topic string, row wmsql.Row, consumerGroup string) wmsql.Query {
+	ackQuery := `INSERT INTO ` + a.MessagesOffsetsTable(topic) + ` (offset_consumed, offset_acked, consumer_group)
+		VALUES (?, ?, ?) ON CONFLICT (consumer_group) DO UPDATE SET offset_consumed=excluded.offset_consumed, offset_acked=excluded.offset_acked`
+
+	return wmsql.Query{Query: ackQuery, Args: []any{row.Offset, row.Offset, consumerGroup}}
+}
+
+func (a sqliteOffsetsAdapter) ConsumedMessageQuery(topic string, row wmsql.Row, consumerGroup string, _ []byte) wmsql.Query {
+	consumedQuery := `INSERT INTO ` + a.MessagesOffsetsTable(topic) + ` (offset_consumed, consumer_group)
+		VALUES (?, ?) ON CONFLICT (consumer_group) DO UPDATE SET offset_consumed=excluded.offset_consumed`
+
+	return wmsql.Query{Query: consumedQuery, Args: []any{row.Offset, consumerGroup}}
+}
+
+func (a sqliteOffsetsAdapter) NextOffsetQuery(topic, consumerGroup string) wmsql.Query {
+	return wmsql.Query{
+		Query: `SELECT COALESCE(
+				(SELECT offset_acked
+				 FROM ` + a.MessagesOffsetsTable(topic) + `
+				 WHERE consumer_group=?
+				), 0)`,
+		Args: []any{consumerGroup},
+	}
+}
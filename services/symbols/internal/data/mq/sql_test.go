@@ -0,0 +1,180 @@
+package mq
+
+import (
+	"context"
+	"errors"
+	"symbols/internal/data/common"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	wmsql "github.com/ThreeDotsLabs/watermill-sql/v3/pkg/sql"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+const sqlTestTopic = "lifecycle_events"
+
+// sqlRecord is a table written by the tests alongside the messages.
+type sqlRecord struct {
+	ID uint64
+}
+
+// setupSQLPubSub opens an in-memory SQLite database with the message table of sqlTestTopic and
+// returns the publisher and subscriber of the sql broker.
+func setupSQLPubSub(t *testing.T) (*gorm.DB, message.Publisher, message.Subscriber) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	// Every connection of an in-memory database is a separate database
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.AutoMigrate(&sqlRecord{}))
+
+	schema, offsets, err := NewSQLAdapters(db.Dialector.Name())
+	require.NoError(t, err)
+	require.NoError(t, InitializeSQLSchema(db, schema, sqlTestTopic))
+
+	sub, err := wmsql.NewSubscriber(sqlDB, wmsql.SubscriberConfig{
+		ConsumerGroup:    "symbols_lifecycle_queue",
+		PollInterval:     10 * time.Millisecond,
+		ResendInterval:   10 * time.Millisecond,
+		SchemaAdapter:    schema,
+		OffsetsAdapter:   offsets,
+		InitializeSchema: true,
+	}, watermill.NopLogger{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sub.Close() })
+
+	return db, NewSQLPublisher(db, schema, watermill.NopLogger{}), NewSQLSubscriber(sub, db)
+}
+
+// receive returns the next message of msgs, failing the test when none arrives.
+func receive(t *testing.T, msgs <-chan *message.Message) *message.Message {
+	select {
+	case msg := <-msgs:
+		return msg
+	case <-time.After(5 * time.Second):
+		t.Fatal("no message received")
+		return nil
+	}
+}
+
+func TestSQLPublisher_PublishesWithTransaction(t *testing.T) {
+	db, pub, sub := setupSQLPubSub(t)
+	errRollback := errors.New("rollback")
+
+	for _, uuid := range []string{"rolled-back", "committed"} {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			msg := message.NewMessage(uuid, []byte("payload"))
+			msg.SetContext(common.WithTx(context.Background(), tx))
+			require.NoError(t, pub.Publish(sqlTestTopic, msg))
+
+			if uuid == "rolled-back" {
+				return errRollback
+			}
+			return nil
+		})
+		if uuid == "rolled-back" {
+			require.ErrorIs(t, err, errRollback)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	msgs, err := sub.Subscribe(ctx, sqlTestTopic)
+	require.NoError(t, err)
+
+	msg := receive(t, msgs)
+	assert.Equal(t, "committed", msg.UUID, "messages roll back with their transaction")
+	assert.Equal(t, "payload", string(msg.Payload))
+	msg.Ack()
+}
+
+func TestSQLSubscriber_HandlersJoinTransaction(t *testing.T) {
+	db, pub, sub := setupSQLPubSub(t)
+	require.NoError(t, pub.Publish(sqlTestTopic,
+		message.NewMessage("m1", nil),
+		message.NewMessage("m2", nil),
+	))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	msgs, err := sub.Subscribe(ctx, sqlTestTopic)
+	require.NoError(t, err)
+
+	// Transactions opened by handlers are savepoints of the consuming one
+	msg := receive(t, msgs)
+	require.Equal(t, "m1", msg.UUID)
+	require.NoError(t, common.DB(msg.Context(), db).Transaction(func(tx *gorm.DB) error {
+		return tx.Create(&sqlRecord{ID: 1}).Error
+	}))
+	msg.Ack()
+
+	msg = receive(t, msgs)
+	require.Equal(t, "m2", msg.UUID)
+	require.NoError(t, common.DB(msg.Context(), db).Create(&sqlRecord{ID: 2}).Error)
+	msg.Ack()
+
+	require.Eventually(t, func() bool {
+		var count int64
+		return db.Model(&sqlRecord{}).Count(&count).Error == nil && count == 2
+	}, 5*time.Second, 10*time.Millisecond, "handler writes commit with the offset")
+}
+
+func TestSQLSubscriber_NackRedeliversInTransaction(t *testing.T) {
+	db, pub, sub := setupSQLPubSub(t)
+	require.NoError(t, pub.Publish(sqlTestTopic, message.NewMessage("m1", nil)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	msgs, err := sub.Subscribe(ctx, sqlTestTopic)
+	require.NoError(t, err)
+
+	msg := receive(t, msgs)
+	err = common.DB(msg.Context(), db).Transaction(func(tx *gorm.DB) error {
+		require.NoError(t, tx.Create(&sqlRecord{ID: 1}).Error)
+		return errors.New("handler failed")
+	})
+	require.Error(t, err, "the failed try rolls back to its savepoint")
+	msg.Nack()
+
+	msg = receive(t, msgs)
+	assert.Equal(t, "m1", msg.UUID, "a nacked message is redelivered")
+	require.NoError(t, common.DB(msg.Context(), db).Create(&sqlRecord{ID: 2}).Error)
+	msg.Ack()
+
+	require.Eventually(t, func() bool {
+		var ids []uint64
+		return db.Model(&sqlRecord{}).Pluck("id", &ids).Error == nil && assert.ObjectsAreEqual([]uint64{2}, ids)
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestNewSQLAdapters(t *testing.T) {
+	tests := []struct {
+		dialect string
+		wantErr bool
+	}{
+		{dialect: "mysql"},
+		{dialect: "sqlite"},
+		{dialect: "postgres", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect, func(t *testing.T) {
+			schema, offsets, err := NewSQLAdapters(tt.dialect)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, schema)
+			assert.NotNil(t, offsets)
+		})
+	}
+}
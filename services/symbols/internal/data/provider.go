@@ -11,9 +11,34 @@ var ProviderSet = wire.NewSet(
 	NewDB,
 	NewData,
 	NewTransaction,
-	NewAMQPPublisher,
-	NewAMQPSubscriber,
+	NewGoChannel,
+	NewPublisher,
+	NewInboxStore,
+	NewIdempotencyStore,
+	NewRateLimitStore,
+	NewHealthChecks,
 	repo.NewSymbolRepo,
+	repo.NewAuditRepo,
+	repo.NewSymbolRevisionRepo,
+	repo.NewSymbolLockRepo,
+	NewProjectStatsRepo,
+	repo.NewMaintenanceRepo,
+	repo.NewSchedulerLocker,
 	NewEventPublisherWithMetrics,
 	NewEventSubscriberWithMetrics,
 )
+
+// ConsumerProviderSet is the broker consumer providers of the worker.
+var ConsumerProviderSet = wire.NewSet(
+	NewSubscriber,
+	NewDeadLetterPublisher,
+	NewRetryPublisher,
+)
+
+// EmbeddedConsumerProviderSet is the broker consumer providers of the worker handlers the API server
+// runs in-process with the gochannel broker. They return nil with an external broker.
+var EmbeddedConsumerProviderSet = wire.NewSet(
+	NewEmbeddedSubscriber,
+	NewEmbeddedDeadLetterPublisher,
+	NewEmbeddedRetryPublisher,
+)
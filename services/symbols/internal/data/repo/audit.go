@@ -0,0 +1,159 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"platform/pagination"
+	"symbols/internal/biz/domain"
+	"symbols/internal/data/common"
+	"symbols/internal/data/model"
+	"time"
+
+	"github.com/go-kratos-ecosystem/components/v2/gorm/scopes"
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// NewAuditRepo creates a new audit trail repository implementation.
+func NewAuditRepo(db *gorm.DB, logger log.Logger) domain.AuditRepo {
+	return &auditRepo{
+		db:  db,
+		log: log.NewHelper(logger),
+	}
+}
+
+type auditRepo struct {
+	db  *gorm.DB
+	log *log.Helper
+}
+
+func (r *auditRepo) Append(ctx context.Context, e *domain.AuditEvent) error {
+	entity, err := toEntityAuditEvent(e)
+	if err != nil {
+		return err
+	}
+
+	if err := common.DB(ctx, r.db).Create(entity).Error; err != nil {
+		r.log.WithContext(ctx).Errorf("failed to append audit event: %v", err)
+		return domain.ErrDataDatabase
+	}
+
+	e.ID = entity.ID
+	e.CreatedAt = entity.CreatedAt
+
+	return nil
+}
+
+func (r *auditRepo) List(ctx context.Context, opts domain.ListAuditEventsOptions) ([]*domain.AuditEvent, *pagination.Meta, error) {
+	var entities []*model.AuditEvent
+	var totalCount int64
+
+	f := opts.Filter
+	query := common.DB(ctx, r.db).Model(&model.AuditEvent{}).
+		Scopes(scopes.New().
+			WhereEq("project_id", f.ProjectID).
+			When(f.SymbolID != nil, func(db *gorm.DB) *gorm.DB {
+				return db.Where("symbol_id = ?", *f.SymbolID)
+			}).
+			When(f.Actor != nil, func(db *gorm.DB) *gorm.DB {
+				return db.Where("actor = ?", *f.Actor)
+			}).
+			When(f.Operation != nil, func(db *gorm.DB) *gorm.DB {
+				return db.Where("operation = ?", string(*f.Operation))
+			}).
+			When(f.Since != nil, func(db *gorm.DB) *gorm.DB {
+				return db.Where("created_at >= ?", *f.Since)
+			}).
+			When(f.Until != nil, func(db *gorm.DB) *gorm.DB {
+				return db.Where("created_at < ?", *f.Until)
+			}).
+			Scope())
+
+	if err := query.Count(&totalCount).Error; err != nil {
+		r.log.WithContext(ctx).Errorf("failed to count audit events: %v", err)
+		return nil, nil, domain.ErrDataDatabase
+	}
+
+	// Newest first; id breaks ties between events created in the same instant
+	query = query.Order("created_at DESC").Order("id DESC").
+		Limit(int(opts.Pagination.Limit)).Offset(int(opts.Pagination.Offset))
+
+	if err := query.Find(&entities).Error; err != nil {
+		r.log.WithContext(ctx).Errorf("failed to list audit events: %v", err)
+		return nil, nil, domain.ErrDataDatabase
+	}
+
+	events := make([]*domain.AuditEvent, 0, len(entities))
+	for _, entity := range entities {
+		e, err := toDomainAuditEvent(entity)
+		if err != nil {
+			return nil, nil, err
+		}
+		events = append(events, e)
+	}
+
+	meta := &pagination.Meta{
+		TotalCount:      uint64(totalCount),
+		Offset:          opts.Pagination.Offset,
+		Limit:           opts.Pagination.Limit,
+		HasNextPage:     opts.Pagination.Offset+uint64(len(entities)) < uint64(totalCount),
+		HasPreviousPage: opts.Pagination.Offset > 0,
+	}
+
+	return events, meta, nil
+}
+
+func (r *auditRepo) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := common.DB(ctx, r.db).Where("created_at < ?", before).Delete(&model.AuditEvent{})
+	if result.Error != nil {
+		r.log.WithContext(ctx).Errorf("failed to purge audit events: %v", result.Error)
+		return 0, domain.ErrDataDatabase
+	}
+
+	return result.RowsAffected, nil
+}
+
+// toEntityAuditEvent transforms a domain audit event into its persistence object.
+func toEntityAuditEvent(e *domain.AuditEvent) (*model.AuditEvent, error) {
+	changes := e.Changes
+	if changes == nil {
+		changes = []domain.FieldChange{}
+	}
+
+	raw, err := json.Marshal(changes)
+	if err != nil {
+		return nil, domain.ErrDataDatabase
+	}
+
+	return &model.AuditEvent{
+		ID:        e.ID,
+		ProjectID: e.ProjectID,
+		SymbolID:  e.SymbolID,
+		Operation: string(e.Operation),
+		Actor:     e.Actor,
+		RequestID: e.RequestID,
+		ClientIP:  e.ClientIP,
+		Changes:   string(raw),
+		CreatedAt: e.CreatedAt,
+	}, nil
+}
+
+// toDomainAuditEvent transforms a persisted audit event into its domain object.
+func toDomainAuditEvent(e *model.AuditEvent) (*domain.AuditEvent, error) {
+	var changes []domain.FieldChange
+	if err := json.Unmarshal([]byte(e.Changes), &changes); err != nil {
+		return nil, domain.ErrDataDatabase
+	}
+
+	return &domain.AuditEvent{
+		ID:        e.ID,
+		ProjectID: e.ProjectID,
+		SymbolID:  e.SymbolID,
+		Operation: domain.AuditOperation(e.Operation),
+		Actor:     e.Actor,
+		RequestID: e.RequestID,
+		ClientIP:  e.ClientIP,
+		Changes:   changes,
+		CreatedAt: e.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,153 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"os"
+	"platform/pagination"
+	"symbols/internal/biz/domain"
+	"symbols/internal/data/model"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// seedAuditEvents stores the given events with explicit creation times.
+func seedAuditEvents(t *testing.T, r domain.AuditRepo, events ...*domain.AuditEvent) {
+	for _, e := range events {
+		require.NoError(t, r.Append(context.Background(), e))
+	}
+}
+
+func TestAuditRepo_AppendAndList(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	r := NewAuditRepo(db, log.NewStdLogger(os.Stdout))
+
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	seedAuditEvents(t, r,
+		&domain.AuditEvent{ProjectID: 1, SymbolID: 10, Operation: domain.AuditOperationCreate, Actor: "alice", RequestID: "r1", ClientIP: "10.0.0.1",
+			Changes: []domain.FieldChange{{Field: "label", NewValue: "A"}}, CreatedAt: base},
+		&domain.AuditEvent{ProjectID: 1, SymbolID: 10, Operation: domain.AuditOperationUpdate, Actor: "bob", CreatedAt: base.Add(time.Hour)},
+		&domain.AuditEvent{ProjectID: 1, SymbolID: 11, Operation: domain.AuditOperationCreate, Actor: "alice", CreatedAt: base.Add(2 * time.Hour)},
+		&domain.AuditEvent{ProjectID: 2, SymbolID: 20, Operation: domain.AuditOperationDelete, Actor: "alice", CreatedAt: base.Add(3 * time.Hour)},
+	)
+
+	symbolID := uint64(10)
+	actor := "alice"
+	create := domain.AuditOperationCreate
+	since := base.Add(30 * time.Minute)
+	until := base.Add(90 * time.Minute)
+
+	tests := []struct {
+		name      string
+		filter    domain.AuditFilter
+		wantIDs   []uint64 // symbol ids in expected order
+		wantTotal uint64
+	}{
+		{name: "project only, newest first", filter: domain.AuditFilter{ProjectID: 1}, wantIDs: []uint64{11, 10, 10}, wantTotal: 3},
+		{name: "by symbol", filter: domain.AuditFilter{ProjectID: 1, SymbolID: &symbolID}, wantIDs: []uint64{10, 10}, wantTotal: 2},
+		{name: "by actor and operation", filter: domain.AuditFilter{ProjectID: 1, Actor: &actor, Operation: &create}, wantIDs: []uint64{11, 10}, wantTotal: 2},
+		{name: "by time range", filter: domain.AuditFilter{ProjectID: 1, Since: &since, Until: &until}, wantIDs: []uint64{10}, wantTotal: 1},
+		{name: "other project", filter: domain.AuditFilter{ProjectID: 3}, wantIDs: []uint64{}, wantTotal: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, meta, err := r.List(context.Background(), domain.ListAuditEventsOptions{
+				Filter:     tt.filter,
+				Pagination: pagination.OffsetPaginationParams{Offset: 0, Limit: 20},
+			})
+			require.NoError(t, err)
+
+			ids := make([]uint64, 0, len(events))
+			for _, e := range events {
+				ids = append(ids, e.SymbolID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, tt.wantTotal, meta.TotalCount)
+		})
+	}
+
+	t.Run("round trips all fields", func(t *testing.T) {
+		events, _, err := r.List(context.Background(), domain.ListAuditEventsOptions{
+			Filter:     domain.AuditFilter{ProjectID: 1, Actor: &actor, SymbolID: &symbolID},
+			Pagination: pagination.OffsetPaginationParams{Limit: 20},
+		})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		e := events[0]
+		assert.NotZero(t, e.ID)
+		assert.Equal(t, domain.AuditOperationCreate, e.Operation)
+		assert.Equal(t, "r1", e.RequestID)
+		assert.Equal(t, "10.0.0.1", e.ClientIP)
+		assert.Equal(t, []domain.FieldChange{{Field: "label", NewValue: "A"}}, e.Changes)
+		assert.True(t, base.Equal(e.CreatedAt))
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		events, meta, err := r.List(context.Background(), domain.ListAuditEventsOptions{
+			Filter:     domain.AuditFilter{ProjectID: 1},
+			Pagination: pagination.OffsetPaginationParams{Offset: 1, Limit: 1},
+		})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.True(t, meta.HasNextPage)
+		assert.True(t, meta.HasPreviousPage)
+	})
+}
+
+func TestAuditRepo_DeleteBefore(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	r := NewAuditRepo(db, log.NewStdLogger(os.Stdout))
+
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	seedAuditEvents(t, r,
+		&domain.AuditEvent{ProjectID: 1, SymbolID: 1, Operation: domain.AuditOperationCreate, Actor: "a", CreatedAt: base},
+		&domain.AuditEvent{ProjectID: 1, SymbolID: 1, Operation: domain.AuditOperationUpdate, Actor: "a", CreatedAt: base.Add(time.Hour)},
+		&domain.AuditEvent{ProjectID: 1, SymbolID: 1, Operation: domain.AuditOperationDelete, Actor: "a", CreatedAt: base.Add(2 * time.Hour)},
+	)
+
+	n, err := r.DeleteBefore(context.Background(), base.Add(90*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+
+	var remaining int64
+	db.Model(&model.AuditEvent{}).Count(&remaining)
+	assert.Equal(t, int64(1), remaining)
+}
+
+func TestAuditRepo_AppendRollsBackWithMutation(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	// Every connection of an in-memory database is a separate database
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+
+	tm := &gormTransaction{db: db}
+	symbols := NewSymbolRepo(db, tm, log.NewStdLogger(os.Stdout))
+	r := NewAuditRepo(db, log.NewStdLogger(os.Stdout))
+	errMutation := errors.New("mutation failed")
+
+	err = tm.InTx(context.Background(), func(ctx context.Context) error {
+		s, err := symbols.Create(ctx, validDomainSymbol())
+		if err != nil {
+			return err
+		}
+		if err := r.Append(ctx, &domain.AuditEvent{ProjectID: s.Project, SymbolID: s.ID, Operation: domain.AuditOperationCreate, Actor: "a"}); err != nil {
+			return err
+		}
+		return errMutation
+	})
+	require.ErrorIs(t, err, errMutation)
+
+	var count int64
+	require.NoError(t, db.Model(&model.AuditEvent{}).Count(&count).Error)
+	assert.Equal(t, int64(0), count, "the audit event rolls back with the mutation")
+}
@@ -0,0 +1,129 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"platform/idempotency"
+	"symbols/internal/data/model"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// maxReserveAttempts bounds the reservations retried when the conflicting key is released in between.
+const maxReserveAttempts = 3
+
+// NewIdempotencyStore creates the SQL idempotency store, sharing the keys across the API replicas.
+// Expired rows are ignored by Reserve and deleted by Purge, which the worker runs periodically.
+func NewIdempotencyStore(db *gorm.DB, logger log.Logger) *IdempotencyStore {
+	return &IdempotencyStore{
+		db:  db,
+		now: time.Now,
+		log: log.NewHelper(logger),
+	}
+}
+
+// IdempotencyStore is an idempotency.Store and idempotency.Purger on the idempotency_keys table.
+type IdempotencyStore struct {
+	db  *gorm.DB
+	now func() time.Time
+	log *log.Helper
+}
+
+var (
+	_ idempotency.Store  = (*IdempotencyStore)(nil)
+	_ idempotency.Purger = (*IdempotencyStore)(nil)
+)
+
+// Reserve claims key unless a non-expired row exists. When the row holding the key is released
+// before it can be read, the reservation is retried.
+func (s *IdempotencyStore) Reserve(ctx context.Context, key, fingerprint string, ttl time.Duration) (*idempotency.Record, bool, error) {
+	for range maxReserveAttempts {
+		existing, reserved, err := s.reserve(ctx, key, fingerprint, ttl)
+		if err != nil || reserved || existing != nil {
+			return existing, reserved, err
+		}
+	}
+
+	return nil, false, fmt.Errorf("idempotency key %s was released %d times while being reserved", key, maxReserveAttempts)
+}
+
+// reserve tries to claim key once. It returns neither a record nor a reservation when the row
+// holding the key was released between the insert and the lookup.
+func (s *IdempotencyStore) reserve(ctx context.Context, key, fingerprint string, ttl time.Duration) (*idempotency.Record, bool, error) {
+	db := s.db.WithContext(ctx)
+	now := s.now()
+
+	// An expired key no longer deduplicates
+	if err := db.Where("`key` = ? AND expires_at <= ?", key, now).Delete(&model.IdempotencyKey{}).Error; err != nil {
+		return nil, false, mapGormError(err)
+	}
+
+	// The primary key makes concurrent requests race safely
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.IdempotencyKey{
+		Key:         key,
+		Fingerprint: fingerprint,
+		ExpiresAt:   now.Add(ttl),
+	})
+	if result.Error != nil {
+		return nil, false, mapGormError(result.Error)
+	}
+	if result.RowsAffected > 0 {
+		return nil, true, nil
+	}
+
+	var entity model.IdempotencyKey
+	err := db.Where("`key` = ?", key).First(&entity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// Released in the meantime
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, mapGormError(err)
+	}
+
+	return &idempotency.Record{
+		Fingerprint: entity.Fingerprint,
+		Response:    entity.Response,
+		Completed:   entity.Completed,
+		ExpiresAt:   entity.ExpiresAt,
+	}, false, nil
+}
+
+// Complete stores the response of key and restarts its TTL.
+func (s *IdempotencyStore) Complete(ctx context.Context, key string, response []byte, ttl time.Duration) error {
+	err := s.db.WithContext(ctx).Model(&model.IdempotencyKey{}).
+		Where("`key` = ?", key).
+		Updates(map[string]interface{}{
+			"response":   response,
+			"completed":  true,
+			"expires_at": s.now().Add(ttl),
+		}).Error
+	if err != nil {
+		return mapGormError(err)
+	}
+
+	return nil
+}
+
+// Release removes key.
+func (s *IdempotencyStore) Release(ctx context.Context, key string) error {
+	if err := s.db.WithContext(ctx).Where("`key` = ?", key).Delete(&model.IdempotencyKey{}).Error; err != nil {
+		return mapGormError(err)
+	}
+
+	return nil
+}
+
+// Purge deletes the keys expired at now.
+func (s *IdempotencyStore) Purge(ctx context.Context, now time.Time) (int64, error) {
+	result := s.db.WithContext(ctx).Where("expires_at <= ?", now).Delete(&model.IdempotencyKey{})
+	if result.Error != nil {
+		return 0, mapGormError(result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,101 @@
+package repo
+
+import (
+	"context"
+	"os"
+	"symbols/internal/data/model"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func setupIdempotencyStore(t *testing.T) (*IdempotencyStore, *time.Time) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.IdempotencyKey{}))
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := NewIdempotencyStore(db, log.NewStdLogger(os.Stdout))
+	store.now = func() time.Time { return now }
+	return store, &now
+}
+
+func TestIdempotencyStore_ReserveCompleteReplay(t *testing.T) {
+	store, _ := setupIdempotencyStore(t)
+	ctx := context.Background()
+
+	existing, reserved, err := store.Reserve(ctx, "user|k", "fp", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+	assert.Nil(t, existing)
+
+	// A concurrent retry sees the in-flight reservation
+	existing, reserved, err = store.Reserve(ctx, "user|k", "fp", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, reserved)
+	assert.False(t, existing.Completed)
+
+	require.NoError(t, store.Complete(ctx, "user|k", []byte("response"), time.Hour))
+
+	existing, reserved, err = store.Reserve(ctx, "user|k", "other", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, reserved)
+	assert.True(t, existing.Completed)
+	assert.Equal(t, "fp", existing.Fingerprint)
+	assert.Equal(t, []byte("response"), existing.Response)
+
+	require.NoError(t, store.Release(ctx, "user|k"))
+	_, reserved, err = store.Reserve(ctx, "user|k", "fp", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, reserved, "a released key is reserved again")
+}
+
+func TestIdempotencyStore_Expiry(t *testing.T) {
+	store, now := setupIdempotencyStore(t)
+	ctx := context.Background()
+
+	_, _, err := store.Reserve(ctx, "short", "fp", time.Minute)
+	require.NoError(t, err)
+	_, _, err = store.Reserve(ctx, "long", "fp", time.Hour)
+	require.NoError(t, err)
+
+	*now = now.Add(time.Minute)
+	_, reserved, err := store.Reserve(ctx, "short", "fp", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, reserved, "expired keys no longer deduplicate")
+
+	purged, err := store.Purge(ctx, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged, "only expired keys are purged")
+
+	_, reserved, err = store.Reserve(ctx, "long", "fp", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, reserved)
+}
+
+func TestIdempotencyStore_ReserveRetriesReleasedKey(t *testing.T) {
+	store, _ := setupIdempotencyStore(t)
+	ctx := context.Background()
+
+	_, reserved, err := store.Reserve(ctx, "user|k", "fp", time.Minute)
+	require.NoError(t, err)
+	require.True(t, reserved)
+
+	// The holder releases the key after the conflicting insert, before the lookup reads it
+	released := false
+	require.NoError(t, store.db.Callback().Query().Before("gorm:query").Register("test:release", func(tx *gorm.DB) {
+		if tx.Statement.Table == "idempotency_keys" && !released {
+			released = true
+			require.NoError(t, store.Release(ctx, "user|k"))
+		}
+	}))
+
+	existing, reserved, err := store.Reserve(ctx, "user|k", "fp", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, released)
+	assert.True(t, reserved, "the released key is reserved by the retry")
+	assert.Nil(t, existing)
+}
@@ -0,0 +1,98 @@
+package repo
+
+import (
+	"context"
+	"platform/inbox"
+	"symbols/internal/data/common"
+	"symbols/internal/data/model"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// inboxPurgeInterval is how often expired inbox rows are purged.
+const inboxPurgeInterval = time.Hour
+
+// NewInboxStore creates the SQL inbox store. Handlers run in the transaction recording their
+// message, so repositories reading the transaction from the context commit with the record.
+func NewInboxStore(db *gorm.DB, tx common.Transaction, logger log.Logger) inbox.Store {
+	return &inboxStore{
+		db:  db,
+		tx:  tx,
+		now: time.Now,
+		log: log.NewHelper(logger),
+	}
+}
+
+type inboxStore struct {
+	db  *gorm.DB
+	tx  common.Transaction
+	now func() time.Time
+	log *log.Helper
+
+	mu         sync.Mutex
+	lastPurged time.Time
+}
+
+func (s *inboxStore) Process(ctx context.Context, consumer, messageID string, ttl time.Duration, fn func(ctx context.Context) error) (bool, error) {
+	s.purge(ctx)
+
+	duplicate := false
+	err := s.tx.InTx(ctx, func(ctx context.Context) error {
+		db := common.DB(ctx, s.db)
+		now := s.now()
+
+		// An expired record no longer deduplicates
+		err := db.Where("consumer = ? AND message_id = ? AND expires_at <= ?", consumer, messageID, now).
+			Delete(&model.InboxMessage{}).Error
+		if err != nil {
+			return mapGormError(err)
+		}
+
+		// The primary key serializes concurrent deliveries: the second waits for the first to commit
+		result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.InboxMessage{
+			Consumer:    consumer,
+			MessageID:   messageID,
+			ProcessedAt: now,
+			ExpiresAt:   now.Add(ttl),
+		})
+		if result.Error != nil {
+			return mapGormError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			duplicate = true
+			return nil
+		}
+
+		return fn(ctx)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return duplicate, nil
+}
+
+// purge deletes the expired rows at most once per inboxPurgeInterval, in the transaction ctx carries if any.
+func (s *inboxStore) purge(ctx context.Context) {
+	s.mu.Lock()
+	now := s.now()
+	if now.Sub(s.lastPurged) < inboxPurgeInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.lastPurged = now
+	s.mu.Unlock()
+
+	result := common.DB(ctx, s.db).Where("expires_at <= ?", now).Delete(&model.InboxMessage{})
+	if result.Error != nil {
+		s.log.WithContext(ctx).Errorf("failed to purge expired inbox messages: %v", result.Error)
+		return
+	}
+	if result.RowsAffected > 0 {
+		s.log.WithContext(ctx).Infof("Purged %d expired inbox messages", result.RowsAffected)
+	}
+}
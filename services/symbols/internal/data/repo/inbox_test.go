@@ -0,0 +1,122 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"os"
+	"symbols/internal/data/common"
+	"symbols/internal/data/model"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// gormTransaction runs fn in a database transaction carried by the context, like data.Data.
+type gormTransaction struct {
+	db *gorm.DB
+}
+
+func (t *gormTransaction) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return common.DB(ctx, t.db).Transaction(func(tx *gorm.DB) error {
+		return fn(common.WithTx(ctx, tx))
+	})
+}
+
+func setupInboxStore(t *testing.T) (*inboxStore, *gorm.DB) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.InboxMessage{}))
+	// Every connection of an in-memory database is a separate database
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+
+	store := NewInboxStore(db, &gormTransaction{db: db}, log.NewStdLogger(os.Stdout)).(*inboxStore)
+	return store, db
+}
+
+func TestInboxStore_Process(t *testing.T) {
+	store, db := setupInboxStore(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	calls := 0
+	fn := func(ctx context.Context) error {
+		calls++
+		return common.DB(ctx, db).Create(&model.ProjectSymbolStats{ProjectID: uint64(calls), ComputedAt: now}).Error
+	}
+
+	duplicate, err := store.Process(ctx, "consumer", "m1", time.Hour, fn)
+	require.NoError(t, err)
+	assert.False(t, duplicate)
+
+	duplicate, err = store.Process(ctx, "consumer", "m1", time.Hour, fn)
+	require.NoError(t, err)
+	assert.True(t, duplicate, "a processed message is a duplicate")
+	assert.Equal(t, 1, calls)
+
+	duplicate, err = store.Process(ctx, "other", "m1", time.Hour, fn)
+	require.NoError(t, err)
+	assert.False(t, duplicate, "records are scoped per consumer")
+
+	now = now.Add(time.Hour)
+	duplicate, err = store.Process(ctx, "consumer", "m1", time.Hour, fn)
+	require.NoError(t, err)
+	assert.False(t, duplicate, "expired records no longer deduplicate")
+	assert.Equal(t, 3, calls)
+
+	var stats int64
+	require.NoError(t, db.Model(&model.ProjectSymbolStats{}).Count(&stats).Error)
+	assert.Equal(t, int64(3), stats)
+}
+
+func TestInboxStore_FailureRollsBack(t *testing.T) {
+	store, db := setupInboxStore(t)
+	ctx := context.Background()
+	errHandler := errors.New("handler failed")
+
+	duplicate, err := store.Process(ctx, "consumer", "m1", time.Hour, func(ctx context.Context) error {
+		require.NoError(t, common.DB(ctx, db).Create(&model.ProjectSymbolStats{ProjectID: 1, ComputedAt: time.Now()}).Error)
+		return errHandler
+	})
+	assert.ErrorIs(t, err, errHandler)
+	assert.False(t, duplicate)
+
+	var stats, records int64
+	require.NoError(t, db.Model(&model.ProjectSymbolStats{}).Count(&stats).Error)
+	require.NoError(t, db.Model(&model.InboxMessage{}).Count(&records).Error)
+	assert.Zero(t, stats, "handler writes roll back with the record")
+	assert.Zero(t, records)
+
+	calls := 0
+	duplicate, err = store.Process(ctx, "consumer", "m1", time.Hour, func(context.Context) error {
+		calls++
+		return nil
+	})
+	require.NoError(t, err)
+	assert.False(t, duplicate, "a failed message is processed again")
+	assert.Equal(t, 1, calls)
+}
+
+func TestInboxStore_Purge(t *testing.T) {
+	store, db := setupInboxStore(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+	noop := func(context.Context) error { return nil }
+
+	_, err := store.Process(ctx, "consumer", "m1", time.Minute, noop)
+	require.NoError(t, err)
+
+	now = now.Add(inboxPurgeInterval)
+	_, err = store.Process(ctx, "consumer", "m2", time.Minute, noop)
+	require.NoError(t, err)
+
+	var ids []string
+	require.NoError(t, db.Model(&model.InboxMessage{}).Pluck("message_id", &ids).Error)
+	assert.Equal(t, []string{"m2"}, ids)
+}
@@ -0,0 +1,84 @@
+package repo
+
+import (
+	"context"
+	"platform/scheduler"
+	"symbols/internal/data/model"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// NewSchedulerLocker creates the scheduler locker on the scheduler_leases table, so the worker
+// replicas sharing the database run each scheduled job once.
+func NewSchedulerLocker(db *gorm.DB, logger log.Logger) scheduler.Locker {
+	return &schedulerLocker{
+		db:  db,
+		now: time.Now,
+		log: log.NewHelper(logger),
+	}
+}
+
+type schedulerLocker struct {
+	db  *gorm.DB
+	now func() time.Time
+	log *log.Helper
+}
+
+func (l *schedulerLocker) Claim(ctx context.Context, job, holder string, scheduledAt time.Time, ttl time.Duration) (bool, error) {
+	db := l.db.WithContext(ctx)
+	now := l.now()
+
+	// The first run of a job creates its lease; the primary key makes concurrent replicas race safely
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.SchedulerLease{
+		Name:        job,
+		Holder:      holder,
+		ScheduledAt: scheduledAt,
+		LockedUntil: now.Add(ttl),
+	})
+	if result.Error != nil {
+		return false, mapGormError(result.Error)
+	}
+	if result.RowsAffected > 0 {
+		return true, nil
+	}
+
+	// Later runs take the lease over once the previous run released it or its holder stopped renewing it
+	result = db.Model(&model.SchedulerLease{}).
+		Where("name = ? AND scheduled_at < ? AND locked_until <= ?", job, scheduledAt, now).
+		Updates(map[string]interface{}{
+			"holder":       holder,
+			"scheduled_at": scheduledAt,
+			"locked_until": now.Add(ttl),
+		})
+	if result.Error != nil {
+		return false, mapGormError(result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (l *schedulerLocker) Renew(ctx context.Context, job, holder string, ttl time.Duration) error {
+	err := l.db.WithContext(ctx).Model(&model.SchedulerLease{}).
+		Where("name = ? AND holder = ?", job, holder).
+		Update("locked_until", l.now().Add(ttl)).Error
+	if err != nil {
+		return mapGormError(err)
+	}
+
+	return nil
+}
+
+func (l *schedulerLocker) Release(ctx context.Context, job, holder string) error {
+	// The lease row is kept: its scheduled_at prevents other replicas from claiming the same run again
+	err := l.db.WithContext(ctx).Model(&model.SchedulerLease{}).
+		Where("name = ? AND holder = ?", job, holder).
+		Update("locked_until", l.now()).Error
+	if err != nil {
+		return mapGormError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package repo
+
+import (
+	"context"
+	"os"
+	"symbols/internal/data/model"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedulerLocker(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.SchedulerLease{}))
+	locker := NewSchedulerLocker(db, log.NewStdLogger(os.Stdout)).(*schedulerLocker)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	locker.now = func() time.Time { return now }
+	ctx := context.Background()
+	run1 := now
+	run2 := now.Add(time.Minute)
+
+	claimed, err := locker.Claim(ctx, "job", "a", run1, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	claimed, err = locker.Claim(ctx, "job", "b", run1, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, claimed, "a run is claimed once")
+
+	claimed, err = locker.Claim(ctx, "job", "b", run2, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, claimed, "the job is locked while its previous run goes on")
+
+	claimed, err = locker.Claim(ctx, "other", "b", run1, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed, "jobs are locked separately")
+
+	require.NoError(t, locker.Release(ctx, "job", "a"))
+	claimed, err = locker.Claim(ctx, "job", "b", run1, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, claimed, "a released run is not claimed again")
+
+	claimed, err = locker.Claim(ctx, "job", "b", run2, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	// b renews its lock, so the next run waits for it
+	now = now.Add(50 * time.Second)
+	require.NoError(t, locker.Renew(ctx, "job", "b", time.Minute))
+	now = now.Add(50 * time.Second)
+	claimed, err = locker.Claim(ctx, "job", "a", now, time.Minute)
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	// The lock of a crashed holder expires
+	now = now.Add(time.Minute)
+	claimed, err = locker.Claim(ctx, "job", "a", now, time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+}
@@ -0,0 +1,122 @@
+package repo
+
+import (
+	"context"
+	"symbols/internal/biz/domain"
+	"symbols/internal/data/common"
+	"symbols/internal/data/model"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// NewSymbolLockRepo creates a new symbol lease repository implementation.
+func NewSymbolLockRepo(db *gorm.DB, logger log.Logger) domain.SymbolLockRepo {
+	return &lockRepo{
+		db:  db,
+		log: log.NewHelper(logger),
+	}
+}
+
+type lockRepo struct {
+	db  *gorm.DB
+	log *log.Helper
+}
+
+func (r *lockRepo) Acquire(ctx context.Context, l *domain.SymbolLock, now time.Time) (*domain.SymbolLock, bool, error) {
+	db := common.DB(ctx, r.db)
+
+	// Take over an expired lease or refresh our own
+	result := db.Model(&model.SymbolLock{}).
+		Where("symbol_id = ? AND (expires_at <= ? OR (holder = ? AND session_id = ?))", l.SymbolID, now, l.Holder, l.SessionID).
+		Updates(map[string]interface{}{
+			"project_id":  l.ProjectID,
+			"holder":      l.Holder,
+			"session_id":  l.SessionID,
+			"acquired_at": l.AcquiredAt,
+			"expires_at":  l.ExpiresAt,
+		})
+	if result.Error != nil {
+		return nil, false, mapGormError(result.Error)
+	}
+	if result.RowsAffected > 0 {
+		return l, true, nil
+	}
+
+	// No lease row yet; the primary key makes concurrent acquirers race safely
+	err := db.Create(toEntitySymbolLock(l)).Error
+	if err == nil {
+		return l, true, nil
+	}
+	if !isDuplicateKeyError(err) {
+		return nil, false, mapGormError(err)
+	}
+
+	current, err := r.Find(ctx, l.SymbolID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return current, false, nil
+}
+
+func (r *lockRepo) Renew(ctx context.Context, l *domain.SymbolLock, now time.Time) error {
+	result := common.DB(ctx, r.db).Model(&model.SymbolLock{}).
+		Where("symbol_id = ? AND holder = ? AND session_id = ? AND expires_at > ?", l.SymbolID, l.Holder, l.SessionID, now).
+		Update("expires_at", l.ExpiresAt)
+	if result.Error != nil {
+		return mapGormError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+func (r *lockRepo) Find(ctx context.Context, symbolID uint64) (*domain.SymbolLock, error) {
+	var entity model.SymbolLock
+
+	if err := common.DB(ctx, r.db).Where("symbol_id = ?", symbolID).First(&entity).Error; err != nil {
+		return nil, mapGormError(err)
+	}
+
+	return toDomainSymbolLock(&entity), nil
+}
+
+func (r *lockRepo) Delete(ctx context.Context, l *domain.SymbolLock) error {
+	result := common.DB(ctx, r.db).
+		Where("symbol_id = ? AND holder = ? AND session_id = ?", l.SymbolID, l.Holder, l.SessionID).
+		Delete(&model.SymbolLock{})
+	if result.Error != nil {
+		return mapGormError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrDataNotFound
+	}
+
+	return nil
+}
+
+func toEntitySymbolLock(l *domain.SymbolLock) *model.SymbolLock {
+	return &model.SymbolLock{
+		SymbolID:   l.SymbolID,
+		ProjectID:  l.ProjectID,
+		Holder:     l.Holder,
+		SessionID:  l.SessionID,
+		AcquiredAt: l.AcquiredAt,
+		ExpiresAt:  l.ExpiresAt,
+	}
+}
+
+func toDomainSymbolLock(l *model.SymbolLock) *domain.SymbolLock {
+	return &domain.SymbolLock{
+		SymbolID:   l.SymbolID,
+		ProjectID:  l.ProjectID,
+		Holder:     l.Holder,
+		SessionID:  l.SessionID,
+		AcquiredAt: l.AcquiredAt,
+		ExpiresAt:  l.ExpiresAt,
+	}
+}
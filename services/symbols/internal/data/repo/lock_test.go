@@ -0,0 +1,89 @@
+package repo
+
+import (
+	"context"
+	"os"
+	"symbols/internal/biz/domain"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLock(holder, session string, now time.Time, ttl time.Duration) *domain.SymbolLock {
+	return &domain.SymbolLock{
+		SymbolID:   1,
+		ProjectID:  1,
+		Holder:     holder,
+		SessionID:  session,
+		AcquiredAt: now,
+		ExpiresAt:  now.Add(ttl),
+	}
+}
+
+func TestLockRepo_Acquire(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	r := NewSymbolLockRepo(db, log.NewStdLogger(os.Stdout))
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// First acquirer creates the lease
+	_, ok, err := r.Acquire(ctx, newLock("user-1", "sess-1", now, time.Minute), now)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// Another session is refused while the lease is live and sees the holder
+	current, ok, err := r.Acquire(ctx, newLock("user-2", "sess-2", now, time.Minute), now.Add(30*time.Second))
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, "user-1", current.Holder)
+
+	// The holder can refresh its own lease
+	_, ok, err = r.Acquire(ctx, newLock("user-1", "sess-1", now, 5*time.Minute), now.Add(30*time.Second))
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// Once expired, another session takes it over
+	later := now.Add(10 * time.Minute)
+	_, ok, err = r.Acquire(ctx, newLock("user-2", "sess-2", later, time.Minute), later)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	found, err := r.Find(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "user-2", found.Holder)
+	assert.Equal(t, "sess-2", found.SessionID)
+	assert.True(t, found.ExpiresAt.Equal(later.Add(time.Minute)))
+}
+
+func TestLockRepo_RenewAndDelete(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	r := NewSymbolLockRepo(db, log.NewStdLogger(os.Stdout))
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	_, _, err := r.Acquire(ctx, newLock("user-1", "sess-1", now, time.Minute), now)
+	require.NoError(t, err)
+
+	// Renewing requires the holder's live lease
+	assert.ErrorIs(t, r.Renew(ctx, newLock("user-2", "sess-2", now, time.Hour), now), domain.ErrDataNotFound)
+	assert.ErrorIs(t, r.Renew(ctx, newLock("user-1", "sess-1", now, time.Hour), now.Add(2*time.Minute)), domain.ErrDataNotFound)
+	require.NoError(t, r.Renew(ctx, newLock("user-1", "sess-1", now, time.Hour), now))
+
+	found, err := r.Find(ctx, 1)
+	require.NoError(t, err)
+	assert.True(t, found.ExpiresAt.Equal(now.Add(time.Hour)))
+
+	// Deleting requires the lease to still be held by the same session
+	assert.ErrorIs(t, r.Delete(ctx, newLock("user-2", "sess-2", now, time.Minute)), domain.ErrDataNotFound)
+	assert.ErrorIs(t, r.Delete(ctx, newLock("user-1", "sess-2", now, time.Minute)), domain.ErrDataNotFound)
+	require.NoError(t, r.Delete(ctx, newLock("user-1", "sess-1", now, time.Minute)))
+
+	_, err = r.Find(ctx, 1)
+	assert.ErrorIs(t, err, domain.ErrDataNotFound)
+	assert.ErrorIs(t, r.Delete(ctx, newLock("user-1", "sess-1", now, time.Minute)), domain.ErrDataNotFound)
+}
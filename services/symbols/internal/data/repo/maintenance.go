@@ -0,0 +1,102 @@
+package repo
+
+import (
+	"context"
+	"symbols/internal/biz/domain"
+	"symbols/internal/data/common"
+	"symbols/internal/data/model"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// NewMaintenanceRepo creates the repository of the maintenance jobs.
+func NewMaintenanceRepo(db *gorm.DB, logger log.Logger) domain.MaintenanceRepo {
+	return &maintenanceRepo{
+		db:  db,
+		log: log.NewHelper(logger),
+	}
+}
+
+type maintenanceRepo struct {
+	db  *gorm.DB
+	log *log.Helper
+}
+
+func (r *maintenanceRepo) PurgeDeletedSymbols(ctx context.Context, before time.Time, limit int) (int64, error) {
+	var purged int64
+
+	err := common.DB(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var ids []uint64
+		err := tx.Unscoped().Model(&model.Symbol{}).
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+			Order("id ASC").
+			Limit(limit).
+			Pluck("id", &ids).Error
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+
+		// Revisions and audit events outlive the Symbol, as its history
+		for _, owned := range []interface{}{&model.SymbolData{}, &model.SymbolReference{}, &model.SymbolTag{}, &model.SymbolLock{}} {
+			if err := tx.Unscoped().Where("symbol_id IN ?", ids).Delete(owned).Error; err != nil {
+				return err
+			}
+		}
+
+		result := tx.Unscoped().Where("id IN ?", ids).Delete(&model.Symbol{})
+		purged = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("failed to purge deleted symbols: %v", err)
+		return 0, mapGormError(err)
+	}
+
+	return purged, nil
+}
+
+func (r *maintenanceRepo) DeleteOrphanSymbolData(ctx context.Context, limit int) (int64, error) {
+	db := common.DB(ctx, r.db)
+
+	// Soft-deleted Symbols keep their data until they are purged
+	var ids []uint64
+	err := db.Unscoped().Model(&model.SymbolData{}).
+		Joins("LEFT JOIN symbols ON symbols.id = symbol_data.symbol_id").
+		Where("symbols.id IS NULL").
+		Order("symbol_data.id ASC").
+		Limit(limit).
+		Pluck("symbol_data.id", &ids).Error
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("failed to find orphan symbol data: %v", err)
+		return 0, mapGormError(err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	result := db.Unscoped().Where("id IN ?", ids).Delete(&model.SymbolData{})
+	if result.Error != nil {
+		r.log.WithContext(ctx).Errorf("failed to delete orphan symbol data: %v", result.Error)
+		return 0, mapGormError(result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (r *maintenanceRepo) ListStaleStatsProjects(ctx context.Context, before time.Time, afterProjectID uint64, limit int) ([]uint64, error) {
+	var projects []uint64
+
+	err := common.DB(ctx, r.db).Model(&model.ProjectSymbolStats{}).
+		Where("computed_at < ? AND project_id > ?", before, afterProjectID).
+		Order("project_id ASC").
+		Limit(limit).
+		Pluck("project_id", &projects).Error
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("failed to list stale project stats: %v", err)
+		return nil, mapGormError(err)
+	}
+
+	return projects, nil
+}
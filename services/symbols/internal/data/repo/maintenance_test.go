@@ -0,0 +1,103 @@
+package repo
+
+import (
+	"context"
+	"os"
+	"symbols/internal/data/model"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceRepo_PurgeDeletedSymbols(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	symbols := NewSymbolRepo(db, &mockTransaction{}, log.NewStdLogger(os.Stdout))
+	r := NewMaintenanceRepo(db, log.NewStdLogger(os.Stdout))
+	ctx := context.Background()
+	now := time.Now()
+
+	live := createStatsSymbol(t, db, symbols, 1, refUID1, "Section", now)
+	old := createStatsSymbol(t, db, symbols, 1, refUID2, "Section", now)
+	recent := createStatsSymbol(t, db, symbols, 1, tagUID3, "Section", now)
+	require.NoError(t, symbols.Delete(ctx, old.ID))
+	require.NoError(t, symbols.Delete(ctx, recent.ID))
+	require.NoError(t, db.Unscoped().Model(&model.Symbol{}).Where("id = ?", old.ID).UpdateColumn("deleted_at", now.Add(-48*time.Hour)).Error)
+	require.NoError(t, db.Create(&model.SymbolTag{ProjectID: 1, SymbolID: old.ID, Key: "k", Value: "v"}).Error)
+	require.NoError(t, db.Create(&model.SymbolLock{SymbolID: old.ID, ProjectID: 1, Holder: "h", SessionID: "s", AcquiredAt: now, ExpiresAt: now}).Error)
+	require.NoError(t, db.Create(&model.SymbolRevision{SymbolID: old.ID, ProjectID: 1, Revision: 1}).Error)
+
+	purged, err := r.PurgeDeletedSymbols(ctx, now.Add(-24*time.Hour), 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+
+	var ids []uint64
+	require.NoError(t, db.Unscoped().Model(&model.Symbol{}).Order("id").Pluck("id", &ids).Error)
+	assert.Equal(t, []uint64{live.ID, recent.ID}, ids, "live and recently deleted symbols are kept")
+
+	for _, owned := range []interface{}{&model.SymbolData{}, &model.SymbolTag{}, &model.SymbolLock{}} {
+		var count int64
+		require.NoError(t, db.Unscoped().Model(owned).Where("symbol_id = ?", old.ID).Count(&count).Error)
+		assert.Zero(t, count, "%T of the purged symbol is deleted", owned)
+	}
+
+	var revisions int64
+	require.NoError(t, db.Model(&model.SymbolRevision{}).Where("symbol_id = ?", old.ID).Count(&revisions).Error)
+	assert.Equal(t, int64(1), revisions, "revisions are kept")
+
+	purged, err = r.PurgeDeletedSymbols(ctx, now.Add(-24*time.Hour), 10)
+	require.NoError(t, err)
+	assert.Zero(t, purged)
+}
+
+func TestMaintenanceRepo_DeleteOrphanSymbolData(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	symbols := NewSymbolRepo(db, &mockTransaction{}, log.NewStdLogger(os.Stdout))
+	r := NewMaintenanceRepo(db, log.NewStdLogger(os.Stdout))
+	ctx := context.Background()
+	now := time.Now()
+
+	live := createStatsSymbol(t, db, symbols, 1, refUID1, "Section", now)
+	deleted := createStatsSymbol(t, db, symbols, 1, refUID2, "Section", now)
+	require.NoError(t, symbols.Delete(ctx, deleted.ID))
+	data := []byte("{}")
+	for _, symbolID := range []uint64{1000, 1001, 1002} {
+		require.NoError(t, db.Create(&model.SymbolData{SymbolID: symbolID, Data: &data}).Error)
+	}
+
+	collected, err := r.DeleteOrphanSymbolData(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), collected, "at most limit rows are deleted")
+
+	collected, err = r.DeleteOrphanSymbolData(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), collected)
+
+	var owners []uint64
+	require.NoError(t, db.Unscoped().Model(&model.SymbolData{}).Order("symbol_id").Pluck("symbol_id", &owners).Error)
+	assert.Equal(t, []uint64{live.ID, deleted.ID}, owners, "soft-deleted symbols keep their data")
+}
+
+func TestMaintenanceRepo_ListStaleStatsProjects(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	r := NewMaintenanceRepo(db, log.NewStdLogger(os.Stdout))
+	ctx := context.Background()
+	now := time.Now()
+
+	for projectID, age := range map[uint64]time.Duration{1: 2 * time.Hour, 2: time.Minute, 3: 3 * time.Hour, 4: 5 * time.Hour} {
+		require.NoError(t, db.Create(&model.ProjectSymbolStats{ProjectID: projectID, ComponentTargets: map[string]uint64{}, ComputedAt: now.Add(-age)}).Error)
+	}
+
+	projects, err := r.ListStaleStatsProjects(ctx, now.Add(-time.Hour), 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 3}, projects)
+
+	projects, err = r.ListStaleStatsProjects(ctx, now.Add(-time.Hour), 3, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{4}, projects)
+}
@@ -0,0 +1,117 @@
+package repo
+
+import (
+	"context"
+	"platform/ratelimit"
+	"symbols/internal/data/model"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// NewRateLimitStore creates the SQL rate limit store, sharing the token buckets across the API replicas.
+// Buckets idle for longer than idleTTL are deleted lazily, at most once per idleTTL and replica.
+func NewRateLimitStore(db *gorm.DB, idleTTL time.Duration, logger log.Logger) *RateLimitStore {
+	if idleTTL <= 0 {
+		idleTTL = 10 * time.Minute
+	}
+	return &RateLimitStore{
+		db:      db,
+		idleTTL: idleTTL,
+		now:     time.Now,
+		log:     log.NewHelper(logger),
+	}
+}
+
+// RateLimitStore is a ratelimit.Store on the rate_limit_buckets table.
+type RateLimitStore struct {
+	db      *gorm.DB
+	idleTTL time.Duration
+	now     func() time.Time
+	log     *log.Helper
+
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+var _ ratelimit.Store = (*RateLimitStore)(nil)
+
+// Allow takes a token from the bucket identified by key. The bucket row is locked while it is
+// refilled, so concurrent requests on any replica take distinct tokens.
+func (s *RateLimitStore) Allow(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Result, error) {
+	now := s.now()
+	s.sweep(ctx, now)
+
+	var res ratelimit.Result
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The primary key makes concurrent first requests race safely
+		err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.RateLimitBucket{
+			Key:       key,
+			Tokens:    float64(limit.Burst),
+			UpdatedAt: now,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		var b model.RateLimitBucket
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("`key` = ?", key).First(&b).Error; err != nil {
+			return err
+		}
+
+		// Replica clocks may disagree; a bucket updated "later" is not refilled
+		elapsed := max(now.Sub(b.UpdatedAt), 0)
+		tokens, r := ratelimit.Take(b.Tokens, elapsed, limit)
+		res = r
+
+		return tx.Model(&model.RateLimitBucket{}).
+			Where("`key` = ?", key).
+			Updates(map[string]interface{}{
+				"tokens":     tokens,
+				"updated_at": maxTime(now, b.UpdatedAt),
+			}).Error
+	})
+	if err != nil {
+		return ratelimit.Result{}, mapGormError(err)
+	}
+
+	return res, nil
+}
+
+// Refund gives back a token to the bucket identified by key, up to its burst.
+func (s *RateLimitStore) Refund(ctx context.Context, key string, limit ratelimit.Limit) error {
+	err := s.db.WithContext(ctx).Model(&model.RateLimitBucket{}).
+		Where("`key` = ?", key).
+		Update("tokens", gorm.Expr("CASE WHEN tokens + 1 > ? THEN ? ELSE tokens + 1 END", limit.Burst, limit.Burst)).Error
+	if err != nil {
+		return mapGormError(err)
+	}
+
+	return nil
+}
+
+// sweep deletes the buckets idle for longer than the idle TTL, at most once per idle TTL.
+func (s *RateLimitStore) sweep(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	if now.Sub(s.lastSweep) < s.idleTTL {
+		s.mu.Unlock()
+		return
+	}
+	s.lastSweep = now
+	s.mu.Unlock()
+
+	err := s.db.WithContext(ctx).Where("updated_at < ?", now.Add(-s.idleTTL)).Delete(&model.RateLimitBucket{}).Error
+	if err != nil {
+		s.log.WithContext(ctx).Warnf("Failed to delete idle rate limit buckets: %v", err)
+	}
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
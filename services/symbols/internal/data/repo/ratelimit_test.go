@@ -0,0 +1,91 @@
+package repo
+
+import (
+	"context"
+	"os"
+	"platform/ratelimit"
+	"symbols/internal/data/model"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRateLimitStore(t *testing.T) (*RateLimitStore, *time.Time) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&model.RateLimitBucket{}))
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := NewRateLimitStore(db, time.Minute, log.NewStdLogger(os.Stdout))
+	store.now = func() time.Time { return now }
+	return store, &now
+}
+
+func TestRateLimitStore_Allow(t *testing.T) {
+	store, now := setupRateLimitStore(t)
+	ctx := context.Background()
+	limit := ratelimit.Limit{Rate: 1, Burst: 2}
+
+	for i := range 2 {
+		res, err := store.Allow(ctx, "ip|203.0.113.5", limit)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, 1-i, res.Remaining)
+	}
+
+	res, err := store.Allow(ctx, "ip|203.0.113.5", limit)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, time.Second, res.RetryAfter)
+
+	// Other keys have their own bucket
+	res, err = store.Allow(ctx, "ip|198.51.100.7", limit)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	// The bucket refills with time
+	*now = now.Add(time.Second)
+	res, err = store.Allow(ctx, "ip|203.0.113.5", limit)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+}
+
+func TestRateLimitStore_Refund(t *testing.T) {
+	store, _ := setupRateLimitStore(t)
+	ctx := context.Background()
+	limit := ratelimit.Limit{Rate: 1, Burst: 1}
+
+	res, err := store.Allow(ctx, "ip|203.0.113.5", limit)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	require.NoError(t, store.Refund(ctx, "ip|203.0.113.5", limit))
+	require.NoError(t, store.Refund(ctx, "ip|203.0.113.5", limit))
+
+	res, err = store.Allow(ctx, "ip|203.0.113.5", limit)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed, "the refunded token can be taken again")
+
+	res, err = store.Allow(ctx, "ip|203.0.113.5", limit)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed, "refunds never exceed the burst")
+}
+
+func TestRateLimitStore_SweepsIdleBuckets(t *testing.T) {
+	store, now := setupRateLimitStore(t)
+	ctx := context.Background()
+	limit := ratelimit.Limit{Rate: 1, Burst: 1}
+
+	_, err := store.Allow(ctx, "idle", limit)
+	require.NoError(t, err)
+
+	*now = now.Add(2 * time.Minute)
+	_, err = store.Allow(ctx, "active", limit)
+	require.NoError(t, err)
+
+	var keys []string
+	require.NoError(t, store.db.Model(&model.RateLimitBucket{}).Pluck("key", &keys).Error)
+	assert.Equal(t, []string{"active"}, keys)
+}
@@ -0,0 +1,123 @@
+package repo
+
+import (
+	"context"
+	"os"
+	"symbols/internal/biz/domain"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	refUID1 = "6ba7b810-9dad-41d1-80b4-00c04fd430c8"
+	refUID2 = "6ba7b811-9dad-41d1-80b4-00c04fd430c8"
+)
+
+// createSymbolWithRefs stores a symbol of project 1 with the given UID and references.
+func createSymbolWithRefs(t *testing.T, r domain.SymbolRepo, uid string, refs ...string) *domain.Symbol {
+	s := validDomainSymbol()
+	s.UID = uid
+	s.References = refs
+
+	created, err := r.Create(context.Background(), s)
+	require.NoError(t, err)
+
+	return created
+}
+
+func TestSymbolReferences_CreateAndFind(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	r := NewSymbolRepo(db, &mockTransaction{}, log.NewStdLogger(os.Stdout))
+	ctx := context.Background()
+
+	createSymbolWithRefs(t, r, refUID1)
+	createSymbolWithRefs(t, r, refUID2)
+	s := createSymbolWithRefs(t, r, validDomainSymbol().UID, refUID1, refUID2)
+
+	found, err := r.FindByID(ctx, s.ID)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{refUID1, refUID2}, found.References)
+
+	byUID, err := r.FindByUIDs(ctx, 1, []string{refUID1, s.UID})
+	require.NoError(t, err)
+	require.Len(t, byUID, 2)
+	assert.Equal(t, refUID1, byUID[0].UID)
+	assert.Empty(t, byUID[0].References)
+	assert.ElementsMatch(t, []string{refUID1, refUID2}, byUID[1].References)
+
+	otherProject, err := r.FindByUIDs(ctx, 2, []string{refUID1})
+	require.NoError(t, err)
+	assert.Empty(t, otherProject)
+}
+
+func TestSymbolReferences_Update(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	r := NewSymbolRepo(db, &mockTransaction{}, log.NewStdLogger(os.Stdout))
+	ctx := context.Background()
+
+	createSymbolWithRefs(t, r, refUID1)
+	createSymbolWithRefs(t, r, refUID2)
+	s := createSymbolWithRefs(t, r, validDomainSymbol().UID, refUID1)
+
+	s.References = []string{refUID2}
+	_, err := r.Update(ctx, s)
+	require.NoError(t, err)
+
+	found, err := r.FindByID(ctx, s.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{refUID2}, found.References)
+
+	s.References = nil
+	_, err = r.Update(ctx, s)
+	require.NoError(t, err)
+
+	found, err = r.FindByID(ctx, s.ID)
+	require.NoError(t, err)
+	assert.Empty(t, found.References)
+}
+
+func TestSymbolReferences_ListDependents(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	r := NewSymbolRepo(db, &mockTransaction{}, log.NewStdLogger(os.Stdout))
+	ctx := context.Background()
+
+	createSymbolWithRefs(t, r, refUID1)
+	live := createSymbolWithRefs(t, r, refUID2, refUID1)
+	deleted := createSymbolWithRefs(t, r, validDomainSymbol().UID, refUID1)
+	require.NoError(t, r.Delete(ctx, deleted.ID))
+
+	dependents, err := r.ListDependents(ctx, 1, refUID1)
+	require.NoError(t, err)
+	require.Len(t, dependents, 1, "soft-deleted symbols are not dependents")
+	assert.Equal(t, live.ID, dependents[0].ID)
+	assert.Equal(t, []string{refUID1}, dependents[0].References)
+
+	none, err := r.ListDependents(ctx, 1, refUID2)
+	require.NoError(t, err)
+	assert.Empty(t, none)
+}
+
+func TestSymbolReferences_DeleteReferencesTo(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	r := NewSymbolRepo(db, &mockTransaction{}, log.NewStdLogger(os.Stdout))
+	ctx := context.Background()
+
+	createSymbolWithRefs(t, r, refUID1)
+	createSymbolWithRefs(t, r, refUID2)
+	s := createSymbolWithRefs(t, r, validDomainSymbol().UID, refUID1, refUID2)
+
+	n, err := r.DeleteReferencesTo(ctx, 1, refUID1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	found, err := r.FindByID(ctx, s.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{refUID2}, found.References)
+}
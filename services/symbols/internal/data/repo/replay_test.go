@@ -0,0 +1,75 @@
+package repo
+
+import (
+	"context"
+	"os"
+	"symbols/internal/biz/domain"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListForReplay(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	r := NewSymbolRepo(db, &mockTransaction{}, log.NewStdLogger(os.Stdout))
+	ctx := context.Background()
+	now := time.Now()
+
+	s1 := createStatsSymbol(t, db, r, 1, refUID1, "Section", now.Add(-48*time.Hour))
+	s2 := createStatsSymbol(t, db, r, 1, refUID2, "Section", now.Add(-time.Hour))
+	s3 := createStatsSymbol(t, db, r, 2, refUID1, "Section", now.Add(-time.Hour))
+	s4 := createStatsSymbol(t, db, r, 1, tagUID3, "Section", now)
+	deleted := createStatsSymbol(t, db, r, 1, validDomainSymbol().UID, "Section", now)
+	require.NoError(t, r.Delete(ctx, deleted.ID))
+
+	tests := []struct {
+		name    string
+		filter  domain.ReplayFilter
+		afterID uint64
+		limit   int
+		wantIDs []uint64
+	}{
+		{
+			name:    "project",
+			filter:  domain.ReplayFilter{ProjectID: 1},
+			limit:   10,
+			wantIDs: []uint64{s1.ID, s2.ID, s4.ID},
+		},
+		{
+			name:    "id range",
+			filter:  domain.ReplayFilter{MinID: s2.ID, MaxID: s3.ID},
+			limit:   10,
+			wantIDs: []uint64{s2.ID, s3.ID},
+		},
+		{
+			name:    "updated window",
+			filter:  domain.ReplayFilter{UpdatedAfter: now.Add(-2 * time.Hour), UpdatedBefore: now.Add(-time.Minute)},
+			limit:   10,
+			wantIDs: []uint64{s2.ID, s3.ID},
+		},
+		{
+			name:    "keyset page",
+			filter:  domain.ReplayFilter{ProjectID: 1},
+			afterID: s1.ID,
+			limit:   1,
+			wantIDs: []uint64{s2.ID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			symbols, err := r.ListForReplay(ctx, tt.filter, tt.afterID, tt.limit)
+			require.NoError(t, err)
+
+			ids := make([]uint64, 0, len(symbols))
+			for _, s := range symbols {
+				ids = append(ids, s.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package repo
+
+import (
+	"context"
+	"symbols/internal/biz/domain"
+	"symbols/internal/data/common"
+	"symbols/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// NewSymbolRevisionRepo creates a new symbol revision repository implementation.
+func NewSymbolRevisionRepo(db *gorm.DB, logger log.Logger) domain.SymbolRevisionRepo {
+	return &revisionRepo{
+		db:  db,
+		log: log.NewHelper(logger),
+	}
+}
+
+type revisionRepo struct {
+	db  *gorm.DB
+	log *log.Helper
+}
+
+// Append numbers the snapshot after the latest revision of the symbol, in the transaction ctx
+// carries. The symbol row is locked first, so concurrent writers of the same symbol number their
+// revisions one after the other.
+func (r *revisionRepo) Append(ctx context.Context, s *domain.Symbol) (uint32, error) {
+	db := common.DB(ctx, r.db)
+
+	var locked []uint64
+	err := db.Unscoped().Model(&model.Symbol{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", s.ID).
+		Pluck("id", &locked).Error
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("failed to lock symbol: %v", err)
+		return 0, mapGormError(err)
+	}
+
+	var latest uint32
+	err = db.Model(&model.SymbolRevision{}).
+		Where("symbol_id = ?", s.ID).
+		Select("COALESCE(MAX(revision), 0)").
+		Scan(&latest).Error
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("failed to find latest symbol revision: %v", err)
+		return 0, mapGormError(err)
+	}
+
+	entity := toEntitySymbolRevision(s, latest+1)
+	if err := db.Create(entity).Error; err != nil {
+		r.log.WithContext(ctx).Errorf("failed to store symbol revision: %v", err)
+		return 0, mapGormError(err)
+	}
+
+	return entity.Revision, nil
+}
+
+func (r *revisionRepo) Find(ctx context.Context, symbolID uint64, revision uint32) (*domain.SymbolRevision, error) {
+	var entity model.SymbolRevision
+
+	err := common.DB(ctx, r.db).
+		Where("symbol_id = ? AND revision = ?", symbolID, revision).
+		First(&entity).Error
+	if err != nil {
+		return nil, mapGormError(err)
+	}
+
+	return toDomainSymbolRevision(&entity), nil
+}
+
+func (r *revisionRepo) List(ctx context.Context, symbolID uint64) ([]*domain.SymbolRevision, error) {
+	var entities []*model.SymbolRevision
+
+	err := common.DB(ctx, r.db).
+		Omit("data").
+		Where("symbol_id = ?", symbolID).
+		Order("revision ASC").
+		Find(&entities).Error
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("failed to list symbol revisions: %v", err)
+		return nil, mapGormError(err)
+	}
+
+	revisions := make([]*domain.SymbolRevision, 0, len(entities))
+	for _, e := range entities {
+		revisions = append(revisions, toDomainSymbolRevision(e))
+	}
+
+	return revisions, nil
+}
+
+func toEntitySymbolRevision(s *domain.Symbol, revision uint32) *model.SymbolRevision {
+	var data []byte
+	if s.Data != nil && s.Data.Data != nil {
+		data = *s.Data.Data
+	}
+
+	return &model.SymbolRevision{
+		SymbolID:        s.ID,
+		Revision:        revision,
+		ProjectID:       s.Project,
+		UID:             s.UID,
+		Label:           s.Label,
+		ClassName:       s.ClassName,
+		ComponentTarget: s.ComponentTarget,
+		Version:         s.Version,
+		Data:            data,
+		References:      s.References,
+		Tags:            s.Tags,
+	}
+}
+
+func toDomainSymbolRevision(e *model.SymbolRevision) *domain.SymbolRevision {
+	symbol := &domain.Symbol{
+		ID:              e.SymbolID,
+		Project:         e.ProjectID,
+		UID:             e.UID,
+		Label:           e.Label,
+		ClassName:       e.ClassName,
+		ComponentTarget: e.ComponentTarget,
+		Version:         e.Version,
+		References:      e.References,
+		Tags:            e.Tags,
+	}
+	if e.Data != nil {
+		data := e.Data
+		symbol.Data = &domain.SymbolData{Project: e.ProjectID, Data: &data}
+	}
+
+	return &domain.SymbolRevision{
+		Revision:  e.Revision,
+		Symbol:    symbol,
+		CreatedAt: e.CreatedAt,
+	}
+}
@@ -0,0 +1,102 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"os"
+	"symbols/internal/biz/domain"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSymbolRevisions(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	r := NewSymbolRevisionRepo(db, log.NewStdLogger(os.Stdout))
+	ctx := context.Background()
+
+	s := validDomainSymbol()
+	s.ID = 1
+	s.References = []string{refUID1}
+	s.Tags = map[string]string{"theme": "dark"}
+
+	rev, err := r.Append(ctx, s)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), rev)
+
+	updated := validDomainSymbol()
+	updated.ID = 1
+	updated.Version = 2
+	data := []byte(`{"key": "other"}`)
+	updated.Data = &domain.SymbolData{Project: 1, Data: &data}
+
+	rev, err = r.Append(ctx, updated)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), rev)
+
+	other := validDomainSymbol()
+	other.ID = 2
+	rev, err = r.Append(ctx, other)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), rev, "revisions are numbered per symbol")
+
+	first, err := r.Find(ctx, 1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), first.Revision)
+	assert.Equal(t, uint64(1), first.Symbol.ID)
+	assert.Equal(t, s.UID, first.Symbol.UID)
+	assert.Equal(t, []string{refUID1}, first.Symbol.References)
+	assert.Equal(t, map[string]string{"theme": "dark"}, first.Symbol.Tags)
+	assert.Equal(t, *s.Data.Data, *first.Symbol.Data.Data)
+	assert.False(t, first.CreatedAt.IsZero())
+
+	second, err := r.Find(ctx, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), second.Symbol.Version)
+	assert.Equal(t, data, *second.Symbol.Data.Data)
+	assert.Empty(t, second.Symbol.References)
+
+	_, err = r.Find(ctx, 1, 3)
+	assert.ErrorIs(t, err, domain.ErrDataNotFound)
+
+	list, err := r.List(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, uint32(1), list[0].Revision)
+	assert.Equal(t, uint32(2), list[1].Revision)
+	assert.Nil(t, list[0].Symbol.Data, "listed revisions carry no data")
+}
+
+func TestSymbolRevisions_AppendJoinsTransaction(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	// Every connection of an in-memory database is a separate database
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+
+	tm := &gormTransaction{db: db}
+	symbols := NewSymbolRepo(db, tm, log.NewStdLogger(os.Stdout))
+	r := NewSymbolRevisionRepo(db, log.NewStdLogger(os.Stdout))
+	errUpdate := errors.New("update failed")
+
+	created, err := symbols.Create(context.Background(), validDomainSymbol())
+	require.NoError(t, err)
+	_, err = r.Append(context.Background(), created)
+	require.NoError(t, err)
+
+	err = tm.InTx(context.Background(), func(ctx context.Context) error {
+		rev, err := r.Append(ctx, created)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(2), rev)
+		return errUpdate
+	})
+	require.ErrorIs(t, err, errUpdate)
+
+	list, err := r.List(context.Background(), created.ID)
+	require.NoError(t, err)
+	assert.Len(t, list, 1, "the revision rolls back with the update")
+}
@@ -0,0 +1,166 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"symbols/internal/biz/domain"
+	"symbols/internal/data/common"
+	"symbols/internal/data/model"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// StatsOptions controls the materialized project statistics.
+type StatsOptions struct {
+	// Materialized serves statistics from the project_symbol_stats table.
+	Materialized bool
+	// MaxStaleness is the age after which a materialized row is ignored and the statistics
+	// are computed on request. Zero accepts rows of any age.
+	MaxStaleness time.Duration
+}
+
+// NewProjectStatsRepo creates a new project statistics repository implementation.
+func NewProjectStatsRepo(db *gorm.DB, opts StatsOptions, logger log.Logger) domain.ProjectStatsRepo {
+	return &statsRepo{
+		db:   db,
+		opts: opts,
+		log:  log.NewHelper(logger),
+	}
+}
+
+type statsRepo struct {
+	db   *gorm.DB
+	opts StatsOptions
+	log  *log.Helper
+}
+
+// Get serves the materialized row when it is enabled and fresh enough.
+// Lifecycle events are published before the writing transaction commits, so the worker may
+// miss the change that triggered a refresh; MaxStaleness bounds how long such a row is served.
+func (r *statsRepo) Get(ctx context.Context, projectID uint64, now time.Time) (*domain.ProjectSymbolStats, error) {
+	if r.opts.Materialized {
+		var entity model.ProjectSymbolStats
+
+		err := r.db.WithContext(ctx).Where("project_id = ?", projectID).First(&entity).Error
+		switch {
+		case err == nil:
+			if r.opts.MaxStaleness <= 0 || now.Sub(entity.ComputedAt) <= r.opts.MaxStaleness {
+				stats := toDomainProjectSymbolStats(&entity)
+				stats.Materialized = true
+				return stats, nil
+			}
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			r.log.WithContext(ctx).Errorf("failed to read materialized stats: %v", err)
+			return nil, mapGormError(err)
+		}
+	}
+
+	return r.compute(ctx, projectID, now)
+}
+
+func (r *statsRepo) Refresh(ctx context.Context, projectID uint64, now time.Time) error {
+	if !r.opts.Materialized {
+		return nil
+	}
+
+	stats, err := r.compute(ctx, projectID, now)
+	if err != nil {
+		return err
+	}
+
+	err = common.DB(ctx, r.db).
+		Clauses(clause.OnConflict{UpdateAll: true}).
+		Create(toEntityProjectSymbolStats(stats)).Error
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("failed to store materialized stats: %v", err)
+		return mapGormError(err)
+	}
+
+	return nil
+}
+
+// compute aggregates the live symbols of a project.
+func (r *statsRepo) compute(ctx context.Context, projectID uint64, now time.Time) (*domain.ProjectSymbolStats, error) {
+	db := common.DB(ctx, r.db)
+
+	var targets []struct {
+		ComponentTarget string
+		Count           uint64
+	}
+	err := db.Model(&model.Symbol{}).
+		Where("symbols.project_id = ?", projectID).
+		Select("symbols.component_target AS component_target, COUNT(*) AS count").
+		Group("symbols.component_target").
+		Scan(&targets).Error
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("failed to count symbols by component target: %v", err)
+		return nil, mapGormError(err)
+	}
+
+	var totals struct {
+		TotalDataBytes  uint64
+		ChangedLastDay  uint64
+		ChangedLastWeek uint64
+	}
+	err = db.Model(&model.Symbol{}).
+		Joins("LEFT JOIN symbol_data ON symbol_data.symbol_id = symbols.id AND symbol_data.deleted_at IS NULL").
+		Where("symbols.project_id = ?", projectID).
+		Select(
+			"COALESCE(SUM(LENGTH(symbol_data.data)), 0) AS total_data_bytes, "+
+				"COALESCE(SUM(CASE WHEN symbols.updated_at >= ? THEN 1 ELSE 0 END), 0) AS changed_last_day, "+
+				"COALESCE(SUM(CASE WHEN symbols.updated_at >= ? THEN 1 ELSE 0 END), 0) AS changed_last_week",
+			now.Add(-24*time.Hour), now.Add(-7*24*time.Hour),
+		).
+		Scan(&totals).Error
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("failed to aggregate symbol data: %v", err)
+		return nil, mapGormError(err)
+	}
+
+	stats := &domain.ProjectSymbolStats{
+		ProjectID:        projectID,
+		ComponentTargets: make(map[string]uint64, len(targets)),
+		TotalDataBytes:   totals.TotalDataBytes,
+		ChangedLastDay:   totals.ChangedLastDay,
+		ChangedLastWeek:  totals.ChangedLastWeek,
+		ComputedAt:       now,
+	}
+	for _, t := range targets {
+		stats.ComponentTargets[t.ComponentTarget] = t.Count
+		stats.TotalSymbols += t.Count
+	}
+
+	return stats, nil
+}
+
+func toEntityProjectSymbolStats(s *domain.ProjectSymbolStats) *model.ProjectSymbolStats {
+	return &model.ProjectSymbolStats{
+		ProjectID:        s.ProjectID,
+		TotalSymbols:     s.TotalSymbols,
+		ComponentTargets: s.ComponentTargets,
+		TotalDataBytes:   s.TotalDataBytes,
+		ChangedLastDay:   s.ChangedLastDay,
+		ChangedLastWeek:  s.ChangedLastWeek,
+		ComputedAt:       s.ComputedAt,
+	}
+}
+
+func toDomainProjectSymbolStats(s *model.ProjectSymbolStats) *domain.ProjectSymbolStats {
+	targets := s.ComponentTargets
+	if targets == nil {
+		targets = map[string]uint64{}
+	}
+
+	return &domain.ProjectSymbolStats{
+		ProjectID:        s.ProjectID,
+		TotalSymbols:     s.TotalSymbols,
+		ComponentTargets: targets,
+		TotalDataBytes:   s.TotalDataBytes,
+		ChangedLastDay:   s.ChangedLastDay,
+		ChangedLastWeek:  s.ChangedLastWeek,
+		ComputedAt:       s.ComputedAt,
+	}
+}
@@ -0,0 +1,118 @@
+package repo
+
+import (
+	"context"
+	"os"
+	"symbols/internal/biz/domain"
+	"symbols/internal/data/model"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// createStatsSymbol stores a symbol with the given component target, last changed at updatedAt.
+func createStatsSymbol(t *testing.T, db *gorm.DB, r domain.SymbolRepo, project uint64, uid, target string, updatedAt time.Time) *domain.Symbol {
+	s := validDomainSymbol()
+	s.Project = project
+	s.Data.Project = project
+	s.UID = uid
+	s.ComponentTarget = target
+
+	created, err := r.Create(context.Background(), s)
+	require.NoError(t, err)
+	require.NoError(t, db.Model(&model.Symbol{}).Where("id = ?", created.ID).UpdateColumn("updated_at", updatedAt).Error)
+
+	return created
+}
+
+func TestProjectStats_Compute(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	symbols := NewSymbolRepo(db, &mockTransaction{}, log.NewStdLogger(os.Stdout))
+	r := NewProjectStatsRepo(db, StatsOptions{}, log.NewStdLogger(os.Stdout))
+	ctx := context.Background()
+	now := time.Now()
+
+	createStatsSymbol(t, db, symbols, 1, refUID1, "Section", now.Add(-time.Hour))
+	createStatsSymbol(t, db, symbols, 1, refUID2, "Button", now.Add(-3*24*time.Hour))
+	createStatsSymbol(t, db, symbols, 1, validDomainSymbol().UID, "Section", now.Add(-30*24*time.Hour))
+	deleted := createStatsSymbol(t, db, symbols, 1, tagUID3, "Section", now)
+	require.NoError(t, symbols.Delete(ctx, deleted.ID))
+	createStatsSymbol(t, db, symbols, 2, refUID1, "Section", now)
+
+	stats, err := r.Get(ctx, 1, now)
+	require.NoError(t, err)
+
+	dataSize := uint64(len(*validDomainSymbol().Data.Data))
+	assert.Equal(t, uint64(1), stats.ProjectID)
+	assert.Equal(t, uint64(3), stats.TotalSymbols, "soft-deleted and foreign symbols are not counted")
+	assert.Equal(t, map[string]uint64{"Section": 2, "Button": 1}, stats.ComponentTargets)
+	assert.Equal(t, 3*dataSize, stats.TotalDataBytes)
+	assert.Equal(t, uint64(1), stats.ChangedLastDay)
+	assert.Equal(t, uint64(2), stats.ChangedLastWeek)
+	assert.Equal(t, now, stats.ComputedAt)
+	assert.False(t, stats.Materialized)
+
+	empty, err := r.Get(ctx, 3, now)
+	require.NoError(t, err)
+	assert.Zero(t, empty.TotalSymbols)
+	assert.Empty(t, empty.ComponentTargets)
+}
+
+func TestProjectStats_Materialized(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	symbols := NewSymbolRepo(db, &mockTransaction{}, log.NewStdLogger(os.Stdout))
+	r := NewProjectStatsRepo(db, StatsOptions{Materialized: true, MaxStaleness: time.Minute}, log.NewStdLogger(os.Stdout))
+	ctx := context.Background()
+	now := time.Now()
+
+	createStatsSymbol(t, db, symbols, 1, refUID1, "Section", now)
+
+	// Without a materialized row the stats are computed
+	stats, err := r.Get(ctx, 1, now)
+	require.NoError(t, err)
+	assert.False(t, stats.Materialized)
+
+	require.NoError(t, r.Refresh(ctx, 1, now))
+	createStatsSymbol(t, db, symbols, 1, refUID2, "Button", now)
+
+	stats, err = r.Get(ctx, 1, now.Add(30*time.Second))
+	require.NoError(t, err)
+	assert.True(t, stats.Materialized)
+	assert.Equal(t, uint64(1), stats.TotalSymbols, "served from the materialized row")
+	assert.Equal(t, map[string]uint64{"Section": 1}, stats.ComponentTargets)
+
+	// A stale row is ignored
+	stats, err = r.Get(ctx, 1, now.Add(2*time.Minute))
+	require.NoError(t, err)
+	assert.False(t, stats.Materialized)
+	assert.Equal(t, uint64(2), stats.TotalSymbols)
+
+	// Refresh overwrites the row
+	require.NoError(t, r.Refresh(ctx, 1, now.Add(2*time.Minute)))
+	stats, err = r.Get(ctx, 1, now.Add(2*time.Minute))
+	require.NoError(t, err)
+	assert.True(t, stats.Materialized)
+	assert.Equal(t, uint64(2), stats.TotalSymbols)
+
+	var rows int64
+	require.NoError(t, db.Model(&model.ProjectSymbolStats{}).Count(&rows).Error)
+	assert.Equal(t, int64(1), rows)
+}
+
+func TestProjectStats_RefreshDisabled(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	r := NewProjectStatsRepo(db, StatsOptions{}, log.NewStdLogger(os.Stdout))
+
+	require.NoError(t, r.Refresh(context.Background(), 1, time.Now()))
+
+	var rows int64
+	require.NoError(t, db.Model(&model.ProjectSymbolStats{}).Count(&rows).Error)
+	assert.Zero(t, rows)
+}
@@ -5,7 +5,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"platform/pagination"
+	"slices"
 	"strings"
 	"symbols/internal/biz/domain"
 	"symbols/internal/data/common"
@@ -14,6 +16,7 @@ import (
 	"github.com/go-kratos-ecosystem/components/v2/gorm/scopes"
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // NewSymbolRepo creates a new symbol repository implementation.
@@ -36,7 +39,7 @@ func (r *symbolRepo) Create(ctx context.Context, s *domain.Symbol) (*domain.Symb
 	symbol := toEntitySymbol(s)
 
 	// Create symbol
-	if err := r.db.WithContext(ctx).Session(&gorm.Session{FullSaveAssociations: true}).Create(symbol).Error; err != nil {
+	if err := common.DB(ctx, r.db).Session(&gorm.Session{FullSaveAssociations: true}).Create(symbol).Error; err != nil {
 		return nil, r.mapGormError(err)
 	}
 
@@ -48,8 +51,8 @@ func (r *symbolRepo) Update(ctx context.Context, symbol *domain.Symbol) (*domain
 	// Transform to entity
 	entity := toEntitySymbol(symbol)
 
-	// Update symbol
-	result := r.db.WithContext(ctx).Session(&gorm.Session{FullSaveAssociations: true}).Model(&model.Symbol{}).Where("id = ?", symbol.ID).Updates(entity)
+	// Update symbol; references and tags are replaced below rather than merged
+	result := common.DB(ctx, r.db).Session(&gorm.Session{FullSaveAssociations: true}).Model(&model.Symbol{}).Where("id = ?", symbol.ID).Omit("References", "Tags").Updates(entity)
 	if result.Error != nil {
 		return nil, r.mapGormError(result.Error)
 	}
@@ -57,15 +60,33 @@ func (r *symbolRepo) Update(ctx context.Context, symbol *domain.Symbol) (*domain
 		return nil, domain.ErrDataNotFound
 	}
 
+	if err := r.replaceReferences(ctx, symbol.ID, entity.References); err != nil {
+		return nil, err
+	}
+
+	if err := r.replaceTags(ctx, symbol.ID, entity.Tags); err != nil {
+		return nil, err
+	}
+
 	// Fetch updated symbol with data
 	return r.FindByID(ctx, symbol.ID)
 }
 
 func (r *symbolRepo) FindByID(ctx context.Context, id uint64) (*domain.Symbol, error) {
+	return r.findByID(common.DB(ctx, r.db), id)
+}
+
+func (r *symbolRepo) FindByIDForUpdate(ctx context.Context, id uint64) (*domain.Symbol, error) {
+	return r.findByID(common.DB(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}), id)
+}
+
+func (r *symbolRepo) findByID(db *gorm.DB, id uint64) (*domain.Symbol, error) {
 	var symbol *model.Symbol
 
-	err := r.db.WithContext(ctx).
+	err := db.
 		Preload("SymbolData").
+		Preload("References").
+		Preload("Tags").
 		Where("id = ?", id).
 		First(&symbol).Error
 
@@ -81,7 +102,7 @@ func (r *symbolRepo) ListSymbols(ctx context.Context, opts domain.ListSymbolsOpt
 	var totalCount int64
 
 	// Build query with go-kratos scopes for type-safe filtering
-	query := r.db.WithContext(ctx).Model(&model.Symbol{}).
+	query := common.DB(ctx, r.db).Model(&model.Symbol{}).
 		Scopes(scopes.New().
 			WhereEq("project_id", opts.Filter.ProjectID).
 			When(opts.Filter.Label != nil, func(db *gorm.DB) *gorm.DB {
@@ -90,7 +111,8 @@ func (r *symbolRepo) ListSymbols(ctx context.Context, opts domain.ListSymbolsOpt
 			When(opts.Filter.ComponentTarget != nil, func(db *gorm.DB) *gorm.DB {
 				return db.Where("component_target = ?", *opts.Filter.ComponentTarget)
 			}).
-			Scope())
+			Scope()).
+		Scopes(r.tagFilterScope(opts.Filter))
 
 	// Count total records WITH filters applied
 	if err := query.Count(&totalCount).Error; err != nil {
@@ -103,7 +125,7 @@ func (r *symbolRepo) ListSymbols(ctx context.Context, opts domain.ListSymbolsOpt
 	// Apply pagination to the query
 	query = query.Limit(int(opts.Pagination.Limit)).Offset(int(opts.Pagination.Offset))
 
-	if err := query.Find(&symbolEntities).Error; err != nil {
+	if err := query.Preload("Tags").Find(&symbolEntities).Error; err != nil {
 		return nil, nil, r.mapGormError(err)
 	}
 
@@ -125,6 +147,38 @@ func (r *symbolRepo) ListSymbols(ctx context.Context, opts domain.ListSymbolsOpt
 	return symbols, meta, nil
 }
 
+func (r *symbolRepo) ListForReplay(ctx context.Context, filter domain.ReplayFilter, afterID uint64, limit int) ([]*domain.Symbol, error) {
+	var entities []*model.Symbol
+
+	err := common.DB(ctx, r.db).
+		Scopes(scopes.New().
+			When(filter.ProjectID != 0, func(db *gorm.DB) *gorm.DB {
+				return db.Where("project_id = ?", filter.ProjectID)
+			}).
+			When(filter.MinID != 0, func(db *gorm.DB) *gorm.DB {
+				return db.Where("id >= ?", filter.MinID)
+			}).
+			When(filter.MaxID != 0, func(db *gorm.DB) *gorm.DB {
+				return db.Where("id <= ?", filter.MaxID)
+			}).
+			When(!filter.UpdatedAfter.IsZero(), func(db *gorm.DB) *gorm.DB {
+				return db.Where("updated_at >= ?", filter.UpdatedAfter)
+			}).
+			When(!filter.UpdatedBefore.IsZero(), func(db *gorm.DB) *gorm.DB {
+				return db.Where("updated_at < ?", filter.UpdatedBefore)
+			}).
+			Scope()).
+		Where("id > ?", afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&entities).Error
+	if err != nil {
+		return nil, r.mapGormError(err)
+	}
+
+	return toDomainSymbols(entities), nil
+}
+
 // symbolSortScope returns a GORM scope for applying sorting to symbol queries.
 func (r *symbolRepo) symbolSortScope(sort domain.SortOption) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
@@ -141,10 +195,8 @@ func (r *symbolRepo) symbolSortScope(sort domain.SortOption) func(db *gorm.DB) *
 }
 
 func (r *symbolRepo) Delete(ctx context.Context, id uint64) error {
-	// Begin transaction
-
 	// Delete symbol
-	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&model.Symbol{})
+	result := common.DB(ctx, r.db).Where("id = ?", id).Delete(&model.Symbol{})
 	if err := result.Error; err != nil {
 		return r.mapGormError(err)
 	}
@@ -156,10 +208,172 @@ func (r *symbolRepo) Delete(ctx context.Context, id uint64) error {
 	return nil
 }
 
+func (r *symbolRepo) FindByUIDs(ctx context.Context, projectID uint64, uids []string) ([]*domain.Symbol, error) {
+	return r.findByUIDs(common.DB(ctx, r.db), projectID, uids)
+}
+
+func (r *symbolRepo) FindByUIDsForShare(ctx context.Context, projectID uint64, uids []string) ([]*domain.Symbol, error) {
+	return r.findByUIDs(common.DB(ctx, r.db).Clauses(clause.Locking{Strength: "SHARE"}), projectID, uids)
+}
+
+func (r *symbolRepo) findByUIDs(db *gorm.DB, projectID uint64, uids []string) ([]*domain.Symbol, error) {
+	var entities []*model.Symbol
+
+	err := db.
+		Preload("References").
+		Preload("Tags").
+		Where("project_id = ? AND uid IN ?", projectID, uids).
+		Order("id ASC").
+		Find(&entities).Error
+	if err != nil {
+		return nil, r.mapGormError(err)
+	}
+
+	return toDomainSymbols(entities), nil
+}
+
+func (r *symbolRepo) ListDependents(ctx context.Context, projectID uint64, uid string) ([]*domain.Symbol, error) {
+	var entities []*model.Symbol
+
+	err := common.DB(ctx, r.db).
+		Preload("References").
+		Preload("Tags").
+		Where("id IN (?)", r.db.Model(&model.SymbolReference{}).
+			Select("symbol_id").
+			Where("project_id = ? AND ref_uid = ?", projectID, uid)).
+		Order("id ASC").
+		Find(&entities).Error
+	if err != nil {
+		return nil, r.mapGormError(err)
+	}
+
+	return toDomainSymbols(entities), nil
+}
+
+func (r *symbolRepo) DeleteReferencesTo(ctx context.Context, projectID uint64, uid string) (int64, error) {
+	result := common.DB(ctx, r.db).
+		Where("project_id = ? AND ref_uid = ?", projectID, uid).
+		Delete(&model.SymbolReference{})
+	if result.Error != nil {
+		return 0, r.mapGormError(result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (r *symbolRepo) TagKeyFacets(ctx context.Context, projectID uint64) ([]*domain.TagFacet, error) {
+	var facets []*domain.TagFacet
+
+	err := r.tagFacetQuery(ctx, projectID).
+		Select("symbol_tags.tag_key AS value, COUNT(*) AS count").
+		Group("symbol_tags.tag_key").
+		Order("symbol_tags.tag_key ASC").
+		Scan(&facets).Error
+	if err != nil {
+		return nil, r.mapGormError(err)
+	}
+
+	return facets, nil
+}
+
+func (r *symbolRepo) TagValueFacets(ctx context.Context, projectID uint64, key string) ([]*domain.TagFacet, error) {
+	var facets []*domain.TagFacet
+
+	err := r.tagFacetQuery(ctx, projectID).
+		Where("symbol_tags.tag_key = ?", key).
+		Select("symbol_tags.tag_value AS value, COUNT(*) AS count").
+		Group("symbol_tags.tag_value").
+		Order("symbol_tags.tag_value ASC").
+		Scan(&facets).Error
+	if err != nil {
+		return nil, r.mapGormError(err)
+	}
+
+	return facets, nil
+}
+
 // Internal helper functions
 
+// tagFacetQuery selects the tags of the live (not soft-deleted) symbols of a project.
+// A symbol carries a key at most once, so counting rows counts symbols.
+func (r *symbolRepo) tagFacetQuery(ctx context.Context, projectID uint64) *gorm.DB {
+	return common.DB(ctx, r.db).
+		Table("symbol_tags").
+		Joins("JOIN symbols ON symbols.id = symbol_tags.symbol_id AND symbols.deleted_at IS NULL").
+		Where("symbol_tags.project_id = ?", projectID)
+}
+
+// tagFilterScope returns a GORM scope restricting symbols to those matching all tag filters.
+func (r *symbolRepo) tagFilterScope(filter domain.SymbolFilter) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		tagged := func(where string, args ...interface{}) *gorm.DB {
+			return r.db.Model(&model.SymbolTag{}).Select("symbol_id").Where(where, args...)
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(filter.Tags)) {
+			db = db.Where("id IN (?)", tagged("tag_key = ? AND tag_value = ?", key, filter.Tags[key]))
+		}
+		for _, key := range filter.TagKeys {
+			db = db.Where("id IN (?)", tagged("tag_key = ?", key))
+		}
+		for _, key := range slices.Sorted(maps.Keys(filter.TagIn)) {
+			db = db.Where("id IN (?)", tagged("tag_key = ? AND tag_value IN ?", key, filter.TagIn[key]))
+		}
+
+		return db
+	}
+}
+
+// replaceTags replaces all tags of a symbol with the given ones.
+func (r *symbolRepo) replaceTags(ctx context.Context, symbolID uint64, tags []model.SymbolTag) error {
+	db := common.DB(ctx, r.db)
+
+	if err := db.Where("symbol_id = ?", symbolID).Delete(&model.SymbolTag{}).Error; err != nil {
+		return r.mapGormError(err)
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	if err := db.Create(&tags).Error; err != nil {
+		return r.mapGormError(err)
+	}
+
+	return nil
+}
+
+// replaceReferences replaces all references of a symbol with the given ones.
+func (r *symbolRepo) replaceReferences(ctx context.Context, symbolID uint64, refs []model.SymbolReference) error {
+	db := common.DB(ctx, r.db)
+
+	if err := db.Where("symbol_id = ?", symbolID).Delete(&model.SymbolReference{}).Error; err != nil {
+		return r.mapGormError(err)
+	}
+
+	if len(refs) == 0 {
+		return nil
+	}
+
+	if err := db.Create(&refs).Error; err != nil {
+		return r.mapGormError(err)
+	}
+
+	return nil
+}
+
 // mapGormError translates GORM errors to data layer errors
 func (r *symbolRepo) mapGormError(err error) error {
+	return mapGormError(err)
+}
+
+// isDuplicateKeyError checks if error is a duplicate key violation
+func (r *symbolRepo) isDuplicateKeyError(err error) bool {
+	return isDuplicateKeyError(err)
+}
+
+// mapGormError translates GORM errors to data layer errors.
+func mapGormError(err error) error {
 	if err == nil {
 		return nil
 	}
@@ -170,7 +384,7 @@ func (r *symbolRepo) mapGormError(err error) error {
 	}
 
 	// Check for duplicate key constraint violations
-	if r.isDuplicateKeyError(err) {
+	if isDuplicateKeyError(err) {
 		return domain.ErrDataDuplicateEntry
 	}
 
@@ -179,7 +393,7 @@ func (r *symbolRepo) mapGormError(err error) error {
 }
 
 // isDuplicateKeyError checks if error is a duplicate key violation
-func (r *symbolRepo) isDuplicateKeyError(err error) bool {
+func isDuplicateKeyError(err error) bool {
 	errMsg := err.Error()
 	return strings.Contains(errMsg, "Error 1062") ||
 		strings.Contains(errMsg, "Duplicate entry") ||
@@ -210,9 +424,32 @@ func toDomainSymbol(s *model.Symbol) *domain.Symbol {
 		}
 	}
 
+	if len(s.References) > 0 {
+		d.References = make([]string, 0, len(s.References))
+		for _, ref := range s.References {
+			d.References = append(d.References, ref.RefUID)
+		}
+	}
+
+	if len(s.Tags) > 0 {
+		d.Tags = make(map[string]string, len(s.Tags))
+		for _, tag := range s.Tags {
+			d.Tags[tag.Key] = tag.Value
+		}
+	}
+
 	return d
 }
 
+// toDomainSymbols converts a slice of model.Symbol entities to domain objects.
+func toDomainSymbols(entities []*model.Symbol) []*domain.Symbol {
+	symbols := make([]*domain.Symbol, 0, len(entities))
+	for _, entity := range entities {
+		symbols = append(symbols, toDomainSymbol(entity))
+	}
+	return symbols
+}
+
 // toEntitySymbol transforms a *domain.Symbol domain object into a *model.Symbol persistence object.
 // Returns nil if the input is nil.
 func toEntitySymbol(s *domain.Symbol) *model.Symbol {
@@ -242,5 +479,28 @@ func toEntitySymbol(s *domain.Symbol) *model.Symbol {
 		}
 	}
 
+	if len(s.References) > 0 {
+		d.References = make([]model.SymbolReference, 0, len(s.References))
+		for _, ref := range s.References {
+			d.References = append(d.References, model.SymbolReference{
+				ProjectID: s.Project,
+				SymbolID:  s.ID,
+				RefUID:    ref,
+			})
+		}
+	}
+
+	if len(s.Tags) > 0 {
+		d.Tags = make([]model.SymbolTag, 0, len(s.Tags))
+		for _, key := range slices.Sorted(maps.Keys(s.Tags)) {
+			d.Tags = append(d.Tags, model.SymbolTag{
+				ProjectID: s.Project,
+				SymbolID:  s.ID,
+				Key:       key,
+				Value:     s.Tags[key],
+			})
+		}
+	}
+
 	return d
 }
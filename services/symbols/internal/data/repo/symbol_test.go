@@ -9,10 +9,14 @@ import (
 	"symbols/internal/biz/domain"
 	"symbols/internal/data/common"
 	"symbols/internal/data/model"
+	"symbols/internal/data/mq"
 	"testing"
 
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
@@ -25,7 +29,7 @@ func setupTestDB(t *testing.T) *gorm.DB {
 	}
 
 	// Run migrations for test tables
-	if err := db.AutoMigrate(&model.Symbol{}, &model.SymbolData{}); err != nil {
+	if err := db.AutoMigrate(&model.Symbol{}, &model.SymbolData{}, &model.SymbolReference{}, &model.SymbolTag{}, &model.SymbolLock{}, &model.AuditEvent{}, &model.SymbolRevision{}, &model.ProjectSymbolStats{}); err != nil {
 		t.Errorf("Failed to migrate test tables: %v", err)
 	}
 
@@ -34,8 +38,14 @@ func setupTestDB(t *testing.T) *gorm.DB {
 
 // cleanupDB cleans up test data
 func cleanupDB(db *gorm.DB) {
+	db.Exec("DELETE FROM symbol_references")
+	db.Exec("DELETE FROM symbol_tags")
+	db.Exec("DELETE FROM symbol_locks")
 	db.Exec("DELETE FROM symbol_data")
 	db.Exec("DELETE FROM symbols")
+	db.Exec("DELETE FROM symbol_audit_events")
+	db.Exec("DELETE FROM symbol_revisions")
+	db.Exec("DELETE FROM project_symbol_stats")
 }
 
 // validDomainSymbol returns a valid domain symbol for testing
@@ -1004,3 +1014,75 @@ func TestIsDuplicateKeyError(t *testing.T) {
 		})
 	}
 }
+
+func TestSymbolRepo_FailedPublishRollsBack(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	// Every connection of an in-memory database is a separate database
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+
+	tm := &gormTransaction{db: db}
+	r := NewSymbolRepo(db, tm, log.NewStdLogger(os.Stdout))
+
+	// The message table of the topic is missing, so publishing fails inside the transaction
+	schema, _, err := mq.NewSQLAdapters(db.Dialector.Name())
+	require.NoError(t, err)
+	pub := mq.NewSQLPublisher(db, schema, watermill.NopLogger{})
+	publish := func(ctx context.Context) error {
+		msg := message.NewMessage(watermill.NewUUID(), []byte("event"))
+		msg.SetContext(ctx)
+		return pub.Publish("symbols_lifecycle", msg)
+	}
+
+	t.Run("create", func(t *testing.T) {
+		err := tm.InTx(context.Background(), func(ctx context.Context) error {
+			if _, err := r.Create(ctx, validDomainSymbol()); err != nil {
+				return err
+			}
+			return publish(ctx)
+		})
+		require.Error(t, err)
+
+		var count int64
+		require.NoError(t, db.Unscoped().Model(&model.Symbol{}).Count(&count).Error)
+		assert.Equal(t, int64(0), count, "the symbol rolls back with the event")
+	})
+
+	created, err := r.Create(context.Background(), validDomainSymbol())
+	require.NoError(t, err)
+
+	t.Run("update", func(t *testing.T) {
+		err := tm.InTx(context.Background(), func(ctx context.Context) error {
+			s := *created
+			s.Label = "Renamed"
+			s.References = []string{"other-uid"}
+			s.Tags = map[string]string{"env": "prod"}
+			if _, err := r.Update(ctx, &s); err != nil {
+				return err
+			}
+			return publish(ctx)
+		})
+		require.Error(t, err)
+
+		found, err := r.FindByID(context.Background(), created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Test Symbol", found.Label)
+		assert.Empty(t, found.References)
+		assert.Empty(t, found.Tags)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		err := tm.InTx(context.Background(), func(ctx context.Context) error {
+			if err := r.Delete(ctx, created.ID); err != nil {
+				return err
+			}
+			return publish(ctx)
+		})
+		require.Error(t, err)
+
+		_, err = r.FindByID(context.Background(), created.ID)
+		assert.NoError(t, err, "the symbol is not deleted")
+	})
+}
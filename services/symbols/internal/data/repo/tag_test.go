@@ -0,0 +1,144 @@
+package repo
+
+import (
+	"context"
+	"os"
+	"platform/pagination"
+	"symbols/internal/biz/domain"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tagUID3 = "6ba7b812-9dad-41d1-80b4-00c04fd430c8"
+
+// createSymbolWithTags stores a symbol of project 1 with the given UID and tags.
+func createSymbolWithTags(t *testing.T, r domain.SymbolRepo, uid string, tags map[string]string) *domain.Symbol {
+	s := validDomainSymbol()
+	s.UID = uid
+	s.Tags = tags
+
+	created, err := r.Create(context.Background(), s)
+	require.NoError(t, err)
+
+	return created
+}
+
+func TestSymbolTags_CreateAndUpdate(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	r := NewSymbolRepo(db, &mockTransaction{}, log.NewStdLogger(os.Stdout))
+	ctx := context.Background()
+
+	s := createSymbolWithTags(t, r, refUID1, map[string]string{"theme": "dark", "author": "jane"})
+
+	found, err := r.FindByID(ctx, s.ID)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"theme": "dark", "author": "jane"}, found.Tags)
+
+	s.Tags = map[string]string{"theme": "light"}
+	_, err = r.Update(ctx, s)
+	require.NoError(t, err)
+
+	found, err = r.FindByID(ctx, s.ID)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"theme": "light"}, found.Tags)
+}
+
+func TestSymbolTags_ListSymbolsFilters(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	r := NewSymbolRepo(db, &mockTransaction{}, log.NewStdLogger(os.Stdout))
+
+	header := createSymbolWithTags(t, r, refUID1, map[string]string{"category": "header", "theme": "dark"})
+	footer := createSymbolWithTags(t, r, refUID2, map[string]string{"category": "footer", "theme": "light"})
+	plain := createSymbolWithTags(t, r, tagUID3, nil)
+
+	tests := []struct {
+		name    string
+		filter  domain.SymbolFilter
+		wantIDs []uint64
+	}{
+		{
+			name:    "no tag filter",
+			filter:  domain.SymbolFilter{ProjectID: 1},
+			wantIDs: []uint64{header.ID, footer.ID, plain.ID},
+		},
+		{
+			name:    "equality",
+			filter:  domain.SymbolFilter{ProjectID: 1, Tags: map[string]string{"theme": "dark"}},
+			wantIDs: []uint64{header.ID},
+		},
+		{
+			name:    "equality on several tags",
+			filter:  domain.SymbolFilter{ProjectID: 1, Tags: map[string]string{"theme": "dark", "category": "footer"}},
+			wantIDs: []uint64{},
+		},
+		{
+			name:    "existence",
+			filter:  domain.SymbolFilter{ProjectID: 1, TagKeys: []string{"category"}},
+			wantIDs: []uint64{header.ID, footer.ID},
+		},
+		{
+			name:    "in",
+			filter:  domain.SymbolFilter{ProjectID: 1, TagIn: map[string][]string{"category": {"footer", "sidebar"}}},
+			wantIDs: []uint64{footer.ID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := domain.ListSymbolsOptions{
+				Filter:     tt.filter,
+				Pagination: pagination.OffsetPaginationParams{Offset: 0, Limit: 20},
+				Sort:       domain.DefaultSortOption(),
+			}
+
+			symbols, meta, err := r.ListSymbols(context.Background(), opts)
+			require.NoError(t, err)
+
+			ids := make([]uint64, 0, len(symbols))
+			for _, s := range symbols {
+				ids = append(ids, s.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, uint64(len(tt.wantIDs)), meta.TotalCount)
+		})
+	}
+
+	t.Run("listed symbols carry their tags", func(t *testing.T) {
+		symbols, _, err := r.ListSymbols(context.Background(), domain.ListSymbolsOptions{
+			Filter:     domain.SymbolFilter{ProjectID: 1, Tags: map[string]string{"theme": "dark"}},
+			Pagination: pagination.OffsetPaginationParams{Offset: 0, Limit: 20},
+		})
+		require.NoError(t, err)
+		require.Len(t, symbols, 1)
+		assert.Equal(t, map[string]string{"category": "header", "theme": "dark"}, symbols[0].Tags)
+	})
+}
+
+func TestSymbolTags_Facets(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupDB(db)
+	r := NewSymbolRepo(db, &mockTransaction{}, log.NewStdLogger(os.Stdout))
+	ctx := context.Background()
+
+	createSymbolWithTags(t, r, refUID1, map[string]string{"category": "header", "theme": "dark"})
+	createSymbolWithTags(t, r, refUID2, map[string]string{"theme": "dark"})
+	deleted := createSymbolWithTags(t, r, tagUID3, map[string]string{"theme": "light"})
+	require.NoError(t, r.Delete(ctx, deleted.ID))
+
+	keys, err := r.TagKeyFacets(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []*domain.TagFacet{{Value: "category", Count: 1}, {Value: "theme", Count: 2}}, keys)
+
+	values, err := r.TagValueFacets(ctx, 1, "theme")
+	require.NoError(t, err)
+	assert.Equal(t, []*domain.TagFacet{{Value: "dark", Count: 2}}, values, "soft-deleted symbols are not counted")
+
+	other, err := r.TagKeyFacets(ctx, 2)
+	require.NoError(t, err)
+	assert.Empty(t, other)
+}
@@ -0,0 +1,111 @@
+package data
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// tracingSpanKey is the statement instance key holding the span of a query between its callbacks.
+const tracingSpanKey = "tracing:span"
+
+// tracingPlugin is a GORM plugin running each statement in a client span, the child of the span of its
+// context. Statements only carry that context when the repositories use db.WithContext.
+type tracingPlugin struct {
+	tracer trace.Tracer
+}
+
+// newTracingPlugin creates the GORM plugin tracing the queries with tp.
+func newTracingPlugin(tp trace.TracerProvider) gorm.Plugin {
+	return &tracingPlugin{tracer: tp.Tracer("symbols/internal/data")}
+}
+
+// Name returns the plugin name.
+func (p *tracingPlugin) Name() string {
+	return "tracing"
+}
+
+// callbackRegistrar registers a callback at its position in a GORM processor.
+type callbackRegistrar interface {
+	Register(name string, fn func(*gorm.DB)) error
+}
+
+// Initialize registers the span callbacks around every GORM operation.
+func (p *tracingPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	hooks := []struct {
+		name, operation string
+		before, after   callbackRegistrar
+	}{
+		{"create", "INSERT", cb.Create().Before("gorm:create"), cb.Create().After("gorm:create")},
+		{"query", "SELECT", cb.Query().Before("gorm:query"), cb.Query().After("gorm:query")},
+		{"update", "UPDATE", cb.Update().Before("gorm:update"), cb.Update().After("gorm:update")},
+		{"delete", "DELETE", cb.Delete().Before("gorm:delete"), cb.Delete().After("gorm:delete")},
+		{"row", "ROW", cb.Row().Before("gorm:row"), cb.Row().After("gorm:row")},
+		{"raw", "RAW", cb.Raw().Before("gorm:raw"), cb.Raw().After("gorm:raw")},
+	}
+
+	for _, h := range hooks {
+		if err := h.before.Register("tracing:before_"+h.name, p.before(h.operation)); err != nil {
+			return err
+		}
+		if err := h.after.Register("tracing:after_"+h.name, p.after); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// before starts the span of a statement.
+func (p *tracingPlugin) before(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		if db.Statement == nil || db.Statement.Context == nil {
+			return
+		}
+
+		name := operation
+		if db.Statement.Table != "" {
+			name += " " + db.Statement.Table
+		}
+		_, span := p.tracer.Start(db.Statement.Context, name,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				semconv.DBSystemNameKey.String(db.Dialector.Name()),
+				semconv.DBOperationName(operation),
+			),
+		)
+		db.InstanceSet(tracingSpanKey, span)
+	}
+}
+
+// after ends the span of a statement with its SQL, the affected rows and its error.
+// A missing record is an expected outcome, not a failure.
+func (p *tracingPlugin) after(db *gorm.DB) {
+	v, ok := db.InstanceGet(tracingSpanKey)
+	if !ok {
+		return
+	}
+	span, ok := v.(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	attrs := []attribute.KeyValue{
+		semconv.DBQueryText(db.Statement.SQL.String()),
+		semconv.DBResponseReturnedRows(int(db.Statement.RowsAffected)),
+	}
+	if db.Statement.Table != "" {
+		attrs = append(attrs, semconv.DBCollectionName(db.Statement.Table))
+	}
+	span.SetAttributes(attrs...)
+
+	if err := db.Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
@@ -0,0 +1,110 @@
+package data
+
+import (
+	"context"
+	"symbols/internal/data/model"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestTracingPlugin(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&model.SchedulerLease{}))
+	require.NoError(t, db.Use(newTracingPlugin(tp)))
+
+	ctx, request := tp.Tracer("test").Start(context.Background(), "request")
+	defer request.End()
+
+	tests := []struct {
+		name       string
+		run        func(db *gorm.DB) error
+		wantName   string
+		wantStatus codes.Code
+	}{
+		{
+			name:     "insert",
+			run:      func(db *gorm.DB) error { return db.Create(&model.SchedulerLease{Name: "job", Holder: "a"}).Error },
+			wantName: "INSERT scheduler_leases",
+		},
+		{
+			name: "select",
+			run: func(db *gorm.DB) error {
+				var lease model.SchedulerLease
+				return db.First(&lease, "name = ?", "job").Error
+			},
+			wantName: "SELECT scheduler_leases",
+		},
+		{
+			name: "missing record is not an error",
+			run: func(db *gorm.DB) error {
+				var lease model.SchedulerLease
+				_ = db.First(&lease, "name = ?", "missing").Error
+				return nil
+			},
+			wantName: "SELECT scheduler_leases",
+		},
+		{
+			name: "update",
+			run: func(db *gorm.DB) error {
+				return db.Model(&model.SchedulerLease{}).Where("name = ?", "job").Update("holder", "b").Error
+			},
+			wantName: "UPDATE scheduler_leases",
+		},
+		{
+			name:     "delete",
+			run:      func(db *gorm.DB) error { return db.Delete(&model.SchedulerLease{}, "name = ?", "job").Error },
+			wantName: "DELETE scheduler_leases",
+		},
+		{
+			name:     "raw",
+			run:      func(db *gorm.DB) error { return db.Exec("UPDATE scheduler_leases SET holder = ?", "c").Error },
+			wantName: "RAW",
+		},
+		{
+			name: "failure",
+			run: func(db *gorm.DB) error {
+				_ = db.Exec("SELECT * FROM missing_table").Error
+				return nil
+			},
+			wantName:   "RAW",
+			wantStatus: codes.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := len(recorder.Ended())
+			require.NoError(t, tt.run(db.WithContext(ctx)))
+
+			spans := recorder.Ended()[before:]
+			require.Len(t, spans, 1)
+			span := spans[0]
+			assert.Equal(t, tt.wantName, span.Name())
+			assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+			assert.Equal(t, request.SpanContext().SpanID(), span.Parent().SpanID())
+			assert.Equal(t, tt.wantStatus, span.Status().Code)
+			assert.Contains(t, span.Attributes(), attribute.String("db.system.name", "sqlite"))
+
+			var query string
+			for _, a := range span.Attributes() {
+				if a.Key == "db.query.text" {
+					query = a.Value.AsString()
+				}
+			}
+			assert.NotEmpty(t, query)
+		})
+	}
+}
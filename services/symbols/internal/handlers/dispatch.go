@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"context"
+	eventsv1 "contracts/gen/events/symbols/v1"
+	"fmt"
+	"platform/events"
+	conf "symbols/internal/conf/gen"
+	"symbols/internal/data/mq"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Unknown event policies of conf.RabbitMQServer_Queue.unknown_events.
+const (
+	UnknownEventsIgnore     = "ignore"
+	UnknownEventsDeadLetter = "dead_letter"
+)
+
+// SymbolEventHandler handles decoded symbol lifecycle events.
+// Returned errors are retried unless they wrap events.ErrPermanent.
+type SymbolEventHandler interface {
+	SymbolCreated(ctx context.Context, evt *eventsv1.SymbolCreated) error
+	SymbolUpdated(ctx context.Context, evt *eventsv1.SymbolUpdated) error
+	SymbolDeleted(ctx context.Context, evt *eventsv1.SymbolDeleted) error
+}
+
+// RegisterSymbolEventHandler registers the typed methods of h for the symbol lifecycle events on topic.
+func RegisterSymbolEventHandler(r *events.Registry, topic string, h SymbolEventHandler) {
+	events.Handle(r, topic, h.SymbolCreated)
+	events.Handle(r, topic, h.SymbolUpdated)
+	events.Handle(r, topic, h.SymbolDeleted)
+}
+
+// NewFallbackHandler returns the handler of events without a typed handler selected by the queue
+// unknown_events policy.
+func NewFallbackHandler(cfg *conf.Data, logger log.Logger) events.FallbackFunc {
+	l := log.NewHelper(logger)
+
+	if cfg.GetMq().GetQueue().GetUnknownEvents() == UnknownEventsDeadLetter {
+		return func(msg *message.Message) error {
+			return fmt.Errorf("%w: no handler for event %q", events.ErrPermanent, eventName(msg))
+		}
+	}
+
+	return func(msg *message.Message) error {
+		l.WithContext(msg.Context()).Debugf("Ignoring event %q of message %s: no handler", eventName(msg), msg.UUID)
+		return nil
+	}
+}
+
+// eventName returns the CloudEvents type of the message, or its routing key.
+func eventName(msg *message.Message) string {
+	if t := msg.Metadata.Get(events.HeaderType); t != "" {
+		return t
+	}
+	return mq.MessageRoutingKey(msg)
+}
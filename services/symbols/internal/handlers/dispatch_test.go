@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"context"
+	eventsv1 "contracts/gen/events/symbols/v1"
+	"errors"
+	"os"
+	"platform/events"
+	"symbols/internal/biz/event"
+	conf "symbols/internal/conf/gen"
+	"symbols/internal/data/mq"
+	"testing"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
+)
+
+func newMessage(t *testing.T, topic string, evt proto.Message) *message.Message {
+	payload, err := proto.Marshal(evt)
+	assert.NoError(t, err)
+
+	msg := message.NewMessage(watermill.NewUUID(), payload)
+	mq.SetMessageRoutingKey(topic, msg)
+	return msg
+}
+
+type mockSymbolEventHandler struct {
+	mock.Mock
+}
+
+func (h *mockSymbolEventHandler) SymbolCreated(ctx context.Context, evt *eventsv1.SymbolCreated) error {
+	return h.Called(ctx, evt.GetId()).Error(0)
+}
+
+func (h *mockSymbolEventHandler) SymbolUpdated(ctx context.Context, evt *eventsv1.SymbolUpdated) error {
+	return h.Called(ctx, evt.GetId()).Error(0)
+}
+
+func (h *mockSymbolEventHandler) SymbolDeleted(ctx context.Context, evt *eventsv1.SymbolDeleted) error {
+	return h.Called(ctx, evt.GetId()).Error(0)
+}
+
+func TestRegisterSymbolEventHandler(t *testing.T) {
+	handlerErr := errors.New("db down")
+
+	tests := []struct {
+		name          string
+		msg           func(t *testing.T) *message.Message
+		wantCall      string
+		callErr       error
+		wantFallback  bool
+		wantErr       error
+		wantPermanent bool
+	}{
+		{
+			name: "created",
+			msg: func(t *testing.T) *message.Message {
+				return newMessage(t, event.SymbolCreatedTopic, &eventsv1.SymbolCreated{Id: 1})
+			},
+			wantCall: "SymbolCreated",
+		},
+		{
+			name: "updated",
+			msg: func(t *testing.T) *message.Message {
+				return newMessage(t, event.SymbolUpdatedTopic, &eventsv1.SymbolUpdated{Id: 1})
+			},
+			wantCall: "SymbolUpdated",
+		},
+		{
+			name: "deleted",
+			msg: func(t *testing.T) *message.Message {
+				return newMessage(t, event.SymbolDeletedTopic, &eventsv1.SymbolDeleted{Id: 1})
+			},
+			wantCall: "SymbolDeleted",
+		},
+		{
+			name: "cloudevents type",
+			msg: func(t *testing.T) *message.Message {
+				msg := newMessage(t, "", &eventsv1.SymbolDeleted{Id: 1})
+				events.SetCloudEvent(msg, events.CloudEvent{Type: "events.symbols.v1.SymbolDeleted", Source: mq.EventSource})
+				return msg
+			},
+			wantCall: "SymbolDeleted",
+		},
+		{
+			name: "handler error is returned for retry",
+			msg: func(t *testing.T) *message.Message {
+				return newMessage(t, event.SymbolCreatedTopic, &eventsv1.SymbolCreated{Id: 1})
+			},
+			wantCall: "SymbolCreated",
+			callErr:  handlerErr,
+			wantErr:  handlerErr,
+		},
+		{
+			name: "event without typed handler goes to the fallback",
+			msg: func(t *testing.T) *message.Message {
+				return newMessage(t, event.SymbolLockedTopic, &eventsv1.SymbolLocked{Id: 1})
+			},
+			wantFallback: true,
+		},
+		{
+			name: "unknown routing key goes to the fallback",
+			msg: func(t *testing.T) *message.Message {
+				return newMessage(t, "symbol.archived", &eventsv1.SymbolCreated{Id: 1})
+			},
+			wantFallback: true,
+		},
+		{
+			name: "undecodable payload fails permanently",
+			msg: func(t *testing.T) *message.Message {
+				msg := message.NewMessage(watermill.NewUUID(), []byte{0xff, 0xff})
+				mq.SetMessageRoutingKey(event.SymbolUpdatedTopic, msg)
+				return msg
+			},
+			wantPermanent: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &mockSymbolEventHandler{}
+			var fallbackCalled bool
+			r := events.NewRegistry(nil,
+				events.WithTypeResolver(mq.EventType),
+				events.WithFallback(func(*message.Message) error {
+					fallbackCalled = true
+					return nil
+				}),
+			)
+			RegisterSymbolEventHandler(r, "lifecycle_events", h)
+			msg := tt.msg(t)
+
+			if tt.wantCall != "" {
+				h.On(tt.wantCall, msg.Context(), uint64(1)).Return(tt.callErr)
+			}
+
+			err := r.Dispatch("lifecycle_events", msg)
+
+			switch {
+			case tt.wantPermanent:
+				assert.ErrorIs(t, err, events.ErrPermanent)
+			case tt.wantErr != nil:
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.NotErrorIs(t, err, events.ErrPermanent)
+			default:
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantFallback, fallbackCalled)
+			h.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNewFallbackHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        string
+		wantPermanent bool
+	}{
+		{name: "default ignores", policy: ""},
+		{name: "ignore", policy: UnknownEventsIgnore},
+		{name: "dead letter", policy: UnknownEventsDeadLetter, wantPermanent: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &conf.Data{Mq: &conf.RabbitMQServer{Queue: &conf.RabbitMQServer_Queue{UnknownEvents: tt.policy}}}
+			fallback := NewFallbackHandler(cfg, log.NewStdLogger(os.Stdout))
+
+			err := fallback(newMessage(t, "symbol.archived", &eventsv1.SymbolCreated{Id: 1}))
+
+			if tt.wantPermanent {
+				assert.ErrorIs(t, err, events.ErrPermanent)
+				assert.ErrorContains(t, err, "symbol.archived")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
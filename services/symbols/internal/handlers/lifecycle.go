@@ -2,9 +2,10 @@
 package handlers
 
 import (
+	"context"
+	eventsv1 "contracts/gen/events/symbols/v1"
 	"symbols/internal/biz/domain"
 
-	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
@@ -16,22 +17,28 @@ func NewLifecycleEventHandler(symbolUC domain.SymbolUseCase, logger log.Logger)
 	}
 }
 
+// LifecycleEventHandler keeps the materialized project stats up to date with symbol lifecycle events.
 type LifecycleEventHandler struct {
 	logger   *log.Helper
 	symbolUC domain.SymbolUseCase
 }
 
-func (h *LifecycleEventHandler) Handle(msg *message.Message) error {
-	ctx := msg.Context()
-	// Extract correlation ID for tracing
-	correlationID := msg.Metadata.Get("correlation_id")
-	h.logger.WithContext(ctx).Infof(
-		"Processing lifecycle event - msgID: %s, correlationID: %s",
-		msg.UUID,
-		correlationID,
-	)
+// SymbolCreated refreshes the stats of the project the symbol was added to.
+func (h *LifecycleEventHandler) SymbolCreated(ctx context.Context, evt *eventsv1.SymbolCreated) error {
+	return h.refreshStats(ctx, evt.GetProjectId(), evt.GetId(), "created")
+}
+
+// SymbolUpdated refreshes the stats of the project of the symbol.
+func (h *LifecycleEventHandler) SymbolUpdated(ctx context.Context, evt *eventsv1.SymbolUpdated) error {
+	return h.refreshStats(ctx, evt.GetProjectId(), evt.GetId(), "updated")
+}
 
-	// Business logic here
+// SymbolDeleted refreshes the stats of the project the symbol was removed from.
+func (h *LifecycleEventHandler) SymbolDeleted(ctx context.Context, evt *eventsv1.SymbolDeleted) error {
+	return h.refreshStats(ctx, evt.GetProjectId(), evt.GetId(), "deleted")
+}
 
-	return nil
+func (h *LifecycleEventHandler) refreshStats(ctx context.Context, projectID, symbolID uint64, change string) error {
+	h.logger.WithContext(ctx).Infof("Symbol %d %s, refreshing stats of project %d", symbolID, change, projectID)
+	return h.symbolUC.RefreshProjectSymbolStats(ctx, projectID)
 }
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"context"
+	eventsv1 "contracts/gen/events/symbols/v1"
+	"errors"
+	"os"
+	"platform/events"
+	"symbols/internal/biz/domain"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// mockSymbolUseCase implements only the use cases the handler calls.
+type mockSymbolUseCase struct {
+	domain.SymbolUseCase
+	mock.Mock
+}
+
+func (uc *mockSymbolUseCase) RefreshProjectSymbolStats(ctx context.Context, projectID uint64) error {
+	args := uc.Called(ctx, projectID)
+	return args.Error(0)
+}
+
+func TestLifecycleEventHandler(t *testing.T) {
+	tests := []struct {
+		name        string
+		handle      func(ctx context.Context, h *LifecycleEventHandler) error
+		refreshErr  error
+		wantProject uint64
+		wantErr     bool
+	}{
+		{
+			name: "created",
+			handle: func(ctx context.Context, h *LifecycleEventHandler) error {
+				return h.SymbolCreated(ctx, &eventsv1.SymbolCreated{Id: 1, ProjectId: 7})
+			},
+			wantProject: 7,
+		},
+		{
+			name: "updated",
+			handle: func(ctx context.Context, h *LifecycleEventHandler) error {
+				return h.SymbolUpdated(ctx, &eventsv1.SymbolUpdated{Id: 1, ProjectId: 8})
+			},
+			wantProject: 8,
+		},
+		{
+			name: "deleted",
+			handle: func(ctx context.Context, h *LifecycleEventHandler) error {
+				return h.SymbolDeleted(ctx, &eventsv1.SymbolDeleted{Id: 1, ProjectId: 9})
+			},
+			wantProject: 9,
+		},
+		{
+			name: "refresh failure is retried",
+			handle: func(ctx context.Context, h *LifecycleEventHandler) error {
+				return h.SymbolCreated(ctx, &eventsv1.SymbolCreated{Id: 1, ProjectId: 7})
+			},
+			refreshErr:  errors.New("db down"),
+			wantProject: 7,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			uc := &mockSymbolUseCase{}
+			h := NewLifecycleEventHandler(uc, log.NewStdLogger(os.Stdout))
+
+			uc.On("RefreshProjectSymbolStats", ctx, tt.wantProject).Return(tt.refreshErr)
+
+			err := tt.handle(ctx, h)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.NotErrorIs(t, err, events.ErrPermanent)
+			} else {
+				assert.NoError(t, err)
+			}
+			uc.AssertExpectations(t)
+		})
+	}
+}
@@ -5,4 +5,4 @@ import (
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewLifecycleEventHandler)
+var ProviderSet = wire.NewSet(NewLifecycleEventHandler, NewFallbackHandler)
@@ -3,8 +3,11 @@ package server
 
 import (
 	v1 "contracts/gen/service/symbols/v1"
+	"platform/health"
+	"platform/idempotency"
 	"platform/metrics"
 	"platform/middleware"
+	platform_ratelimit "platform/ratelimit"
 	"symbols/internal/conf/gen"
 	"symbols/internal/service"
 
@@ -14,16 +17,22 @@ import (
 	"github.com/go-kratos/kratos/v2/middleware/logging"
 	"github.com/go-kratos/kratos/v2/middleware/ratelimit"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	"go.opentelemetry.io/otel/trace"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, mc *conf.Metrics, reg *metrics.Registry, symbolService *service.SymbolService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, mc *conf.Metrics, reg *metrics.Registry, trusted middleware.TrustedProxies, limiter *platform_ratelimit.Limiter, idem *idempotency.Handler, hc *health.Health, symbolService *service.SymbolService, tp trace.TracerProvider, logger log.Logger) *grpc.Server {
 	// Build middleware chain
 	middlewares := []kratos_middleware.Middleware{
 		recovery.Recovery(),
+		// Server spans continue the trace context of the caller, and the logs carry their trace.id and span.id
+		tracing.Server(tracing.WithTracerProvider(tp)),
 		ratelimit.Server(),
 		middleware.RequestIDMiddleware(logger),
+		middleware.CallerMiddleware(trusted),
 	}
 
 	// Add metrics middleware if enabled
@@ -31,13 +40,24 @@ func NewGRPCServer(c *conf.Server, mc *conf.Metrics, reg *metrics.Registry, symb
 		middlewares = append(middlewares, metrics.GRPCMiddleware(reg))
 	}
 
-	middlewares = append(middlewares,
-		logging.Server(logger),
-		validate.ProtoValidate(),
-	)
+	middlewares = append(middlewares, logging.Server(logger))
+
+	// Add per-client rate limiting if enabled
+	if limiter != nil {
+		middlewares = append(middlewares, limiter.Middleware())
+	}
+
+	middlewares = append(middlewares, validate.ProtoValidate())
+
+	// Replay responses of retried mutations if enabled
+	if idem != nil {
+		middlewares = append(middlewares, idem.Middleware())
+	}
 
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(middlewares...),
+		// Serve our grpc.health.v1, reporting the readiness of the dependencies
+		grpc.CustomHealth(),
 	}
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
@@ -49,6 +69,7 @@ func NewGRPCServer(c *conf.Server, mc *conf.Metrics, reg *metrics.Registry, symb
 		opts = append(opts, grpc.Timeout(c.Grpc.Timeout.AsDuration()))
 	}
 	srv := grpc.NewServer(opts...)
+	healthpb.RegisterHealthServer(srv, hc.GRPCServer(v1.SymbolsService_ServiceDesc.ServiceName))
 	v1.RegisterSymbolsServiceServer(srv, symbolService)
 	return srv
 }
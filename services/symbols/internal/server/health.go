@@ -0,0 +1,25 @@
+package server
+
+import (
+	"platform/health"
+	"platform/metrics"
+	conf "symbols/internal/conf/gen"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewHealth creates the liveness and readiness probes shared by the HTTP and gRPC servers, checking
+// the dependencies of the data layer.
+func NewHealth(c *conf.Server, checks health.Checks, reg *metrics.Registry, logger log.Logger) (*health.Health, error) {
+	hc := c.GetHealth()
+	h := health.New(health.Options{
+		Timeout:  hc.GetTimeout().AsDuration(),
+		CacheTTL: hc.GetCacheTtl().AsDuration(),
+	}, reg, logger)
+
+	if err := h.RegisterChecks(checks); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
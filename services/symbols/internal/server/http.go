@@ -2,8 +2,11 @@ package server
 
 import (
 	v1 "contracts/gen/service/symbols/v1"
+	"platform/health"
+	"platform/idempotency"
 	"platform/metrics"
 	"platform/middleware"
+	platform_ratelimit "platform/ratelimit"
 	"symbols/internal/conf/gen"
 	"symbols/internal/service"
 
@@ -13,16 +16,21 @@ import (
 	"github.com/go-kratos/kratos/v2/middleware/logging"
 	"github.com/go-kratos/kratos/v2/middleware/ratelimit"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/gorilla/handlers"
+	"go.opentelemetry.io/otel/trace"
 )
 
-func NewHTTPServer(c *conf.Server, mc *conf.Metrics, reg *metrics.Registry, symbolService *service.SymbolService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, mc *conf.Metrics, reg *metrics.Registry, trusted middleware.TrustedProxies, limiter *platform_ratelimit.Limiter, idem *idempotency.Handler, hc *health.Health, symbolService *service.SymbolService, tp trace.TracerProvider, logger log.Logger) *http.Server {
 	// Build middleware chain
 	middlewares := []kratosmiddleware.Middleware{
 		recovery.Recovery(),
+		// Server spans continue the trace context of the caller, and the logs carry their trace.id and span.id
+		tracing.Server(tracing.WithTracerProvider(tp)),
 		ratelimit.Server(),
 		middleware.RequestIDMiddleware(logger),
+		middleware.CallerMiddleware(trusted),
 	}
 
 	// Add metrics middleware if enabled
@@ -30,10 +38,19 @@ func NewHTTPServer(c *conf.Server, mc *conf.Metrics, reg *metrics.Registry, symb
 		middlewares = append(middlewares, metrics.HTTPMiddleware(reg))
 	}
 
-	middlewares = append(middlewares,
-		logging.Server(logger),
-		validate.ProtoValidate(),
-	)
+	middlewares = append(middlewares, logging.Server(logger))
+
+	// Add per-client rate limiting if enabled
+	if limiter != nil {
+		middlewares = append(middlewares, limiter.Middleware())
+	}
+
+	middlewares = append(middlewares, validate.ProtoValidate())
+
+	// Replay responses of retried mutations if enabled
+	if idem != nil {
+		middlewares = append(middlewares, idem.Middleware())
+	}
 
 	var opts = []http.ServerOption{
 		http.Middleware(middlewares...),
@@ -66,6 +83,10 @@ func NewHTTPServer(c *conf.Server, mc *conf.Metrics, reg *metrics.Registry, symb
 		srv.Handle(metricsPath, metrics.NewMetricsHandler(reg))
 	}
 
+	// Probes bypass the middleware: they are neither traced, logged nor rate limited
+	srv.Handle(health.LivenessPath, hc.LivenessHandler())
+	srv.Handle(health.ReadinessPath, hc.ReadinessHandler())
+
 	v1.RegisterSymbolsServiceHTTPServer(srv, symbolService)
 	return srv
 }
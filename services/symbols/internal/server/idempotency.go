@@ -0,0 +1,20 @@
+package server
+
+import (
+	"platform/idempotency"
+	"platform/metrics"
+	conf "symbols/internal/conf/gen"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewIdempotencyHandler creates the idempotency-key handler shared by the HTTP and gRPC servers.
+// Returns nil when idempotency keys are disabled.
+func NewIdempotencyHandler(c *conf.Server, store idempotency.Store, reg *metrics.Registry, logger log.Logger) *idempotency.Handler {
+	ic := c.GetIdempotency()
+	if ic == nil || !ic.Enabled.GetValue() || store == nil {
+		return nil
+	}
+
+	return idempotency.NewHandler(store, ic.Operations, ic.Ttl.AsDuration(), reg, logger)
+}
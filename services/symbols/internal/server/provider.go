@@ -9,4 +9,9 @@ var ProviderSet = wire.NewSet(
 	NewHTTPServer,
 	NewGRPCServer,
 	NewMetricsRegistry,
+	NewTracerProvider,
+	NewTrustedProxies,
+	NewRateLimiter,
+	NewIdempotencyHandler,
+	NewHealth,
 )
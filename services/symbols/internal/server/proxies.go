@@ -0,0 +1,11 @@
+package server
+
+import (
+	"platform/middleware"
+	conf "symbols/internal/conf/gen"
+)
+
+// NewTrustedProxies parses the proxies whose X-Forwarded-For header the HTTP and gRPC servers honour.
+func NewTrustedProxies(c *conf.Server) (middleware.TrustedProxies, error) {
+	return middleware.ParseTrustedProxies(c.GetTrustedProxies())
+}
@@ -0,0 +1,34 @@
+package server
+
+import (
+	"platform/metrics"
+	"platform/ratelimit"
+	conf "symbols/internal/conf/gen"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// NewRateLimiter creates the per-client rate limiter shared by the HTTP and gRPC servers.
+// Returns nil when rate limiting is disabled.
+func NewRateLimiter(c *conf.Server, store ratelimit.Store, reg *metrics.Registry, logger log.Logger) *ratelimit.Limiter {
+	rl := c.GetRateLimit()
+	if rl == nil || !rl.Enabled.GetValue() || store == nil {
+		return nil
+	}
+
+	rules := make([]ratelimit.Rule, 0, len(rl.Rules))
+	for _, r := range rl.Rules {
+		rate := float64(r.Requests) / r.Period.AsDuration().Seconds()
+		burst := int(r.Burst)
+		if burst == 0 {
+			burst = int(r.Requests)
+		}
+		rules = append(rules, ratelimit.Rule{
+			Operation: r.Operation,
+			Key:       ratelimit.KeyType(r.Key),
+			Limit:     ratelimit.Limit{Rate: rate, Burst: burst},
+		})
+	}
+
+	return ratelimit.NewLimiter(store, rules, reg, logger)
+}
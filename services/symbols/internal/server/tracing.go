@@ -0,0 +1,45 @@
+package server
+
+import (
+	"context"
+	"os"
+	"platform/build"
+	"platform/tracing"
+	conf "symbols/internal/conf/gen"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewTracerProvider creates the tracer provider exporting the spans of the servers, the database and
+// the broker as configured by conf.Tracing, and registers it globally. Spans are not exported when
+// tracing is not configured.
+func NewTracerProvider(tc *conf.Tracing, buildInfo *build.ServiceBuildInfo, logger log.Logger) (trace.TracerProvider, func(), error) {
+	cfg := tracing.DefaultConfig()
+	if tc != nil {
+		if tc.Exporter != "" {
+			cfg.Exporter = tc.Exporter
+		}
+		if tc.Protocol != "" {
+			cfg.Protocol = tc.Protocol
+		}
+		cfg.Endpoint = tc.Endpoint
+		cfg.Insecure = tc.GetInsecure().GetValue()
+		cfg.Headers = tc.Headers
+		if tc.SampleRatio != nil {
+			cfg.SampleRatio = tc.SampleRatio.Value
+		}
+	}
+
+	instanceID, _ := os.Hostname()
+	tp, cleanup, err := tracing.NewTracerProvider(context.Background(), cfg, buildInfo, instanceID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if cfg.Exporter != tracing.ExporterNone {
+		log.NewHelper(logger).Infof("Exporting traces to %s (sample ratio %.2f)", cfg.Exporter, cfg.SampleRatio)
+	}
+
+	return tp, cleanup, nil
+}
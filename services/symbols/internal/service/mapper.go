@@ -4,11 +4,20 @@ package service
 import (
 	v1 "contracts/gen/service/symbols/v1"
 	"fmt"
+	"maps"
 	"platform/pagination"
+	"slices"
+	"strings"
 
 	"symbols/internal/biz/domain"
 
 	"github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func toBizSymbol(s *v1.Symbol) *domain.Symbol {
@@ -35,6 +44,8 @@ func SymbolFromCreateRequest(s *v1.CreateSymbolRequest) *domain.Symbol {
 		ClassName:       s.ClassName,
 		ComponentTarget: s.ComponentTarget,
 		Version:         s.Version,
+		References:      s.References,
+		Tags:            s.Tags,
 		Data: &domain.SymbolData{
 			Project: s.ProjectId,
 			Data:    &s.Data,
@@ -50,6 +61,8 @@ func SymbolFromUpdateRequest(s *v1.UpdateSymbolRequest) *domain.Symbol {
 		ClassName:       s.ClassName,
 		ComponentTarget: s.ComponentTarget,
 		Version:         s.Version,
+		References:      s.References,
+		Tags:            s.Tags,
 		Data: &domain.SymbolData{
 			Project: s.ProjectId,
 			Data:    &s.Data,
@@ -80,6 +93,7 @@ func NewListSymbolsOptions(in *v1.ListSymbolsRequest) domain.ListSymbolsOptions
 	if in.ComponentTarget != nil {
 		filter.ComponentTarget = in.ComponentTarget
 	}
+	applyTagFilters(&filter, in)
 
 	return domain.ListSymbolsOptions{
 		Filter: filter,
@@ -91,6 +105,130 @@ func NewListSymbolsOptions(in *v1.ListSymbolsRequest) domain.ListSymbolsOptions
 	}
 }
 
+// applyTagFilters parses the tag filters of a list request into the domain filter.
+// Entries of tags and tag_in without "=" filter on key existence.
+func applyTagFilters(filter *domain.SymbolFilter, in *v1.ListSymbolsRequest) {
+	if len(in.TagKeys) > 0 {
+		filter.TagKeys = append(filter.TagKeys, in.TagKeys...)
+	}
+
+	for _, tag := range in.Tags {
+		key, value, ok := strings.Cut(tag, "=")
+		if !ok {
+			filter.TagKeys = append(filter.TagKeys, key)
+			continue
+		}
+		if filter.Tags == nil {
+			filter.Tags = make(map[string]string)
+		}
+		filter.Tags[key] = value
+	}
+
+	for _, tag := range in.TagIn {
+		key, values, ok := strings.Cut(tag, "=")
+		if !ok {
+			filter.TagKeys = append(filter.TagKeys, key)
+			continue
+		}
+		if filter.TagIn == nil {
+			filter.TagIn = make(map[string][]string)
+		}
+		filter.TagIn[key] = append(filter.TagIn[key], strings.Split(values, ",")...)
+	}
+}
+
+// toV1TagFacets transforms domain tag facets to proto facets
+func toV1TagFacets(facets []*domain.TagFacet) []*v1.TagFacet {
+	result := make([]*v1.TagFacet, 0, len(facets))
+	for _, f := range facets {
+		result = append(result, &v1.TagFacet{Value: f.Value, Count: f.Count})
+	}
+	return result
+}
+
+func toV1SymbolRevisions(revisions []*domain.SymbolRevision) []*v1.SymbolRevision {
+	result := make([]*v1.SymbolRevision, 0, len(revisions))
+	for _, r := range revisions {
+		result = append(result, &v1.SymbolRevision{
+			Revision:  r.Revision,
+			Version:   r.Symbol.Version,
+			CreatedAt: timestamppb.New(r.CreatedAt),
+		})
+	}
+	return result
+}
+
+// DiffTargetFromRequest maps the target oneof of a DiffSymbolRequest
+func DiffTargetFromRequest(in *v1.DiffSymbolRequest) domain.DiffTarget {
+	switch t := in.Target.(type) {
+	case *v1.DiffSymbolRequest_Revision:
+		return domain.DiffTarget{Revision: t.Revision}
+	case *v1.DiffSymbolRequest_OtherId:
+		return domain.DiffTarget{SymbolID: t.OtherId}
+	case *v1.DiffSymbolRequest_Proposed:
+		if p := t.Proposed; p != nil {
+			return domain.DiffTarget{Proposed: &domain.Symbol{
+				Label:           p.Label,
+				ClassName:       p.ClassName,
+				ComponentTarget: p.ComponentTarget,
+				Version:         p.Version,
+				Data:            &domain.SymbolData{Data: &p.Data},
+				References:      p.References,
+				Tags:            p.Tags,
+			}}
+		}
+	}
+	return domain.DiffTarget{}
+}
+
+func toV1DiffSymbolResponse(d *domain.SymbolDiff) (*v1.DiffSymbolResponse, error) {
+	data := &v1.DataDiff{Changed: d.Data.Changed}
+
+	for _, op := range d.Data.Patch {
+		v1op := &v1.JsonPatchOperation{Op: op.Op, Path: op.Path}
+		if op.Value != nil {
+			v1op.Value = &structpb.Value{}
+			if err := protojson.Unmarshal(op.Value, v1op.Value); err != nil {
+				return nil, fmt.Errorf("failed to convert patch value at %q: %w", op.Path, err)
+			}
+		}
+		data.Patch = append(data.Patch, v1op)
+	}
+
+	if b := d.Data.Bytes; b != nil {
+		data.Bytes = &v1.DataByteSummary{
+			OldSize:      uint64(b.OldSize),
+			NewSize:      uint64(b.NewSize),
+			OldSha256:    b.OldSHA256,
+			NewSha256:    b.NewSHA256,
+			CommonPrefix: uint64(b.CommonPrefix),
+			CommonSuffix: uint64(b.CommonSuffix),
+		}
+	}
+
+	return &v1.DiffSymbolResponse{Changes: toV1FieldChanges(d.Changes), Data: data}, nil
+}
+
+// toV1ProjectSymbolStats transforms domain project stats to proto, ordering component targets by name
+func toV1ProjectSymbolStats(s *domain.ProjectSymbolStats) *v1.ProjectSymbolStats {
+	targets := make([]*v1.ComponentTargetCount, 0, len(s.ComponentTargets))
+	for _, target := range slices.Sorted(maps.Keys(s.ComponentTargets)) {
+		targets = append(targets, &v1.ComponentTargetCount{ComponentTarget: target, Count: s.ComponentTargets[target]})
+	}
+
+	return &v1.ProjectSymbolStats{
+		ProjectId:        s.ProjectID,
+		TotalSymbols:     s.TotalSymbols,
+		ComponentTargets: targets,
+		TotalDataBytes:   s.TotalDataBytes,
+		AverageDataBytes: s.AverageDataBytes(),
+		ChangedLastDay:   s.ChangedLastDay,
+		ChangedLastWeek:  s.ChangedLastWeek,
+		ComputedAt:       timestamppb.New(s.ComputedAt),
+		Materialized:     s.Materialized,
+	}
+}
+
 func toV1Symbol(s *domain.Symbol) *v1.Symbol {
 	var data []byte
 	if s.Data != nil && s.Data.Data != nil {
@@ -105,6 +243,8 @@ func toV1Symbol(s *domain.Symbol) *v1.Symbol {
 		ComponentTarget: s.ComponentTarget,
 		Version:         s.Version,
 		Data:            data,
+		References:      s.References,
+		Tags:            s.Tags,
 	}
 }
 func toV1SymbolItem(s *domain.Symbol) *v1.SymbolItem {
@@ -116,7 +256,17 @@ func toV1SymbolItem(s *domain.Symbol) *v1.SymbolItem {
 		ClassName:       s.ClassName,
 		ComponentTarget: s.ComponentTarget,
 		Version:         s.Version,
+		Tags:            s.Tags,
+	}
+}
+
+// toV1SymbolItems transforms domain symbols to proto list items
+func toV1SymbolItems(symbols []*domain.Symbol) []*v1.SymbolItem {
+	items := make([]*v1.SymbolItem, 0, len(symbols))
+	for _, symbol := range symbols {
+		items = append(items, toV1SymbolItem(symbol))
 	}
+	return items
 }
 
 // toV1PaginationMeta transforms domain pagination metadata to proto metadata
@@ -134,7 +284,84 @@ func toV1PaginationMeta(meta *pagination.Meta) *v1.PaginationMeta {
 	}
 }
 
-func toServiceError(err error) *errors.Error {
+// NewListAuditEventsOptions transforms proto request to domain audit list options with defaults.
+func NewListAuditEventsOptions(in *v1.ListAuditEventsRequest) domain.ListAuditEventsOptions {
+	limit := in.Limit
+
+	// Default limit to 20 if not provided or zero
+	if limit == 0 {
+		limit = 20
+	}
+
+	filter := domain.AuditFilter{
+		ProjectID: in.ProjectId,
+		SymbolID:  in.SymbolId,
+		Actor:     in.Actor,
+	}
+
+	if op, ok := auditOperationFromV1[in.Operation]; ok {
+		filter.Operation = &op
+	}
+	if in.Since != nil {
+		since := in.Since.AsTime()
+		filter.Since = &since
+	}
+	if in.Until != nil {
+		until := in.Until.AsTime()
+		filter.Until = &until
+	}
+
+	return domain.ListAuditEventsOptions{
+		Filter: filter,
+		Pagination: pagination.OffsetPaginationParams{
+			Offset: in.Offset,
+			Limit:  limit,
+		},
+	}
+}
+
+var auditOperationFromV1 = map[v1.AuditOperation]domain.AuditOperation{
+	v1.AuditOperation_AUDIT_OPERATION_CREATE: domain.AuditOperationCreate,
+	v1.AuditOperation_AUDIT_OPERATION_UPDATE: domain.AuditOperationUpdate,
+	v1.AuditOperation_AUDIT_OPERATION_DELETE: domain.AuditOperationDelete,
+}
+
+var auditOperationToV1 = map[domain.AuditOperation]v1.AuditOperation{
+	domain.AuditOperationCreate: v1.AuditOperation_AUDIT_OPERATION_CREATE,
+	domain.AuditOperationUpdate: v1.AuditOperation_AUDIT_OPERATION_UPDATE,
+	domain.AuditOperationDelete: v1.AuditOperation_AUDIT_OPERATION_DELETE,
+}
+
+func toV1FieldChanges(changes []domain.FieldChange) []*v1.FieldChange {
+	result := make([]*v1.FieldChange, 0, len(changes))
+	for _, c := range changes {
+		result = append(result, &v1.FieldChange{
+			Field:    c.Field,
+			OldValue: c.OldValue,
+			NewValue: c.NewValue,
+		})
+	}
+	return result
+}
+
+func toV1AuditEvent(e *domain.AuditEvent) *v1.AuditEvent {
+	return &v1.AuditEvent{
+		Id:        e.ID,
+		ProjectId: e.ProjectID,
+		SymbolId:  e.SymbolID,
+		Operation: auditOperationToV1[e.Operation],
+		Actor:     e.Actor,
+		RequestId: e.RequestID,
+		ClientIp:  e.ClientIP,
+		Changes:   toV1FieldChanges(e.Changes),
+		CreatedAt: timestamppb.New(e.CreatedAt),
+	}
+}
+
+// toServiceError maps domain errors to transport errors.
+// Errors without an HTTP equivalent are returned as gRPC statuses carrying the reason,
+// which Kratos converts for HTTP clients as well.
+func toServiceError(err error) error {
 	if err == nil {
 		return nil
 	}
@@ -146,6 +373,12 @@ func toServiceError(err error) *errors.Error {
 			"symbol not found",
 		)
 
+	case errors.Is(err, domain.ErrRevisionNotFound):
+		return errors.NotFound(
+			v1.ErrorReason_REVISION_NOT_FOUND.String(),
+			err.Error(),
+		)
+
 	case errors.Is(err, domain.ErrInvalidID):
 		return errors.BadRequest(
 			v1.ErrorReason_INVALID_ID.String(),
@@ -164,6 +397,33 @@ func toServiceError(err error) *errors.Error {
 			fmt.Sprintf("validation failed: %v", err),
 		)
 
+	case errors.Is(err, domain.ErrSymbolHasDependents):
+		return failedPrecondition(v1.ErrorReason_SYMBOL_HAS_DEPENDENTS, err.Error())
+
+	case errors.Is(err, domain.ErrSymbolLocked):
+		return failedPrecondition(v1.ErrorReason_SYMBOL_LOCKED, err.Error())
+
+	case errors.Is(err, domain.ErrLockNotHeld):
+		return failedPrecondition(v1.ErrorReason_LOCK_NOT_HELD, err.Error())
+
+	case errors.Is(err, domain.ErrLockForbidden):
+		return errors.Forbidden(
+			v1.ErrorReason_LOCK_FORBIDDEN.String(),
+			err.Error(),
+		)
+
+	case errors.Is(err, domain.ErrInvalidReference):
+		return errors.BadRequest(
+			v1.ErrorReason_INVALID_REFERENCE.String(),
+			err.Error(),
+		)
+
+	case errors.Is(err, domain.ErrReferenceCycle):
+		return errors.BadRequest(
+			v1.ErrorReason_REFERENCE_CYCLE.String(),
+			err.Error(),
+		)
+
 	case errors.Is(err, domain.ErrDatabaseOperation):
 		return errors.InternalServer(
 			v1.ErrorReason_DATABASE_ERROR.String(),
@@ -179,3 +439,21 @@ func toServiceError(err error) *errors.Error {
 	}
 
 }
+
+// failedPrecondition builds a FAILED_PRECONDITION status carrying the error reason.
+// Kratos errors have no such code, so the gRPC status is returned directly.
+func failedPrecondition(reason v1.ErrorReason, message string) error {
+	st, _ := status.New(codes.FailedPrecondition, message).
+		WithDetails(&errdetails.ErrorInfo{Reason: reason.String()})
+	return st.Err()
+}
+
+func toV1SymbolLock(l *domain.SymbolLock) *v1.SymbolLock {
+	return &v1.SymbolLock{
+		SymbolId:   l.SymbolID,
+		Holder:     l.Holder,
+		SessionId:  l.SessionID,
+		AcquiredAt: timestamppb.New(l.AcquiredAt),
+		ExpiresAt:  timestamppb.New(l.ExpiresAt),
+	}
+}
@@ -2,12 +2,18 @@ package service
 
 import (
 	v1 "contracts/gen/service/symbols/v1"
+	"fmt"
 	"platform/pagination"
 	"testing"
+	"time"
 
 	"symbols/internal/biz/domain"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func Test_toBizSymbol(t *testing.T) {
@@ -430,3 +436,187 @@ func Test_toBizListSymbolsOptions(t *testing.T) {
 		})
 	}
 }
+
+func Test_NewListAuditEventsOptions(t *testing.T) {
+	symbolID := uint64(9)
+	actor := "user-42"
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		in   *v1.ListAuditEventsRequest
+		want domain.ListAuditEventsOptions
+	}{
+		{
+			name: "defaults",
+			in:   &v1.ListAuditEventsRequest{ProjectId: 1},
+			want: domain.ListAuditEventsOptions{
+				Filter:     domain.AuditFilter{ProjectID: 1},
+				Pagination: pagination.OffsetPaginationParams{Offset: 0, Limit: 20},
+			},
+		},
+		{
+			name: "all filters",
+			in: &v1.ListAuditEventsRequest{
+				ProjectId: 1,
+				Offset:    5,
+				Limit:     50,
+				SymbolId:  &symbolID,
+				Actor:     &actor,
+				Operation: v1.AuditOperation_AUDIT_OPERATION_DELETE,
+				Since:     timestamppb.New(since),
+			},
+			want: domain.ListAuditEventsOptions{
+				Filter: domain.AuditFilter{
+					ProjectID: 1,
+					SymbolID:  &symbolID,
+					Actor:     &actor,
+					Operation: func() *domain.AuditOperation { op := domain.AuditOperationDelete; return &op }(),
+					Since:     &since,
+				},
+				Pagination: pagination.OffsetPaginationParams{Offset: 5, Limit: 50},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewListAuditEventsOptions(tt.in))
+		})
+	}
+}
+
+func Test_toServiceError(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantCode   int
+		wantReason v1.ErrorReason
+	}{
+		{
+			name:       "not found",
+			err:        domain.ErrSymbolNotFound,
+			wantCode:   404,
+			wantReason: v1.ErrorReason_SYMBOL_NOT_FOUND,
+		},
+		{
+			name:       "revision not found",
+			err:        fmt.Errorf("%w: symbol 1 has no revision 9", domain.ErrRevisionNotFound),
+			wantCode:   404,
+			wantReason: v1.ErrorReason_REVISION_NOT_FOUND,
+		},
+		{
+			name:       "invalid reference",
+			err:        fmt.Errorf("%w: symbol x not found", domain.ErrInvalidReference),
+			wantCode:   400,
+			wantReason: v1.ErrorReason_INVALID_REFERENCE,
+		},
+		{
+			name:       "reference cycle",
+			err:        domain.ErrReferenceCycle,
+			wantCode:   400,
+			wantReason: v1.ErrorReason_REFERENCE_CYCLE,
+		},
+		{
+			name:       "has dependents",
+			err:        fmt.Errorf("%w: referenced by 2 symbol(s)", domain.ErrSymbolHasDependents),
+			wantCode:   400,
+			wantReason: v1.ErrorReason_SYMBOL_HAS_DEPENDENTS,
+		},
+		{
+			name:       "locked",
+			err:        fmt.Errorf("%w: held by user-1", domain.ErrSymbolLocked),
+			wantCode:   400,
+			wantReason: v1.ErrorReason_SYMBOL_LOCKED,
+		},
+		{
+			name:       "lock not held",
+			err:        domain.ErrLockNotHeld,
+			wantCode:   400,
+			wantReason: v1.ErrorReason_LOCK_NOT_HELD,
+		},
+		{
+			name:       "lock forbidden",
+			err:        domain.ErrLockForbidden,
+			wantCode:   403,
+			wantReason: v1.ErrorReason_LOCK_FORBIDDEN,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			se := errors.FromError(toServiceError(tt.err))
+
+			assert.Equal(t, int32(tt.wantCode), se.Code)
+			assert.Equal(t, tt.wantReason.String(), se.Reason)
+		})
+	}
+
+	t.Run("has dependents is a failed precondition over grpc", func(t *testing.T) {
+		st, ok := status.FromError(toServiceError(domain.ErrSymbolHasDependents))
+
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+	})
+
+	t.Run("locked is a failed precondition over grpc", func(t *testing.T) {
+		st, ok := status.FromError(toServiceError(domain.ErrSymbolLocked))
+
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.NoError(t, toServiceError(nil))
+	})
+}
+
+func Test_NewListSymbolsOptions_TagFilters(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       *v1.ListSymbolsRequest
+		wantTags    map[string]string
+		wantTagKeys []string
+		wantTagIn   map[string][]string
+	}{
+		{
+			name:  "no tag filters",
+			input: &v1.ListSymbolsRequest{ProjectId: 1},
+		},
+		{
+			name: "equality",
+			input: &v1.ListSymbolsRequest{
+				ProjectId: 1,
+				Tags:      []string{"theme=dark", "author=jane=doe"},
+			},
+			wantTags: map[string]string{"theme": "dark", "author": "jane=doe"},
+		},
+		{
+			name: "existence",
+			input: &v1.ListSymbolsRequest{
+				ProjectId: 1,
+				TagKeys:   []string{"category"},
+				Tags:      []string{"theme"},
+			},
+			wantTagKeys: []string{"category", "theme"},
+		},
+		{
+			name: "in",
+			input: &v1.ListSymbolsRequest{
+				ProjectId: 1,
+				TagIn:     []string{"category=header,footer"},
+			},
+			wantTagIn: map[string][]string{"category": {"header", "footer"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := NewListSymbolsOptions(tt.input).Filter
+
+			assert.Equal(t, tt.wantTags, filter.Tags)
+			assert.Equal(t, tt.wantTagKeys, filter.TagKeys)
+			assert.Equal(t, tt.wantTagIn, filter.TagIn)
+		})
+	}
+}
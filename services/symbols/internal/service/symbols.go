@@ -65,8 +65,120 @@ func (s *SymbolService) UpdateSymbol(ctx context.Context, in *v1.UpdateSymbolReq
 	return &v1.UpdateSymbolResponse{Symbol: toV1Symbol(g)}, nil
 }
 func (s *SymbolService) DeleteSymbol(ctx context.Context, in *v1.DeleteSymbolRequest) (*v1.DeleteSymbolResponse, error) {
-	if err := s.uc.DeleteSymbol(ctx, in.Id); err != nil {
+	if err := s.uc.DeleteSymbol(ctx, in.Id, in.Cascade); err != nil {
 		return nil, toServiceError(err)
 	}
 	return &v1.DeleteSymbolResponse{Success: true}, nil
 }
+func (s *SymbolService) ListAuditEvents(ctx context.Context, in *v1.ListAuditEventsRequest) (*v1.ListAuditEventsResponse, error) {
+	opts := NewListAuditEventsOptions(in)
+
+	events, meta, err := s.uc.ListAuditEvents(ctx, opts)
+	if err != nil {
+		return nil, toServiceError(err)
+	}
+
+	result := make([]*v1.AuditEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, toV1AuditEvent(event))
+	}
+
+	return &v1.ListAuditEventsResponse{
+		Events:     result,
+		Pagination: toV1PaginationMeta(meta),
+	}, nil
+}
+
+func (s *SymbolService) ListSymbolDependents(ctx context.Context, in *v1.ListSymbolDependentsRequest) (*v1.ListSymbolDependentsResponse, error) {
+	symbols, err := s.uc.ListSymbolDependents(ctx, in.Id)
+	if err != nil {
+		return nil, toServiceError(err)
+	}
+
+	return &v1.ListSymbolDependentsResponse{Symbols: toV1SymbolItems(symbols)}, nil
+}
+
+func (s *SymbolService) ListSymbolDependencies(ctx context.Context, in *v1.ListSymbolDependenciesRequest) (*v1.ListSymbolDependenciesResponse, error) {
+	symbols, err := s.uc.ListSymbolDependencies(ctx, in.Id)
+	if err != nil {
+		return nil, toServiceError(err)
+	}
+
+	return &v1.ListSymbolDependenciesResponse{Symbols: toV1SymbolItems(symbols)}, nil
+}
+
+func (s *SymbolService) ListTagKeys(ctx context.Context, in *v1.ListTagKeysRequest) (*v1.ListTagKeysResponse, error) {
+	facets, err := s.uc.ListTagKeys(ctx, in.ProjectId)
+	if err != nil {
+		return nil, toServiceError(err)
+	}
+
+	return &v1.ListTagKeysResponse{Facets: toV1TagFacets(facets)}, nil
+}
+
+func (s *SymbolService) ListTagValues(ctx context.Context, in *v1.ListTagValuesRequest) (*v1.ListTagValuesResponse, error) {
+	facets, err := s.uc.ListTagValues(ctx, in.ProjectId, in.Key)
+	if err != nil {
+		return nil, toServiceError(err)
+	}
+
+	return &v1.ListTagValuesResponse{Facets: toV1TagFacets(facets)}, nil
+}
+
+func (s *SymbolService) ListSymbolRevisions(ctx context.Context, in *v1.ListSymbolRevisionsRequest) (*v1.ListSymbolRevisionsResponse, error) {
+	revisions, err := s.uc.ListSymbolRevisions(ctx, in.Id)
+	if err != nil {
+		return nil, toServiceError(err)
+	}
+
+	return &v1.ListSymbolRevisionsResponse{Revisions: toV1SymbolRevisions(revisions)}, nil
+}
+
+func (s *SymbolService) DiffSymbol(ctx context.Context, in *v1.DiffSymbolRequest) (*v1.DiffSymbolResponse, error) {
+	diff, err := s.uc.DiffSymbol(ctx, in.Id, in.BaseRevision, DiffTargetFromRequest(in))
+	if err != nil {
+		return nil, toServiceError(err)
+	}
+
+	resp, err := toV1DiffSymbolResponse(diff)
+	if err != nil {
+		return nil, toServiceError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *SymbolService) GetProjectSymbolStats(ctx context.Context, in *v1.GetProjectSymbolStatsRequest) (*v1.GetProjectSymbolStatsResponse, error) {
+	stats, err := s.uc.GetProjectSymbolStats(ctx, in.ProjectId)
+	if err != nil {
+		return nil, toServiceError(err)
+	}
+
+	return &v1.GetProjectSymbolStatsResponse{Stats: toV1ProjectSymbolStats(stats)}, nil
+}
+
+func (s *SymbolService) AcquireSymbolLock(ctx context.Context, in *v1.AcquireSymbolLockRequest) (*v1.AcquireSymbolLockResponse, error) {
+	lock, err := s.uc.AcquireSymbolLock(ctx, in.Id, in.Ttl.AsDuration())
+	if err != nil {
+		return nil, toServiceError(err)
+	}
+
+	return &v1.AcquireSymbolLockResponse{Lock: toV1SymbolLock(lock)}, nil
+}
+
+func (s *SymbolService) RenewSymbolLock(ctx context.Context, in *v1.RenewSymbolLockRequest) (*v1.RenewSymbolLockResponse, error) {
+	lock, err := s.uc.RenewSymbolLock(ctx, in.Id, in.Ttl.AsDuration())
+	if err != nil {
+		return nil, toServiceError(err)
+	}
+
+	return &v1.RenewSymbolLockResponse{Lock: toV1SymbolLock(lock)}, nil
+}
+
+func (s *SymbolService) ReleaseSymbolLock(ctx context.Context, in *v1.ReleaseSymbolLockRequest) (*v1.ReleaseSymbolLockResponse, error) {
+	if err := s.uc.ReleaseSymbolLock(ctx, in.Id, in.Force); err != nil {
+		return nil, toServiceError(err)
+	}
+
+	return &v1.ReleaseSymbolLockResponse{Success: true}, nil
+}
@@ -7,9 +7,11 @@ import (
 	"platform/pagination"
 	"symbols/internal/biz/domain"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 type mockSymbolUseCase struct {
@@ -40,11 +42,27 @@ func (uc *mockSymbolUseCase) UpdateSymbol(ctx context.Context, g *domain.Symbol)
 	return args.Get(0).(*domain.Symbol), args.Error(1)
 }
 
-func (uc *mockSymbolUseCase) DeleteSymbol(ctx context.Context, id uint64) error {
-	args := uc.Called(ctx, id)
+func (uc *mockSymbolUseCase) DeleteSymbol(ctx context.Context, id uint64, cascade bool) error {
+	args := uc.Called(ctx, id, cascade)
 	return args.Error(0)
 }
 
+func (uc *mockSymbolUseCase) ListSymbolDependents(ctx context.Context, id uint64) ([]*domain.Symbol, error) {
+	args := uc.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Symbol), args.Error(1)
+}
+
+func (uc *mockSymbolUseCase) ListSymbolDependencies(ctx context.Context, id uint64) ([]*domain.Symbol, error) {
+	args := uc.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Symbol), args.Error(1)
+}
+
 func (uc *mockSymbolUseCase) ListSymbols(ctx context.Context, opts domain.ListSymbolsOptions) ([]*domain.Symbol, *pagination.Meta, error) {
 	args := uc.Called(ctx, opts)
 	if args.Get(0) == nil {
@@ -56,6 +74,83 @@ func (uc *mockSymbolUseCase) ListSymbols(ctx context.Context, opts domain.ListSy
 	return args.Get(0).([]*domain.Symbol), args.Get(1).(*pagination.Meta), args.Error(2)
 }
 
+func (uc *mockSymbolUseCase) AcquireSymbolLock(ctx context.Context, id uint64, ttl time.Duration) (*domain.SymbolLock, error) {
+	args := uc.Called(ctx, id, ttl)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SymbolLock), args.Error(1)
+}
+
+func (uc *mockSymbolUseCase) RenewSymbolLock(ctx context.Context, id uint64, ttl time.Duration) (*domain.SymbolLock, error) {
+	args := uc.Called(ctx, id, ttl)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SymbolLock), args.Error(1)
+}
+
+func (uc *mockSymbolUseCase) ReleaseSymbolLock(ctx context.Context, id uint64, force bool) error {
+	args := uc.Called(ctx, id, force)
+	return args.Error(0)
+}
+
+func (uc *mockSymbolUseCase) ListTagKeys(ctx context.Context, projectID uint64) ([]*domain.TagFacet, error) {
+	args := uc.Called(ctx, projectID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TagFacet), args.Error(1)
+}
+
+func (uc *mockSymbolUseCase) ListTagValues(ctx context.Context, projectID uint64, key string) ([]*domain.TagFacet, error) {
+	args := uc.Called(ctx, projectID, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TagFacet), args.Error(1)
+}
+
+func (uc *mockSymbolUseCase) ListSymbolRevisions(ctx context.Context, id uint64) ([]*domain.SymbolRevision, error) {
+	args := uc.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.SymbolRevision), args.Error(1)
+}
+
+func (uc *mockSymbolUseCase) DiffSymbol(ctx context.Context, id uint64, baseRevision uint32, target domain.DiffTarget) (*domain.SymbolDiff, error) {
+	args := uc.Called(ctx, id, baseRevision, target)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SymbolDiff), args.Error(1)
+}
+
+func (uc *mockSymbolUseCase) GetProjectSymbolStats(ctx context.Context, projectID uint64) (*domain.ProjectSymbolStats, error) {
+	args := uc.Called(ctx, projectID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ProjectSymbolStats), args.Error(1)
+}
+
+func (uc *mockSymbolUseCase) RefreshProjectSymbolStats(ctx context.Context, projectID uint64) error {
+	args := uc.Called(ctx, projectID)
+	return args.Error(0)
+}
+
+func (uc *mockSymbolUseCase) ListAuditEvents(ctx context.Context, opts domain.ListAuditEventsOptions) ([]*domain.AuditEvent, *pagination.Meta, error) {
+	args := uc.Called(ctx, opts)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	if args.Get(1) == nil {
+		return args.Get(0).([]*domain.AuditEvent), nil, args.Error(2)
+	}
+	return args.Get(0).([]*domain.AuditEvent), args.Get(1).(*pagination.Meta), args.Error(2)
+}
+
 func TestCreateSymbol(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -600,17 +695,38 @@ func TestDeleteSymbol(t *testing.T) {
 				Id: 1,
 			},
 			mockSetup: func(uc *mockSymbolUseCase, ctx context.Context, req *v1.DeleteSymbolRequest) {
-				uc.On("DeleteSymbol", ctx, req.Id).Return(nil)
+				uc.On("DeleteSymbol", ctx, req.Id, req.Cascade).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "cascade",
+			request: &v1.DeleteSymbolRequest{
+				Id:      1,
+				Cascade: true,
+			},
+			mockSetup: func(uc *mockSymbolUseCase, ctx context.Context, req *v1.DeleteSymbolRequest) {
+				uc.On("DeleteSymbol", ctx, req.Id, true).Return(nil)
 			},
 			wantErr: false,
 		},
+		{
+			name: "has dependents",
+			request: &v1.DeleteSymbolRequest{
+				Id: 1,
+			},
+			mockSetup: func(uc *mockSymbolUseCase, ctx context.Context, req *v1.DeleteSymbolRequest) {
+				uc.On("DeleteSymbol", ctx, req.Id, false).Return(domain.ErrSymbolHasDependents)
+			},
+			wantErr: true,
+		},
 		{
 			name: "not found error",
 			request: &v1.DeleteSymbolRequest{
 				Id: 999,
 			},
 			mockSetup: func(uc *mockSymbolUseCase, ctx context.Context, req *v1.DeleteSymbolRequest) {
-				uc.On("DeleteSymbol", ctx, req.Id).Return(errors.New("symbol not found"))
+				uc.On("DeleteSymbol", ctx, req.Id, req.Cascade).Return(errors.New("symbol not found"))
 			},
 			wantErr: true,
 		},
@@ -620,7 +736,7 @@ func TestDeleteSymbol(t *testing.T) {
 				Id: 1,
 			},
 			mockSetup: func(uc *mockSymbolUseCase, ctx context.Context, req *v1.DeleteSymbolRequest) {
-				uc.On("DeleteSymbol", ctx, req.Id).Return(errors.New("database error"))
+				uc.On("DeleteSymbol", ctx, req.Id, req.Cascade).Return(errors.New("database error"))
 			},
 			wantErr: true,
 		},
@@ -648,3 +764,532 @@ func TestDeleteSymbol(t *testing.T) {
 		})
 	}
 }
+
+func TestListAuditEvents(t *testing.T) {
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		request     *v1.ListAuditEventsRequest
+		mockSetup   func(*mockSymbolUseCase, context.Context, *v1.ListAuditEventsRequest)
+		wantErr     bool
+		checkResult func(*testing.T, *v1.ListAuditEventsResponse)
+	}{
+		{
+			name:    "success",
+			request: &v1.ListAuditEventsRequest{ProjectId: 1, Limit: 10},
+			mockSetup: func(uc *mockSymbolUseCase, ctx context.Context, req *v1.ListAuditEventsRequest) {
+				events := []*domain.AuditEvent{{
+					ID:        5,
+					ProjectID: 1,
+					SymbolID:  9,
+					Operation: domain.AuditOperationUpdate,
+					Actor:     "user-42",
+					RequestID: "req-1",
+					ClientIP:  "203.0.113.5",
+					Changes:   []domain.FieldChange{{Field: "label", OldValue: "a", NewValue: "b"}},
+					CreatedAt: createdAt,
+				}}
+				meta := &pagination.Meta{TotalCount: 1, Limit: 10}
+				uc.On("ListAuditEvents", ctx, NewListAuditEventsOptions(req)).Return(events, meta, nil)
+			},
+			checkResult: func(t *testing.T, resp *v1.ListAuditEventsResponse) {
+				assert.Len(t, resp.Events, 1)
+				e := resp.Events[0]
+				assert.Equal(t, uint64(9), e.SymbolId)
+				assert.Equal(t, v1.AuditOperation_AUDIT_OPERATION_UPDATE, e.Operation)
+				assert.Equal(t, "user-42", e.Actor)
+				assert.Equal(t, "req-1", e.RequestId)
+				assert.Equal(t, "203.0.113.5", e.ClientIp)
+				assert.Equal(t, createdAt, e.CreatedAt.AsTime())
+				assert.Len(t, e.Changes, 1)
+				assert.Equal(t, "label", e.Changes[0].Field)
+				assert.Equal(t, uint64(1), resp.Pagination.TotalCount)
+			},
+		},
+		{
+			name:    "use case error",
+			request: &v1.ListAuditEventsRequest{ProjectId: 1, Limit: 10},
+			mockSetup: func(uc *mockSymbolUseCase, ctx context.Context, req *v1.ListAuditEventsRequest) {
+				uc.On("ListAuditEvents", ctx, NewListAuditEventsOptions(req)).Return(nil, nil, domain.ErrDatabaseOperation)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &mockSymbolUseCase{}
+			service := &SymbolService{uc: uc}
+			ctx := context.Background()
+
+			tt.mockSetup(uc, ctx, tt.request)
+
+			result, err := service.ListAuditEvents(ctx, tt.request)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				if tt.checkResult != nil {
+					tt.checkResult(t, result)
+				}
+			}
+
+			uc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListSymbolDependents(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   *v1.ListSymbolDependentsRequest
+		mockSetup func(*mockSymbolUseCase, context.Context, *v1.ListSymbolDependentsRequest)
+		wantErr   bool
+		wantIDs   []uint64
+	}{
+		{
+			name:    "success",
+			request: &v1.ListSymbolDependentsRequest{Id: 1},
+			mockSetup: func(uc *mockSymbolUseCase, ctx context.Context, req *v1.ListSymbolDependentsRequest) {
+				uc.On("ListSymbolDependents", ctx, req.Id).Return([]*domain.Symbol{{ID: 2}, {ID: 3}}, nil)
+			},
+			wantIDs: []uint64{2, 3},
+		},
+		{
+			name:    "not found error",
+			request: &v1.ListSymbolDependentsRequest{Id: 999},
+			mockSetup: func(uc *mockSymbolUseCase, ctx context.Context, req *v1.ListSymbolDependentsRequest) {
+				uc.On("ListSymbolDependents", ctx, req.Id).Return(nil, domain.ErrSymbolNotFound)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &mockSymbolUseCase{}
+			service := &SymbolService{uc: uc}
+			ctx := context.Background()
+
+			tt.mockSetup(uc, ctx, tt.request)
+
+			result, err := service.ListSymbolDependents(ctx, tt.request)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				ids := make([]uint64, 0, len(result.Symbols))
+				for _, s := range result.Symbols {
+					ids = append(ids, s.Id)
+				}
+				assert.Equal(t, tt.wantIDs, ids)
+			}
+
+			uc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListSymbolDependencies(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   *v1.ListSymbolDependenciesRequest
+		mockSetup func(*mockSymbolUseCase, context.Context, *v1.ListSymbolDependenciesRequest)
+		wantErr   bool
+		wantIDs   []uint64
+	}{
+		{
+			name:    "success",
+			request: &v1.ListSymbolDependenciesRequest{Id: 2},
+			mockSetup: func(uc *mockSymbolUseCase, ctx context.Context, req *v1.ListSymbolDependenciesRequest) {
+				uc.On("ListSymbolDependencies", ctx, req.Id).Return([]*domain.Symbol{{ID: 1}}, nil)
+			},
+			wantIDs: []uint64{1},
+		},
+		{
+			name:    "no dependencies",
+			request: &v1.ListSymbolDependenciesRequest{Id: 1},
+			mockSetup: func(uc *mockSymbolUseCase, ctx context.Context, req *v1.ListSymbolDependenciesRequest) {
+				uc.On("ListSymbolDependencies", ctx, req.Id).Return([]*domain.Symbol{}, nil)
+			},
+			wantIDs: []uint64{},
+		},
+		{
+			name:    "use case error",
+			request: &v1.ListSymbolDependenciesRequest{Id: 1},
+			mockSetup: func(uc *mockSymbolUseCase, ctx context.Context, req *v1.ListSymbolDependenciesRequest) {
+				uc.On("ListSymbolDependencies", ctx, req.Id).Return(nil, domain.ErrDatabaseOperation)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &mockSymbolUseCase{}
+			service := &SymbolService{uc: uc}
+			ctx := context.Background()
+
+			tt.mockSetup(uc, ctx, tt.request)
+
+			result, err := service.ListSymbolDependencies(ctx, tt.request)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				ids := make([]uint64, 0, len(result.Symbols))
+				for _, s := range result.Symbols {
+					ids = append(ids, s.Id)
+				}
+				assert.Equal(t, tt.wantIDs, ids)
+			}
+
+			uc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListTagKeys(t *testing.T) {
+	tests := []struct {
+		name       string
+		request    *v1.ListTagKeysRequest
+		mockSetup  func(*mockSymbolUseCase, context.Context, *v1.ListTagKeysRequest)
+		wantErr    bool
+		wantFacets []*v1.TagFacet
+	}{
+		{
+			name:    "success",
+			request: &v1.ListTagKeysRequest{ProjectId: 1},
+			mockSetup: func(uc *mockSymbolUseCase, ctx context.Context, req *v1.ListTagKeysRequest) {
+				uc.On("ListTagKeys", ctx, req.ProjectId).Return([]*domain.TagFacet{{Value: "author", Count: 2}, {Value: "theme", Count: 5}}, nil)
+			},
+			wantFacets: []*v1.TagFacet{{Value: "author", Count: 2}, {Value: "theme", Count: 5}},
+		},
+		{
+			name:    "use case error",
+			request: &v1.ListTagKeysRequest{ProjectId: 1},
+			mockSetup: func(uc *mockSymbolUseCase, ctx context.Context, req *v1.ListTagKeysRequest) {
+				uc.On("ListTagKeys", ctx, req.ProjectId).Return(nil, domain.ErrDatabaseOperation)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &mockSymbolUseCase{}
+			service := &SymbolService{uc: uc}
+			ctx := context.Background()
+
+			tt.mockSetup(uc, ctx, tt.request)
+
+			result, err := service.ListTagKeys(ctx, tt.request)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantFacets, result.Facets)
+			}
+
+			uc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListTagValues(t *testing.T) {
+	tests := []struct {
+		name       string
+		request    *v1.ListTagValuesRequest
+		mockSetup  func(*mockSymbolUseCase, context.Context, *v1.ListTagValuesRequest)
+		wantErr    bool
+		wantFacets []*v1.TagFacet
+	}{
+		{
+			name:    "success",
+			request: &v1.ListTagValuesRequest{ProjectId: 1, Key: "theme"},
+			mockSetup: func(uc *mockSymbolUseCase, ctx context.Context, req *v1.ListTagValuesRequest) {
+				uc.On("ListTagValues", ctx, req.ProjectId, req.Key).Return([]*domain.TagFacet{{Value: "dark", Count: 3}}, nil)
+			},
+			wantFacets: []*v1.TagFacet{{Value: "dark", Count: 3}},
+		},
+		{
+			name:    "validation error",
+			request: &v1.ListTagValuesRequest{ProjectId: 1},
+			mockSetup: func(uc *mockSymbolUseCase, ctx context.Context, req *v1.ListTagValuesRequest) {
+				uc.On("ListTagValues", ctx, req.ProjectId, req.Key).Return(nil, domain.ErrValidationFailed)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &mockSymbolUseCase{}
+			service := &SymbolService{uc: uc}
+			ctx := context.Background()
+
+			tt.mockSetup(uc, ctx, tt.request)
+
+			result, err := service.ListTagValues(ctx, tt.request)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantFacets, result.Facets)
+			}
+
+			uc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSymbolLocks(t *testing.T) {
+	acquiredAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	lock := &domain.SymbolLock{
+		SymbolID:   1,
+		ProjectID:  1,
+		Holder:     "user-1",
+		SessionID:  "sess-1",
+		AcquiredAt: acquiredAt,
+		ExpiresAt:  acquiredAt.Add(5 * time.Minute),
+	}
+
+	t.Run("acquire", func(t *testing.T) {
+		uc := &mockSymbolUseCase{}
+		service := &SymbolService{uc: uc}
+		ctx := context.Background()
+
+		uc.On("AcquireSymbolLock", ctx, uint64(1), time.Duration(0)).Return(lock, nil)
+
+		resp, err := service.AcquireSymbolLock(ctx, &v1.AcquireSymbolLockRequest{Id: 1})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "user-1", resp.Lock.Holder)
+		assert.Equal(t, "sess-1", resp.Lock.SessionId)
+		assert.Equal(t, acquiredAt.Add(5*time.Minute), resp.Lock.ExpiresAt.AsTime())
+		uc.AssertExpectations(t)
+	})
+
+	t.Run("acquire while locked", func(t *testing.T) {
+		uc := &mockSymbolUseCase{}
+		service := &SymbolService{uc: uc}
+		ctx := context.Background()
+
+		uc.On("AcquireSymbolLock", ctx, uint64(1), time.Duration(0)).Return(nil, domain.ErrSymbolLocked)
+
+		resp, err := service.AcquireSymbolLock(ctx, &v1.AcquireSymbolLockRequest{Id: 1})
+
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("renew", func(t *testing.T) {
+		uc := &mockSymbolUseCase{}
+		service := &SymbolService{uc: uc}
+		ctx := context.Background()
+
+		uc.On("RenewSymbolLock", ctx, uint64(1), time.Minute).Return(lock, nil)
+
+		resp, err := service.RenewSymbolLock(ctx, &v1.RenewSymbolLockRequest{Id: 1, Ttl: durationpb.New(time.Minute)})
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), resp.Lock.SymbolId)
+		uc.AssertExpectations(t)
+	})
+
+	t.Run("release with force", func(t *testing.T) {
+		uc := &mockSymbolUseCase{}
+		service := &SymbolService{uc: uc}
+		ctx := context.Background()
+
+		uc.On("ReleaseSymbolLock", ctx, uint64(1), true).Return(nil)
+
+		resp, err := service.ReleaseSymbolLock(ctx, &v1.ReleaseSymbolLockRequest{Id: 1, Force: true})
+
+		assert.NoError(t, err)
+		assert.True(t, resp.Success)
+		uc.AssertExpectations(t)
+	})
+}
+
+func TestGetProjectSymbolStats(t *testing.T) {
+	computedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		uc := &mockSymbolUseCase{}
+		service := &SymbolService{uc: uc}
+		ctx := context.Background()
+
+		uc.On("GetProjectSymbolStats", ctx, uint64(1)).Return(&domain.ProjectSymbolStats{
+			ProjectID:        1,
+			TotalSymbols:     3,
+			ComponentTargets: map[string]uint64{"Section": 2, "Button": 1},
+			TotalDataBytes:   300,
+			ChangedLastDay:   1,
+			ChangedLastWeek:  2,
+			ComputedAt:       computedAt,
+			Materialized:     true,
+		}, nil)
+
+		result, err := service.GetProjectSymbolStats(ctx, &v1.GetProjectSymbolStatsRequest{ProjectId: 1})
+
+		assert.NoError(t, err)
+		stats := result.Stats
+		assert.Equal(t, uint64(3), stats.TotalSymbols)
+		assert.Equal(t, []*v1.ComponentTargetCount{
+			{ComponentTarget: "Button", Count: 1},
+			{ComponentTarget: "Section", Count: 2},
+		}, stats.ComponentTargets)
+		assert.Equal(t, uint64(300), stats.TotalDataBytes)
+		assert.Equal(t, 100.0, stats.AverageDataBytes)
+		assert.Equal(t, uint64(1), stats.ChangedLastDay)
+		assert.Equal(t, uint64(2), stats.ChangedLastWeek)
+		assert.Equal(t, computedAt, stats.ComputedAt.AsTime())
+		assert.True(t, stats.Materialized)
+	})
+
+	t.Run("use case error", func(t *testing.T) {
+		uc := &mockSymbolUseCase{}
+		service := &SymbolService{uc: uc}
+		ctx := context.Background()
+
+		uc.On("GetProjectSymbolStats", ctx, uint64(1)).Return(nil, domain.ErrDatabaseOperation)
+
+		result, err := service.GetProjectSymbolStats(ctx, &v1.GetProjectSymbolStatsRequest{ProjectId: 1})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestListSymbolRevisions(t *testing.T) {
+	createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	uc := &mockSymbolUseCase{}
+	service := &SymbolService{uc: uc}
+	ctx := context.Background()
+
+	uc.On("ListSymbolRevisions", ctx, uint64(1)).Return([]*domain.SymbolRevision{
+		{Revision: 1, Symbol: &domain.Symbol{Version: 1}, CreatedAt: createdAt},
+		{Revision: 2, Symbol: &domain.Symbol{Version: 3}, CreatedAt: createdAt},
+	}, nil)
+	uc.On("ListSymbolRevisions", ctx, uint64(2)).Return(nil, domain.ErrSymbolNotFound)
+
+	result, err := service.ListSymbolRevisions(ctx, &v1.ListSymbolRevisionsRequest{Id: 1})
+	assert.NoError(t, err)
+	assert.Len(t, result.Revisions, 2)
+	assert.Equal(t, uint32(2), result.Revisions[1].Revision)
+	assert.Equal(t, uint32(3), result.Revisions[1].Version)
+	assert.Equal(t, createdAt, result.Revisions[1].CreatedAt.AsTime())
+
+	_, err = service.ListSymbolRevisions(ctx, &v1.ListSymbolRevisionsRequest{Id: 2})
+	assert.Error(t, err)
+}
+
+func bytesPtr(b []byte) *[]byte {
+	return &b
+}
+
+func TestDiffSymbol(t *testing.T) {
+	tests := []struct {
+		name       string
+		request    *v1.DiffSymbolRequest
+		wantTarget domain.DiffTarget
+		diff       *domain.SymbolDiff
+		ucErr      error
+		wantErr    bool
+		check      func(*testing.T, *v1.DiffSymbolResponse)
+	}{
+		{
+			name:       "json patch",
+			request:    &v1.DiffSymbolRequest{Id: 1, BaseRevision: 2, Target: &v1.DiffSymbolRequest_Revision{Revision: 3}},
+			wantTarget: domain.DiffTarget{Revision: 3},
+			diff: &domain.SymbolDiff{
+				Changes: []domain.FieldChange{{Field: "label", OldValue: "a", NewValue: "b"}},
+				Data: domain.DataDiff{Changed: true, Patch: []domain.JSONPatchOperation{
+					{Op: "replace", Path: "/title", Value: []byte(`"new"`)},
+					{Op: "add", Path: "/items/0", Value: []byte(`{"n":1}`)},
+					{Op: "remove", Path: "/old"},
+				}},
+			},
+			check: func(t *testing.T, resp *v1.DiffSymbolResponse) {
+				assert.Equal(t, []*v1.FieldChange{{Field: "label", OldValue: "a", NewValue: "b"}}, resp.Changes)
+				assert.True(t, resp.Data.Changed)
+				assert.Nil(t, resp.Data.Bytes)
+				assert.Len(t, resp.Data.Patch, 3)
+				assert.Equal(t, "new", resp.Data.Patch[0].Value.GetStringValue())
+				assert.Equal(t, 1.0, resp.Data.Patch[1].Value.GetStructValue().Fields["n"].GetNumberValue())
+				assert.Equal(t, "remove", resp.Data.Patch[2].Op)
+				assert.Nil(t, resp.Data.Patch[2].Value)
+			},
+		},
+		{
+			name:       "byte summary",
+			request:    &v1.DiffSymbolRequest{Id: 1, Target: &v1.DiffSymbolRequest_OtherId{OtherId: 2}},
+			wantTarget: domain.DiffTarget{SymbolID: 2},
+			diff: &domain.SymbolDiff{
+				Data: domain.DataDiff{Changed: true, Bytes: &domain.ByteSummary{OldSize: 3, NewSize: 4, OldSHA256: "a", NewSHA256: "b", CommonPrefix: 1, CommonSuffix: 2}},
+			},
+			check: func(t *testing.T, resp *v1.DiffSymbolResponse) {
+				assert.Empty(t, resp.Changes)
+				assert.Empty(t, resp.Data.Patch)
+				assert.Equal(t, &v1.DataByteSummary{OldSize: 3, NewSize: 4, OldSha256: "a", NewSha256: "b", CommonPrefix: 1, CommonSuffix: 2}, resp.Data.Bytes)
+			},
+		},
+		{
+			name: "proposed payload",
+			request: &v1.DiffSymbolRequest{Id: 1, Target: &v1.DiffSymbolRequest_Proposed{Proposed: &v1.SymbolProposal{
+				Label: "New", Version: 2, Data: []byte(`{}`), Tags: map[string]string{"theme": "dark"},
+			}}},
+			wantTarget: domain.DiffTarget{Proposed: &domain.Symbol{
+				Label: "New", Version: 2, Data: &domain.SymbolData{Data: bytesPtr([]byte(`{}`))}, Tags: map[string]string{"theme": "dark"},
+			}},
+			diff: &domain.SymbolDiff{},
+			check: func(t *testing.T, resp *v1.DiffSymbolResponse) {
+				assert.False(t, resp.Data.Changed)
+			},
+		},
+		{
+			name:       "use case error",
+			request:    &v1.DiffSymbolRequest{Id: 1, Target: &v1.DiffSymbolRequest_Revision{Revision: 9}},
+			wantTarget: domain.DiffTarget{Revision: 9},
+			ucErr:      domain.ErrRevisionNotFound,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &mockSymbolUseCase{}
+			service := &SymbolService{uc: uc}
+			ctx := context.Background()
+
+			if tt.ucErr != nil {
+				uc.On("DiffSymbol", ctx, tt.request.Id, tt.request.BaseRevision, tt.wantTarget).Return(nil, tt.ucErr)
+			} else {
+				uc.On("DiffSymbol", ctx, tt.request.Id, tt.request.BaseRevision, tt.wantTarget).Return(tt.diff, nil)
+			}
+
+			result, err := service.DiffSymbol(ctx, tt.request)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				return
+			}
+			assert.NoError(t, err)
+			tt.check(t, result)
+			uc.AssertExpectations(t)
+		})
+	}
+}
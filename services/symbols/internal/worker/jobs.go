@@ -0,0 +1,141 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"platform/idempotency"
+	"platform/metrics"
+	"platform/scheduler"
+	"slices"
+	"symbols/internal/biz/domain"
+	conf "symbols/internal/conf/gen"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Names of the scheduled jobs, the keys of conf.Data.scheduler.jobs.
+const (
+	JobPurgeDeletedSymbols     = "purge_deleted_symbols"
+	JobCollectOrphanSymbolData = "collect_orphan_symbol_data"
+	JobRefreshProjectStats     = "refresh_project_stats"
+	JobExpireIdempotencyKeys   = "expire_idempotency_keys"
+	JobPurgeAuditEvents        = "purge_audit_events"
+)
+
+const (
+	// defaultDeletedSymbolRetention is how long soft-deleted Symbols are kept when not configured.
+	defaultDeletedSymbolRetention = 30 * 24 * time.Hour
+	// defaultStatsRetention is the age of the refreshed stats when neither the job nor stats.max_staleness set it.
+	defaultStatsRetention = time.Hour
+)
+
+// NewJobs builds the scheduled jobs configured in conf.Data.scheduler.jobs. Jobs whose feature is
+// disabled, like refreshing stats that are not materialized, are left out with a warning.
+func NewJobs(cfg *conf.Data, maintenance domain.MaintenanceUseCase, idempotencyStore idempotency.Store, logger log.Logger) ([]scheduler.Job, error) {
+	l := log.NewHelper(logger)
+
+	configured := cfg.GetScheduler().GetJobs()
+	var jobs []scheduler.Job
+	for _, name := range slices.Sorted(maps.Keys(configured)) {
+		jc := configured[name]
+		if e := jc.GetEnabled(); e != nil && !e.Value {
+			continue
+		}
+
+		schedule, err := scheduler.Parse(jc.GetSchedule())
+		if err != nil {
+			return nil, fmt.Errorf("scheduler job %s: %w", name, err)
+		}
+		job := scheduler.Job{Name: name, Schedule: schedule, Timeout: jc.GetTimeout().AsDuration()}
+		retention := jc.GetRetention().AsDuration()
+
+		switch name {
+		case JobPurgeDeletedSymbols:
+			if retention <= 0 {
+				retention = defaultDeletedSymbolRetention
+			}
+			job.Run = func(ctx context.Context) error {
+				purged, err := maintenance.PurgeDeletedSymbols(ctx, time.Now().Add(-retention))
+				l.WithContext(ctx).Infof("Purged %d symbols deleted more than %s ago", purged, retention)
+				return err
+			}
+		case JobCollectOrphanSymbolData:
+			job.Run = func(ctx context.Context) error {
+				collected, err := maintenance.CollectOrphanSymbolData(ctx)
+				l.WithContext(ctx).Infof("Deleted %d orphan symbol data", collected)
+				return err
+			}
+		case JobRefreshProjectStats:
+			if !cfg.GetStats().GetMaterialized().GetValue() {
+				l.Warnf("Job %s is not scheduled: project stats are not materialized", name)
+				continue
+			}
+			if retention <= 0 {
+				retention = cfg.GetStats().GetMaxStaleness().AsDuration()
+			}
+			if retention <= 0 {
+				retention = defaultStatsRetention
+			}
+			job.Run = func(ctx context.Context) error {
+				refreshed, err := maintenance.RefreshStaleProjectStats(ctx, time.Now().Add(-retention))
+				l.WithContext(ctx).Infof("Refreshed the stats of %d projects", refreshed)
+				return err
+			}
+		case JobExpireIdempotencyKeys:
+			purger, ok := idempotencyStore.(idempotency.Purger)
+			if !ok {
+				l.Warnf("Job %s is not scheduled: idempotency keys are disabled or not stored in the database", name)
+				continue
+			}
+			job.Run = func(ctx context.Context) error {
+				expired, err := purger.Purge(ctx, time.Now())
+				l.WithContext(ctx).Infof("Deleted %d expired idempotency keys", expired)
+				return err
+			}
+		case JobPurgeAuditEvents:
+			if retention <= 0 {
+				retention = cfg.GetAudit().GetRetention().AsDuration()
+			}
+			if retention <= 0 {
+				l.Warnf("Job %s is not scheduled: audit events are kept forever", name)
+				continue
+			}
+			job.Run = func(ctx context.Context) error {
+				purged, err := maintenance.PurgeAuditEvents(ctx, time.Now().Add(-retention))
+				l.WithContext(ctx).Infof("Purged %d audit events older than %s", purged, retention)
+				return err
+			}
+		default:
+			return nil, fmt.Errorf("unknown scheduler job %q", name)
+		}
+
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
+
+// NewScheduler creates the scheduler running jobs in the worker, or returns nil when
+// conf.Data.scheduler is disabled. Runs are claimed through locker, so each runs on one replica.
+func NewScheduler(cfg *conf.Data, locker scheduler.Locker, jobs []scheduler.Job, reg *metrics.Registry, logger log.Logger) (*scheduler.Scheduler, error) {
+	sc := cfg.GetScheduler()
+	if !sc.GetEnabled().GetValue() {
+		return nil, nil
+	}
+
+	// Restarted replicas must not renew the locks of their previous process
+	host, _ := os.Hostname()
+	s := scheduler.New(locker, scheduler.Options{
+		Holder:  host + "-" + watermill.NewShortUUID(),
+		LockTTL: sc.GetLockTtl().AsDuration(),
+	}, reg, logger)
+	if err := s.Register(jobs...); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
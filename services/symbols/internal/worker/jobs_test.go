@@ -0,0 +1,163 @@
+package worker
+
+import (
+	"context"
+	"os"
+	"platform/idempotency"
+	conf "symbols/internal/conf/gen"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// recordingMaintenance records the cutoffs the jobs pass to the maintenance use case.
+type recordingMaintenance struct {
+	purgedBefore      time.Time
+	auditPurgedBefore time.Time
+}
+
+func (m *recordingMaintenance) PurgeDeletedSymbols(_ context.Context, before time.Time) (int64, error) {
+	m.purgedBefore = before
+	return 0, nil
+}
+
+func (m *recordingMaintenance) CollectOrphanSymbolData(context.Context) (int64, error) {
+	return 0, nil
+}
+
+func (m *recordingMaintenance) RefreshStaleProjectStats(context.Context, time.Time) (int, error) {
+	return 0, nil
+}
+
+func (m *recordingMaintenance) PurgeAuditEvents(_ context.Context, before time.Time) (int64, error) {
+	m.auditPurgedBefore = before
+	return 0, nil
+}
+
+// purgingStore is an idempotency store expired by the scheduler.
+type purgingStore struct {
+	*idempotency.MemoryStore
+}
+
+func (purgingStore) Purge(context.Context, time.Time) (int64, error) {
+	return 0, nil
+}
+
+func TestNewJobs(t *testing.T) {
+	job := func(schedule string) *conf.Scheduler_Job {
+		return &conf.Scheduler_Job{Schedule: schedule}
+	}
+
+	tests := []struct {
+		name    string
+		cfg     *conf.Data
+		store   idempotency.Store
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "configured jobs in name order",
+			cfg: &conf.Data{
+				Scheduler: &conf.Scheduler{Jobs: map[string]*conf.Scheduler_Job{
+					JobPurgeDeletedSymbols:     job("@daily"),
+					JobCollectOrphanSymbolData: job("@hourly"),
+					JobRefreshProjectStats:     job("*/5 * * * *"),
+					JobExpireIdempotencyKeys:   job("@every 10m"),
+					JobPurgeAuditEvents:        job("@daily"),
+				}},
+				Stats: &conf.Stats{Materialized: wrapperspb.Bool(true)},
+				Audit: &conf.Audit{Retention: durationpb.New(24 * time.Hour)},
+			},
+			store: purgingStore{idempotency.NewMemoryStore()},
+			want:  []string{JobCollectOrphanSymbolData, JobExpireIdempotencyKeys, JobPurgeAuditEvents, JobPurgeDeletedSymbols, JobRefreshProjectStats},
+		},
+		{
+			name: "jobs of disabled features are left out",
+			cfg: &conf.Data{
+				Scheduler: &conf.Scheduler{Jobs: map[string]*conf.Scheduler_Job{
+					JobPurgeDeletedSymbols:   job("@daily"),
+					JobRefreshProjectStats:   job("*/5 * * * *"),
+					JobExpireIdempotencyKeys: job("@every 10m"),
+					JobPurgeAuditEvents:      job("@daily"),
+				}},
+			},
+			store: idempotency.NewMemoryStore(),
+			want:  []string{JobPurgeDeletedSymbols},
+		},
+		{
+			name: "disabled job",
+			cfg: &conf.Data{
+				Scheduler: &conf.Scheduler{Jobs: map[string]*conf.Scheduler_Job{
+					JobPurgeDeletedSymbols: {Schedule: "@daily", Enabled: wrapperspb.Bool(false)},
+				}},
+			},
+		},
+		{
+			name: "invalid schedule",
+			cfg: &conf.Data{
+				Scheduler: &conf.Scheduler{Jobs: map[string]*conf.Scheduler_Job{JobPurgeDeletedSymbols: job("every day")}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown job",
+			cfg: &conf.Data{
+				Scheduler: &conf.Scheduler{Jobs: map[string]*conf.Scheduler_Job{"vacuum": job("@daily")}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobs, err := NewJobs(tt.cfg, &recordingMaintenance{}, tt.store, log.NewStdLogger(os.Stdout))
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			var names []string
+			for _, j := range jobs {
+				names = append(names, j.Name)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestNewJobs_PurgeRetention(t *testing.T) {
+	maintenance := &recordingMaintenance{}
+	cfg := &conf.Data{Scheduler: &conf.Scheduler{Jobs: map[string]*conf.Scheduler_Job{
+		JobPurgeDeletedSymbols: {Schedule: "@daily"},
+	}}}
+
+	jobs, err := NewJobs(cfg, maintenance, nil, log.NewStdLogger(os.Stdout))
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+
+	require.NoError(t, jobs[0].Run(context.Background()))
+	assert.WithinDuration(t, time.Now().Add(-defaultDeletedSymbolRetention), maintenance.purgedBefore, time.Minute)
+}
+
+func TestNewJobs_AuditRetention(t *testing.T) {
+	maintenance := &recordingMaintenance{}
+	cfg := &conf.Data{
+		Scheduler: &conf.Scheduler{Jobs: map[string]*conf.Scheduler_Job{
+			JobPurgeAuditEvents: {Schedule: "@daily"},
+		}},
+		Audit: &conf.Audit{Retention: durationpb.New(48 * time.Hour)},
+	}
+
+	jobs, err := NewJobs(cfg, maintenance, nil, log.NewStdLogger(os.Stdout))
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+
+	require.NoError(t, jobs[0].Run(context.Background()))
+	assert.WithinDuration(t, time.Now().Add(-48*time.Hour), maintenance.auditPurgedBefore, time.Minute)
+}
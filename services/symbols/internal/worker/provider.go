@@ -5,4 +5,4 @@ import (
 )
 
 // ProviderSet is server providers.
-var ProviderSet = wire.NewSet(NewRouter, NewWorker)
+var ProviderSet = wire.NewSet(NewRouter, NewJobs, NewScheduler, NewWorker)
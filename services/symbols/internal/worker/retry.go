@@ -0,0 +1,56 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"platform/events"
+	"strconv"
+	"symbols/internal/data/mq"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+// DelayedRetry republishes the messages a handler failed to handle to the retry queue of their next delay
+// and acks them, so the retry does not hold a worker slot and survives restarts. The attempts metadata,
+// counted by CountAttempts, travels with the retries: once a message was tried policy(topic).MaxAttempts
+// times, the error is returned for the message to be dead-lettered. The policy is looked up by the topic
+// the handler consumes, shared by all its workers. Handlers whose policy has no delays run through
+// inProcess instead.
+func DelayedRetry(pub mq.RetryPublisher, queue string, policy func(topic string) mq.RetryPolicy, inProcess message.HandlerMiddleware, logger watermill.LoggerAdapter) message.HandlerMiddleware {
+	return func(h message.HandlerFunc) message.HandlerFunc {
+		retried := inProcess(h)
+
+		return func(msg *message.Message) ([]*message.Message, error) {
+			p := policy(message.SubscribeTopicFromCtx(msg.Context()))
+			if !p.Delayed() {
+				return retried(msg)
+			}
+
+			produced, err := h(msg)
+			if err == nil || errors.Is(err, events.ErrPermanent) {
+				return produced, err
+			}
+
+			attempts, _ := strconv.Atoi(msg.Metadata.Get(mq.AttemptsKey))
+			if attempts >= p.MaxAttempts {
+				return nil, err
+			}
+
+			delay := p.Delay(attempts)
+			retryQueue := mq.RetryQueueName(queue, delay)
+			if pubErr := pub.Publish(retryQueue, msg.Copy()); pubErr != nil {
+				// Returned to the poison queue middleware, which dead-letters the message
+				return nil, errors.Join(err, fmt.Errorf("failed to schedule the retry: %w", pubErr))
+			}
+
+			logger.Info("Message retry scheduled", watermill.LogFields{
+				"message_uuid": msg.UUID,
+				"attempts":     attempts,
+				"delay":        delay.String(),
+				"error":        err.Error(),
+			})
+			return nil, nil
+		}
+	}
+}
@@ -2,48 +2,168 @@
 package worker
 
 import (
+	"errors"
 	"platform/events"
+	"platform/inbox"
 	platform_logger "platform/logger"
+	"platform/tracing"
+	"strconv"
 	conf "symbols/internal/conf/gen"
+	"symbols/internal/data/mq"
 	"symbols/internal/handlers"
 	"time"
 
+	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
 	"github.com/ThreeDotsLabs/watermill/message/router/plugin"
+	"go.opentelemetry.io/otel/trace"
 )
 
-func NewRouter(cfg *conf.Data, lifecycleHandler *handlers.LifecycleEventHandler, eventSub events.Subscriber, logger *platform_logger.WatermillLogger) *message.Router {
+// NewRouter builds the worker router from the event handler registry: the typed lifecycle handlers are
+// registered on the lifecycle exchange and every other event goes to fallback.
+func NewRouter(cfg *conf.Data, lifecycleHandler *handlers.LifecycleEventHandler, fallback events.FallbackFunc, eventSub events.Subscriber, deadLetters mq.DeadLetterPublisher, retries mq.RetryPublisher, inboxStore inbox.Store, tp trace.TracerProvider, logger *platform_logger.WatermillLogger) *message.Router {
 
-	router, err := message.NewRouter(message.RouterConfig{}, logger)
+	// Router level middleware is executed for every message sent to the router
+	mw := []message.HandlerMiddleware{
+		// Tracing runs each message in a span continuing the trace of its publisher, across all its retries
+		tracing.Middleware(tp),
 
-	if err != nil {
-		panic(err)
+		// CorrelationID will copy the correlation id from the incoming message's metadata to the produced messages
+		middleware.CorrelationID,
 	}
 
-	// SignalsHandler will gracefully shut down Router when SIGTERM is received.
-	// You can also close the router by just calling `r.Close()`.
-	router.AddPlugin(plugin.SignalsHandler)
+	// PoisonQueue publishes the messages that still fail after the retries to the dead-letter queue
+	// and acks them, so they do not loop forever on the queue.
+	if deadLetters != nil {
+		poisonQueue, err := middleware.PoisonQueue(deadLetters, cfg.Mq.DeadLetter.Queue)
+		if err != nil {
+			panic(err)
+		}
+		mw = append(mw, poisonQueue)
+	} else {
+		// Without a dead-letter queue, permanent failures would be redelivered forever
+		mw = append(mw, dropPermanentFailures(logger))
+	}
 
-	// Router level middleware is executed for every message sent to the router
-	router.AddMiddleware(
-		// CorrelationID will copy the correlation id from the incoming message's metadata to the produced messages
-		middleware.CorrelationID,
+	// The handler function is retried if it returns an error.
+	// After MaxRetries, the message is dead-lettered, or Nacked for the PubSub to resend it when dead-lettering is disabled.
+	retry := middleware.Retry{
+		MaxRetries:      3,
+		InitialInterval: time.Millisecond * 100,
+		// Permanent failures, like undecodable events, fail the same way on every try
+		ShouldRetry: func(params middleware.RetryParams) bool {
+			return !errors.Is(params.Err, events.ErrPermanent)
+		},
+		Logger: logger,
+	}.Middleware
+
+	// With retry queues, the handlers with retry delays wait for their retries on the broker instead
+	if retries != nil {
+		retry = DelayedRetry(retries, cfg.Mq.Queue.GetName(), func(topic string) mq.RetryPolicy {
+			return retryPolicy(cfg.Mq.Queue, topic)
+		}, retry, logger)
+	}
+
+	mw = append(mw,
+		retry,
+
+		// CountAttempts records every try in the message metadata, so dead letters show how often they failed.
+		CountAttempts,
+	)
+
+	// The inbox acks messages the queue consumer already processed. Each try runs the handler in
+	// the transaction recording the message, so it rolls back together with a failed try.
+	if inboxStore != nil {
+		mw = append(mw, inbox.Middleware(inboxStore, cfg.Mq.Queue.GetName(), cfg.Mq.GetInbox().GetTtl().AsDuration(), logger))
+	}
 
-		// The handler function is retried if it returns an error.
-		// After MaxRetries, the message is Nacked, and it's up to the PubSub to resend it.
-		middleware.Retry{
-			MaxRetries:      3,
-			InitialInterval: time.Millisecond * 100,
-			Logger:          logger,
-		}.Middleware,
+	mw = append(mw,
 
 		// Recoverer handles panics from handlers.
 		// In this case, it passes them as errors to the Retry middleware.
 		middleware.Recoverer,
 	)
 
-	router.AddConsumerHandler("events", cfg.Mq.Exchange.Name, eventSub.Unwrap(), lifecycleHandler.Handle)
+	// The AMQP subscriber hands a consumer its next message only after the previous one is acked,
+	// so each worker of a handler subscribes with its own consumer.
+	registry := events.NewRegistry(eventSub.Unwrap(),
+		events.WithTypeResolver(mq.EventType),
+		events.WithFallback(fallback),
+		events.WithWorkers(func(name string) int {
+			// Every gochannel subscription receives every message, so extra workers would duplicate them.
+			// The SQL subscriber consumes a consumer group in offset order, so they would only contend for it.
+			if b := cfg.GetBroker(); b == mq.BrokerGoChannel || b == mq.BrokerSQL {
+				return 1
+			}
+			return workerCount(cfg.Mq.Queue, name)
+		}),
+		// SignalsHandler will gracefully shut down Router when SIGTERM is received.
+		// You can also close the router by just calling `r.Close()`.
+		events.WithPlugins(plugin.SignalsHandler),
+		events.WithMiddleware(mw...),
+	)
+
+	handlers.RegisterSymbolEventHandler(registry, cfg.Mq.Exchange.Name, lifecycleHandler)
+
+	router, err := registry.Router(logger)
+	if err != nil {
+		panic(err)
+	}
 
 	return router
 }
+
+// workerCount returns how many workers run the named handler: its override, the queue worker_count, or 1.
+func workerCount(queue *conf.RabbitMQServer_Queue, name string) int {
+	if override := queue.GetHandlers()[name].GetWorkerCount(); override > 0 {
+		return int(override)
+	}
+	if n := queue.GetWorkerCount(); n > 0 {
+		return int(n)
+	}
+	return 1
+}
+
+// retryPolicy returns the delayed retries of the handler of topic: its override, or the queue retry.
+// Overrides are keyed by topic, as the workers of a handler are named <topic>-<n>.
+func retryPolicy(queue *conf.RabbitMQServer_Queue, topic string) mq.RetryPolicy {
+	r := queue.GetHandlers()[topic].GetRetry()
+	if r == nil {
+		r = queue.GetRetry()
+	}
+
+	var p mq.RetryPolicy
+	for _, d := range r.GetDelays() {
+		p.Delays = append(p.Delays, d.AsDuration())
+	}
+	p.MaxAttempts = int(r.GetMaxAttempts())
+	if p.MaxAttempts == 0 {
+		p.MaxAttempts = len(p.Delays) + 1
+	}
+	return p
+}
+
+// dropPermanentFailures acks the messages that failed permanently, logging the failure.
+func dropPermanentFailures(logger watermill.LoggerAdapter) message.HandlerMiddleware {
+	return func(h message.HandlerFunc) message.HandlerFunc {
+		return func(msg *message.Message) ([]*message.Message, error) {
+			produced, err := h(msg)
+			if errors.Is(err, events.ErrPermanent) {
+				logger.Error("Dropping message that failed permanently", err, watermill.LogFields{"message_uuid": msg.UUID})
+				return produced, nil
+			}
+			return produced, err
+		}
+	}
+}
+
+// CountAttempts increments the attempts metadata of a message each time its handler runs.
+func CountAttempts(h message.HandlerFunc) message.HandlerFunc {
+	return func(msg *message.Message) ([]*message.Message, error) {
+		attempts, _ := strconv.Atoi(msg.Metadata.Get(mq.AttemptsKey))
+		msg.Metadata.Set(mq.AttemptsKey, strconv.Itoa(attempts+1))
+
+		return h(msg)
+	}
+}
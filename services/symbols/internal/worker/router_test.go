@@ -0,0 +1,754 @@
+package worker
+
+import (
+	"context"
+	eventsv1 "contracts/gen/events/symbols/v1"
+	"errors"
+	"os"
+	"platform/inbox"
+	platform_logger "platform/logger"
+	"strconv"
+	"symbols/internal/biz/domain"
+	conf "symbols/internal/conf/gen"
+	"symbols/internal/data"
+	"symbols/internal/data/model"
+	"symbols/internal/data/mq"
+	"symbols/internal/data/repo"
+	"symbols/internal/handlers"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/message/router/middleware"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// failingUseCase fails every stats refresh.
+type failingUseCase struct {
+	domain.SymbolUseCase
+}
+
+func (failingUseCase) RefreshProjectSymbolStats(context.Context, uint64) error {
+	return errors.New("db down")
+}
+
+func TestNewRouter_DeadLettersPoisonMessages(t *testing.T) {
+	logger := log.NewStdLogger(os.Stdout)
+	cfg := &conf.Data{Mq: &conf.RabbitMQServer{
+		Exchange:   &conf.RabbitMQServer_Exchange{Name: "lifecycle_events"},
+		DeadLetter: &conf.RabbitMQServer_DeadLetter{Queue: "lifecycle_event_queue.dlq"},
+	}}
+
+	pubSub := gochannel.NewGoChannel(gochannel.Config{}, watermill.NopLogger{})
+	defer pubSub.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	deadLetters, err := pubSub.Subscribe(ctx, cfg.Mq.DeadLetter.Queue)
+	require.NoError(t, err)
+
+	router := NewRouter(
+		cfg,
+		handlers.NewLifecycleEventHandler(failingUseCase{}, logger),
+		handlers.NewFallbackHandler(cfg, logger),
+		mq.NewEventSubscriber(pubSub, logger),
+		pubSub,
+		nil,
+		nil,
+		nil,
+		platform_logger.NewWatermillLogger(logger),
+	)
+	go func() { _ = router.Run(ctx) }()
+	defer router.Close()
+	<-router.Running()
+
+	payload, err := proto.Marshal(&eventsv1.SymbolCreated{Id: 1, ProjectId: 7})
+	require.NoError(t, err)
+	msg := message.NewMessage(watermill.NewUUID(), payload)
+	mq.SetMessageRoutingKey("symbol.created", msg)
+	require.NoError(t, pubSub.Publish(cfg.Mq.Exchange.Name, msg))
+
+	select {
+	case dl := <-deadLetters:
+		dl.Ack()
+		assert.Equal(t, msg.UUID, dl.UUID)
+		assert.Equal(t, "4", dl.Metadata.Get(mq.AttemptsKey), "one try and three retries")
+		assert.Contains(t, dl.Metadata.Get(middleware.ReasonForPoisonedKey), "db down")
+		assert.Equal(t, cfg.Mq.Exchange.Name, dl.Metadata.Get(middleware.PoisonedTopicKey))
+		assert.Equal(t, "symbol.created", mq.MessageRoutingKey(dl))
+	case <-ctx.Done():
+		t.Fatal("message was not dead-lettered")
+	}
+}
+
+func TestNewRouter_DelayedRetries(t *testing.T) {
+	logger := log.NewStdLogger(os.Stdout)
+	cfg := &conf.Data{Mq: &conf.RabbitMQServer{
+		Exchange: &conf.RabbitMQServer_Exchange{Name: "lifecycle_events"},
+		Queue: &conf.RabbitMQServer_Queue{
+			Name: "lifecycle_event_queue",
+			Retry: &conf.RabbitMQServer_Retry{
+				Delays:      []*durationpb.Duration{durationpb.New(10 * time.Second), durationpb.New(time.Minute)},
+				MaxAttempts: 3,
+			},
+		},
+		DeadLetter: &conf.RabbitMQServer_DeadLetter{Queue: "lifecycle_event_queue.dlq"},
+	}}
+
+	pubSub := gochannel.NewGoChannel(gochannel.Config{}, watermill.NopLogger{})
+	defer pubSub.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	deadLetters, err := pubSub.Subscribe(ctx, cfg.Mq.DeadLetter.Queue)
+	require.NoError(t, err)
+	shortRetries, err := pubSub.Subscribe(ctx, "lifecycle_event_queue.retry.10s")
+	require.NoError(t, err)
+	longRetries, err := pubSub.Subscribe(ctx, "lifecycle_event_queue.retry.1m")
+	require.NoError(t, err)
+
+	router := NewRouter(
+		cfg,
+		handlers.NewLifecycleEventHandler(failingUseCase{}, logger),
+		handlers.NewFallbackHandler(cfg, logger),
+		mq.NewEventSubscriber(pubSub, logger),
+		pubSub,
+		pubSub,
+		nil,
+		nil,
+		platform_logger.NewWatermillLogger(logger),
+	)
+	go func() { _ = router.Run(ctx) }()
+	defer router.Close()
+	<-router.Running()
+
+	payload, err := proto.Marshal(&eventsv1.SymbolCreated{Id: 1, ProjectId: 7})
+	require.NoError(t, err)
+	msg := message.NewMessage(watermill.NewUUID(), payload)
+	mq.SetMessageRoutingKey("symbol.created", msg)
+	require.NoError(t, pubSub.Publish(cfg.Mq.Exchange.Name, msg))
+
+	// Each retry waits in the queue of its delay; the broker would then dead-letter it back to the queue
+	for i, retries := range []<-chan *message.Message{shortRetries, longRetries} {
+		select {
+		case retry := <-retries:
+			retry.Ack()
+			assert.Equal(t, msg.UUID, retry.UUID)
+			assert.Equal(t, strconv.Itoa(i+1), retry.Metadata.Get(mq.AttemptsKey), "a single try per delivery")
+			assert.Equal(t, "symbol.created", mq.MessageRoutingKey(retry))
+			require.NoError(t, pubSub.Publish(cfg.Mq.Exchange.Name, retry.Copy()))
+		case <-ctx.Done():
+			t.Fatalf("retry %d was not scheduled", i+1)
+		}
+	}
+
+	select {
+	case dl := <-deadLetters:
+		dl.Ack()
+		assert.Equal(t, msg.UUID, dl.UUID)
+		assert.Equal(t, "3", dl.Metadata.Get(mq.AttemptsKey), "dead-lettered after max_attempts tries")
+		assert.Contains(t, dl.Metadata.Get(middleware.ReasonForPoisonedKey), "db down")
+	case <-ctx.Done():
+		t.Fatal("message was not dead-lettered")
+	}
+}
+
+func TestNewRouter_DelayedRetriesHandlerOverride(t *testing.T) {
+	logger := log.NewStdLogger(os.Stdout)
+	cfg := &conf.Data{Mq: &conf.RabbitMQServer{
+		Exchange: &conf.RabbitMQServer_Exchange{Name: "lifecycle_events"},
+		Queue: &conf.RabbitMQServer_Queue{
+			Name:        "lifecycle_event_queue",
+			WorkerCount: 3,
+			Retry: &conf.RabbitMQServer_Retry{
+				Delays:      []*durationpb.Duration{durationpb.New(10 * time.Second), durationpb.New(time.Minute)},
+				MaxAttempts: 3,
+			},
+			Handlers: map[string]*conf.RabbitMQServer_Queue_Handler{
+				"lifecycle_events": {Retry: &conf.RabbitMQServer_Retry{
+					Delays:      []*durationpb.Duration{durationpb.New(5 * time.Minute)},
+					MaxAttempts: 2,
+				}},
+			},
+		},
+		DeadLetter: &conf.RabbitMQServer_DeadLetter{Queue: "lifecycle_event_queue.dlq"},
+	}}
+	sub := newQueueSubscriber()
+
+	pubSub := gochannel.NewGoChannel(gochannel.Config{}, watermill.NopLogger{})
+	defer pubSub.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	deadLetters, err := pubSub.Subscribe(ctx, cfg.Mq.DeadLetter.Queue)
+	require.NoError(t, err)
+	queueRetries, err := pubSub.Subscribe(ctx, "lifecycle_event_queue.retry.10s")
+	require.NoError(t, err)
+	handlerRetries, err := pubSub.Subscribe(ctx, "lifecycle_event_queue.retry.5m")
+	require.NoError(t, err)
+
+	router := NewRouter(
+		cfg,
+		handlers.NewLifecycleEventHandler(failingUseCase{}, logger),
+		handlers.NewFallbackHandler(cfg, logger),
+		mq.NewEventSubscriber(sub, logger),
+		pubSub,
+		pubSub,
+		nil,
+		nil,
+		platform_logger.NewWatermillLogger(logger),
+	)
+	go func() { _ = router.Run(ctx) }()
+	defer router.Close()
+	<-router.Running()
+
+	require.Equal(t, int32(3), sub.subscriptions.Load(), "workers named lifecycle_events-<n>")
+
+	payload, err := proto.Marshal(&eventsv1.SymbolCreated{Id: 1, ProjectId: 7})
+	require.NoError(t, err)
+	msg := message.NewMessage(watermill.NewUUID(), payload)
+	mq.SetMessageRoutingKey("symbol.created", msg)
+	sub.queue <- msg
+
+	select {
+	case retry := <-handlerRetries:
+		retry.Ack()
+		assert.Equal(t, msg.UUID, retry.UUID)
+		assert.Equal(t, "1", retry.Metadata.Get(mq.AttemptsKey))
+		sub.queue <- retry.Copy()
+	case retry := <-queueRetries:
+		retry.Ack()
+		t.Fatal("the queue retry was used instead of the handler override")
+	case <-ctx.Done():
+		t.Fatal("retry was not scheduled")
+	}
+
+	select {
+	case dl := <-deadLetters:
+		dl.Ack()
+		assert.Equal(t, msg.UUID, dl.UUID)
+		assert.Equal(t, "2", dl.Metadata.Get(mq.AttemptsKey), "dead-lettered after the handler max_attempts")
+	case <-ctx.Done():
+		t.Fatal("message was not dead-lettered")
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	queue := &conf.RabbitMQServer_Queue{
+		Retry: &conf.RabbitMQServer_Retry{Delays: []*durationpb.Duration{durationpb.New(10 * time.Second)}},
+		Handlers: map[string]*conf.RabbitMQServer_Queue_Handler{
+			"slow": {Retry: &conf.RabbitMQServer_Retry{
+				Delays:      []*durationpb.Duration{durationpb.New(time.Minute), durationpb.New(10 * time.Minute)},
+				MaxAttempts: 5,
+			}},
+			"in_process": {Retry: &conf.RabbitMQServer_Retry{}},
+			"concurrent": {WorkerCount: 2},
+		},
+	}
+
+	tests := []struct {
+		handler string
+		want    mq.RetryPolicy
+	}{
+		{handler: "slow", want: mq.RetryPolicy{Delays: []time.Duration{time.Minute, 10 * time.Minute}, MaxAttempts: 5}},
+		{handler: "concurrent", want: mq.RetryPolicy{Delays: []time.Duration{10 * time.Second}, MaxAttempts: 2}},
+		{handler: "other", want: mq.RetryPolicy{Delays: []time.Duration{10 * time.Second}, MaxAttempts: 2}},
+		{handler: "in_process", want: mq.RetryPolicy{MaxAttempts: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.handler, func(t *testing.T) {
+			assert.Equal(t, tt.want, retryPolicy(queue, tt.handler))
+		})
+	}
+	assert.False(t, retryPolicy(nil, "any").Delayed())
+}
+
+// queueSubscriber is an in-memory stand-in for an AMQP queue with competing consumers: every
+// subscription takes the next message off the shared queue only after its previous one was acked.
+type queueSubscriber struct {
+	queue         chan *message.Message
+	subscriptions atomic.Int32
+	acked         atomic.Int32
+}
+
+func newQueueSubscriber() *queueSubscriber {
+	return &queueSubscriber{queue: make(chan *message.Message, 100)}
+}
+
+func (s *queueSubscriber) Subscribe(ctx context.Context, _ string) (<-chan *message.Message, error) {
+	s.subscriptions.Add(1)
+	out := make(chan *message.Message)
+
+	go func() {
+		defer close(out)
+		for {
+			var msg *message.Message
+			select {
+			case msg = <-s.queue:
+			case <-ctx.Done():
+				return
+			}
+
+			msg = msg.Copy()
+			select {
+			case out <- msg:
+			case <-ctx.Done():
+				return
+			}
+
+			select {
+			case <-msg.Acked():
+				s.acked.Add(1)
+			case <-msg.Nacked():
+				s.queue <- msg
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func (s *queueSubscriber) Close() error { return nil }
+
+// blockingUseCase holds every stats refresh until released and tracks how many run at once.
+type blockingUseCase struct {
+	domain.SymbolUseCase
+	release     chan struct{}
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+	done        atomic.Int32
+}
+
+func (uc *blockingUseCase) RefreshProjectSymbolStats(context.Context, uint64) error {
+	n := uc.inFlight.Add(1)
+	for {
+		m := uc.maxInFlight.Load()
+		if n <= m || uc.maxInFlight.CompareAndSwap(m, n) {
+			break
+		}
+	}
+
+	<-uc.release
+	uc.inFlight.Add(-1)
+	uc.done.Add(1)
+	return nil
+}
+
+func TestNewRouter_WorkerCount(t *testing.T) {
+	tests := []struct {
+		name     string
+		queue    *conf.RabbitMQServer_Queue
+		wantConc int32
+	}{
+		{
+			name:     "queue worker count",
+			queue:    &conf.RabbitMQServer_Queue{WorkerCount: 3},
+			wantConc: 3,
+		},
+		{
+			name: "handler override",
+			queue: &conf.RabbitMQServer_Queue{
+				WorkerCount: 3,
+				Handlers:    map[string]*conf.RabbitMQServer_Queue_Handler{"lifecycle_events": {WorkerCount: 2}},
+			},
+			wantConc: 2,
+		},
+		{
+			name:     "sequential by default",
+			queue:    &conf.RabbitMQServer_Queue{},
+			wantConc: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			const messages = 6
+			logger := log.NewStdLogger(os.Stdout)
+			cfg := &conf.Data{Mq: &conf.RabbitMQServer{
+				Exchange: &conf.RabbitMQServer_Exchange{Name: "lifecycle_events"},
+				Queue:    tt.queue,
+			}}
+			sub := newQueueSubscriber()
+			uc := &blockingUseCase{release: make(chan struct{})}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			router := NewRouter(
+				cfg,
+				handlers.NewLifecycleEventHandler(uc, logger),
+				handlers.NewFallbackHandler(cfg, logger),
+				mq.NewEventSubscriber(sub, logger),
+				nil,
+				nil,
+				nil,
+				nil,
+				platform_logger.NewWatermillLogger(logger),
+			)
+			go func() { _ = router.Run(ctx) }()
+			defer router.Close()
+			<-router.Running()
+
+			assert.Equal(t, tt.wantConc, sub.subscriptions.Load(), "one consumer per worker")
+
+			payload, err := proto.Marshal(&eventsv1.SymbolUpdated{Id: 1, ProjectId: 7})
+			require.NoError(t, err)
+			for range messages {
+				msg := message.NewMessage(watermill.NewUUID(), payload)
+				mq.SetMessageRoutingKey("symbol.updated", msg)
+				sub.queue <- msg
+			}
+
+			require.Eventually(t, func() bool { return uc.inFlight.Load() == tt.wantConc }, 5*time.Second, 10*time.Millisecond)
+			// Give extra workers, if any, the chance to pick up more messages
+			time.Sleep(50 * time.Millisecond)
+			assert.Equal(t, tt.wantConc, uc.maxInFlight.Load())
+
+			close(uc.release)
+			require.Eventually(t, func() bool { return uc.done.Load() == messages }, 5*time.Second, 10*time.Millisecond)
+		})
+	}
+}
+
+func TestNewRouter_PermanentFailures(t *testing.T) {
+	tests := []struct {
+		name        string
+		deadLetters bool
+	}{
+		{name: "dead-lettered without retries", deadLetters: true},
+		{name: "dropped without a dead-letter queue"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := log.NewStdLogger(os.Stdout)
+			cfg := &conf.Data{Mq: &conf.RabbitMQServer{
+				Exchange:   &conf.RabbitMQServer_Exchange{Name: "lifecycle_events"},
+				DeadLetter: &conf.RabbitMQServer_DeadLetter{Queue: "lifecycle_event_queue.dlq"},
+			}}
+			sub := newQueueSubscriber()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			var deadLetterPub mq.DeadLetterPublisher
+			var deadLetters <-chan *message.Message
+			if tt.deadLetters {
+				pubSub := gochannel.NewGoChannel(gochannel.Config{}, watermill.NopLogger{})
+				defer pubSub.Close()
+				var err error
+				deadLetters, err = pubSub.Subscribe(ctx, cfg.Mq.DeadLetter.Queue)
+				require.NoError(t, err)
+				deadLetterPub = pubSub
+			}
+
+			router := NewRouter(
+				cfg,
+				handlers.NewLifecycleEventHandler(failingUseCase{}, logger),
+				handlers.NewFallbackHandler(cfg, logger),
+				mq.NewEventSubscriber(sub, logger),
+				deadLetterPub,
+				nil,
+				nil,
+				nil,
+				platform_logger.NewWatermillLogger(logger),
+			)
+			go func() { _ = router.Run(ctx) }()
+			defer router.Close()
+			<-router.Running()
+
+			msg := message.NewMessage(watermill.NewUUID(), []byte{0xff, 0xff})
+			mq.SetMessageRoutingKey("symbol.created", msg)
+			sub.queue <- msg
+
+			if tt.deadLetters {
+				select {
+				case dl := <-deadLetters:
+					dl.Ack()
+					assert.Equal(t, msg.UUID, dl.UUID)
+					assert.Equal(t, "1", dl.Metadata.Get(mq.AttemptsKey), "permanent failures are not retried")
+				case <-ctx.Done():
+					t.Fatal("message was not dead-lettered")
+				}
+			}
+			require.Eventually(t, func() bool { return sub.acked.Load() == 1 }, 5*time.Second, 10*time.Millisecond)
+		})
+	}
+}
+
+// countingUseCase counts the stats refreshes.
+type countingUseCase struct {
+	domain.SymbolUseCase
+	calls atomic.Int32
+}
+
+func (uc *countingUseCase) RefreshProjectSymbolStats(context.Context, uint64) error {
+	uc.calls.Add(1)
+	return nil
+}
+
+func TestNewRouter_InboxSkipsDuplicates(t *testing.T) {
+	logger := log.NewStdLogger(os.Stdout)
+	cfg := &conf.Data{Mq: &conf.RabbitMQServer{
+		Exchange: &conf.RabbitMQServer_Exchange{Name: "lifecycle_events"},
+		Queue:    &conf.RabbitMQServer_Queue{Name: "symbols_lifecycle_queue"},
+	}}
+	sub := newQueueSubscriber()
+	uc := &countingUseCase{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	router := NewRouter(
+		cfg,
+		handlers.NewLifecycleEventHandler(uc, logger),
+		handlers.NewFallbackHandler(cfg, logger),
+		mq.NewEventSubscriber(sub, logger),
+		nil,
+		nil,
+		inbox.NewMemoryStore(),
+		nil,
+		platform_logger.NewWatermillLogger(logger),
+	)
+	go func() { _ = router.Run(ctx) }()
+	defer router.Close()
+	<-router.Running()
+
+	payload, err := proto.Marshal(&eventsv1.SymbolUpdated{Id: 1, ProjectId: 7})
+	require.NoError(t, err)
+	msg := message.NewMessage(watermill.NewUUID(), payload)
+	mq.SetMessageRoutingKey("symbol.updated", msg)
+
+	// The broker redelivers the message, e.g. after a lost ack
+	sub.queue <- msg
+	sub.queue <- msg
+
+	require.Eventually(t, func() bool { return sub.acked.Load() == 2 }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(1), uc.calls.Load())
+}
+
+func TestNewRouter_GoChannelBroker(t *testing.T) {
+	logger := log.NewStdLogger(os.Stdout)
+	wmLogger := platform_logger.NewWatermillLogger(logger)
+	cfg := &conf.Data{
+		Broker: mq.BrokerGoChannel,
+		Mq: &conf.RabbitMQServer{
+			Exchange: &conf.RabbitMQServer_Exchange{Name: "lifecycle_events"},
+			Queue:    &conf.RabbitMQServer_Queue{WorkerCount: 3},
+		},
+	}
+	uc := &countingUseCase{}
+
+	goChannel, cleanup := data.NewGoChannel(cfg, logger, wmLogger)
+	require.NotNil(t, goChannel)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	router := NewRouter(
+		cfg,
+		handlers.NewLifecycleEventHandler(uc, logger),
+		handlers.NewFallbackHandler(cfg, logger),
+		mq.NewEventSubscriber(data.NewEmbeddedSubscriber(goChannel), logger),
+		data.NewEmbeddedDeadLetterPublisher(cfg, goChannel),
+		data.NewEmbeddedRetryPublisher(),
+		nil,
+		nil,
+		wmLogger,
+	)
+	go func() { _ = router.Run(ctx) }()
+	defer router.Close()
+	<-router.Running()
+
+	brokerPub, closePub := data.NewPublisher(cfg, goChannel, nil, nil, logger, wmLogger)
+	defer closePub()
+	pub := mq.NewEventPublisher(brokerPub, logger)
+	require.NoError(t, pub.PublishSymbolUpdated(ctx, &domain.Symbol{ID: 1, Project: 7}))
+
+	require.Eventually(t, func() bool { return uc.calls.Load() == 1 }, 5*time.Second, 10*time.Millisecond)
+	// Give extra workers, if any, the chance to handle the message again
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(1), uc.calls.Load(), "a single worker consumes the in-process broker")
+}
+
+// tracingUseCase records the span context its stats refreshes run in.
+type tracingUseCase struct {
+	domain.SymbolUseCase
+	spans chan trace.SpanContext
+}
+
+func (uc *tracingUseCase) RefreshProjectSymbolStats(ctx context.Context, _ uint64) error {
+	uc.spans <- trace.SpanContextFromContext(ctx)
+	return nil
+}
+
+func TestNewRouter_PropagatesTraceContext(t *testing.T) {
+	logger := log.NewStdLogger(os.Stdout)
+	wmLogger := platform_logger.NewWatermillLogger(logger)
+	cfg := &conf.Data{
+		Broker: mq.BrokerGoChannel,
+		Mq: &conf.RabbitMQServer{
+			Exchange: &conf.RabbitMQServer_Exchange{Name: "lifecycle_events"},
+			Queue:    &conf.RabbitMQServer_Queue{},
+		},
+	}
+	uc := &tracingUseCase{spans: make(chan trace.SpanContext, 1)}
+
+	// The publisher traces with the global provider
+	prevTP, prevProp := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	defer func() {
+		otel.SetTracerProvider(prevTP)
+		otel.SetTextMapPropagator(prevProp)
+	}()
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	goChannel, cleanup := data.NewGoChannel(cfg, logger, wmLogger)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	router := NewRouter(
+		cfg,
+		handlers.NewLifecycleEventHandler(uc, logger),
+		handlers.NewFallbackHandler(cfg, logger),
+		mq.NewEventSubscriber(data.NewEmbeddedSubscriber(goChannel), logger),
+		data.NewEmbeddedDeadLetterPublisher(cfg, goChannel),
+		data.NewEmbeddedRetryPublisher(),
+		nil,
+		tp,
+		wmLogger,
+	)
+	go func() { _ = router.Run(ctx) }()
+	defer router.Close()
+	<-router.Running()
+
+	brokerPub, closePub := data.NewPublisher(cfg, goChannel, nil, nil, logger, wmLogger)
+	defer closePub()
+	pub := mq.NewEventPublisher(brokerPub, logger)
+
+	reqCtx, request := tp.Tracer("test").Start(ctx, "request")
+	require.NoError(t, pub.PublishSymbolUpdated(reqCtx, &domain.Symbol{ID: 1, Project: 7}))
+	request.End()
+
+	var handled trace.SpanContext
+	select {
+	case handled = <-uc.spans:
+	case <-ctx.Done():
+		t.Fatal("the event was not handled")
+	}
+	assert.Equal(t, request.SpanContext().TraceID(), handled.TraceID(), "the handler should continue the trace of the request")
+
+	var publish, process sdktrace.ReadOnlySpan
+	require.Eventually(t, func() bool {
+		for _, s := range recorder.Ended() {
+			switch s.SpanKind() {
+			case trace.SpanKindProducer:
+				publish = s
+			case trace.SpanKindConsumer:
+				process = s
+			}
+		}
+		return publish != nil && process != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, "publish symbol.updated", publish.Name())
+	assert.Equal(t, request.SpanContext().SpanID(), publish.Parent().SpanID())
+	assert.Equal(t, publish.SpanContext().SpanID(), process.Parent().SpanID())
+	assert.Equal(t, process.SpanContext().SpanID(), handled.SpanID())
+}
+
+func TestNewRouter_SQLBroker(t *testing.T) {
+	logger := log.NewStdLogger(os.Stdout)
+	wmLogger := platform_logger.NewWatermillLogger(logger)
+	cfg := &conf.Data{
+		Broker:    mq.BrokerSQL,
+		SqlPubsub: &conf.SQLPubSub{PollInterval: durationpb.New(10 * time.Millisecond)},
+		Mq: &conf.RabbitMQServer{
+			Exchange: &conf.RabbitMQServer_Exchange{Name: "lifecycle_events"},
+			Queue:    &conf.RabbitMQServer_Queue{Name: "symbols_lifecycle_queue", WorkerCount: 3},
+		},
+	}
+	uc := &countingUseCase{}
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	// Every connection of an in-memory database is a separate database
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.AutoMigrate(&model.InboxMessage{}))
+	d, cleanup, err := data.NewData(db, logger)
+	require.NoError(t, err)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	brokerPub, closePub := data.NewPublisher(cfg, nil, db, nil, logger, wmLogger)
+	defer closePub()
+	pub := mq.NewEventPublisher(brokerPub, logger)
+	require.NoError(t, pub.PublishSymbolUpdated(ctx, &domain.Symbol{ID: 1, Project: 7}))
+
+	router := NewRouter(
+		cfg,
+		handlers.NewLifecycleEventHandler(uc, logger),
+		handlers.NewFallbackHandler(cfg, logger),
+		mq.NewEventSubscriber(data.NewSubscriber(cfg, nil, db, logger, wmLogger), logger),
+		nil,
+		nil,
+		repo.NewInboxStore(db, data.NewTransaction(d), logger),
+		nil,
+		wmLogger,
+	)
+	go func() { _ = router.Run(ctx) }()
+	defer router.Close()
+	<-router.Running()
+
+	// The inbox record is written in the transaction acking the message
+	require.Eventually(t, func() bool {
+		var records int64
+		return db.Model(&model.InboxMessage{}).Count(&records).Error == nil && records == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(1), uc.calls.Load())
+}
+
+func TestCountAttempts(t *testing.T) {
+	calls := 0
+	h := CountAttempts(func(msg *message.Message) ([]*message.Message, error) {
+		calls++
+		return nil, errors.New("failed")
+	})
+
+	msg := message.NewMessage(watermill.NewUUID(), nil)
+	for range 3 {
+		_, _ = h(msg)
+	}
+
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, "3", msg.Metadata.Get(mq.AttemptsKey))
+}
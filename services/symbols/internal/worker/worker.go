@@ -7,6 +7,8 @@ import (
 	"sync"
 	"time"
 
+	"platform/scheduler"
+
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -18,11 +20,13 @@ type Worker interface {
 	Stop() HookFunc
 }
 
-func NewWorker(router *message.Router, logger log.Logger) Worker {
+// NewWorker creates the worker running the router and, when not nil, the scheduled jobs of sched.
+func NewWorker(router *message.Router, sched *scheduler.Scheduler, logger log.Logger) Worker {
 	return &worker{
 		logger:       log.NewHelper(logger),
 		name:         "watermill-router",
 		router:       router,
+		scheduler:    sched,
 		closeTimeout: 15 * time.Second,
 		done:         make(chan struct{}),
 	}
@@ -32,6 +36,7 @@ type worker struct {
 	logger       *log.Helper
 	name         string
 	router       *message.Router
+	scheduler    *scheduler.Scheduler
 	closeTimeout time.Duration
 
 	startOnce sync.Once
@@ -44,6 +49,11 @@ type worker struct {
 func (w *worker) Start() HookFunc {
 	return func(ctx context.Context) error {
 		w.startOnce.Do(func() {
+			if w.scheduler != nil {
+				w.logger.WithContext(ctx).Infof("Starting scheduler with jobs %v", w.scheduler.Jobs())
+				w.scheduler.Start(ctx)
+			}
+
 			go func() {
 				defer close(w.done)
 				w.logger.WithContext(ctx).Infof("Starting router %s", w.name)
@@ -65,6 +75,12 @@ func (w *worker) Stop() HookFunc {
 			stopCtx, cancel := context.WithTimeout(ctx, w.closeTimeout)
 			defer cancel()
 
+			// Running jobs are canceled; they are not bound by the router close timeout
+			if w.scheduler != nil {
+				w.logger.WithContext(ctx).Info("Stopping scheduler")
+				w.scheduler.Stop()
+			}
+
 			w.logger.WithContext(ctx).Infof("Closing router %s", w.name)
 
 			errCh := make(chan error, 1)